@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/github"
 	ghhttp "github.com/github/github-mcp-server/pkg/http"
 	ghoauth "github.com/github/github-mcp-server/pkg/http/oauth"
+	"github.com/github/github-mcp-server/pkg/http/transport"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -39,6 +42,10 @@ var (
 		Short: "Start stdio server",
 		Long:  `Start a server that communicates via standard input/output streams using JSON-RPC messages.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := applySubdomainIsolationOverride(); err != nil {
+				return err
+			}
+
 			token := viper.GetString("personal_access_token")
 			appID := viper.GetString("app-id")
 			appInstallationID := viper.GetString("app-installation-id")
@@ -108,10 +115,24 @@ var (
 				}
 			}
 
+			redactFields, err := redactFieldsConfig()
+			if err != nil {
+				return err
+			}
+
+			lockdownTrustedOrgs, err := lockdownTrustedOrgsConfig()
+			if err != nil {
+				return err
+			}
+
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			stdioServerConfig := ghmcp.StdioServerConfig{
 				Version:              version,
 				Host:                 viper.GetString("host"),
+				UserAgent:            viper.GetString("user-agent"),
+				Proxy:                proxyConfig(),
+				TLS:                  tlsConfig(),
+				ResponseCache:        responseCacheConfig(),
 				Token:                token,
 				EnabledToolsets:      enabledToolsets,
 				EnabledTools:         enabledTools,
@@ -121,10 +142,13 @@ var (
 				EnableCommandLogging: viper.GetBool("enable-command-logging"),
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
+				MaxWorkflowJobPages:  viper.GetInt("max-workflow-job-pages"),
 				LockdownMode:         viper.GetBool("lockdown-mode"),
 				InsidersMode:         viper.GetBool("insiders"),
 				ExcludeTools:         excludeTools,
+				RedactFields:         redactFields,
 				RepoAccessCacheTTL:   &ttl,
+				LockdownTrustedOrgs:  lockdownTrustedOrgs,
 			}
 
 			// When no static token is provided, log in via OAuth using the given
@@ -150,11 +174,12 @@ var (
 			}
 
 			if appAuthRequested {
-				tokenProvider, err := newGitHubAppTokenProvider(appID, appInstallationID, appPrivateKeyPath, appPrivateKeyInline, viper.GetString("host"))
+				provider, err := newGitHubAppTokenProvider(appID, appInstallationID, appPrivateKeyPath, appPrivateKeyInline, viper.GetString("host"))
 				if err != nil {
 					return err
 				}
-				stdioServerConfig.TokenProvider = tokenProvider
+				stdioServerConfig.TokenProvider = provider.AccessToken
+				stdioServerConfig.TokenPermissions = provider.Permissions
 			}
 
 			return ghmcp.RunStdioServer(stdioServerConfig)
@@ -166,6 +191,10 @@ var (
 		Short: "Start HTTP server",
 		Long:  `Start an HTTP server that listens for MCP requests over HTTP.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := applySubdomainIsolationOverride(); err != nil {
+				return err
+			}
+
 			// Parse toolsets (same approach as stdio — see comment there)
 			var enabledToolsets []string
 			if viper.IsSet("toolsets") {
@@ -195,28 +224,56 @@ var (
 				}
 			}
 
+			redactFields, err := redactFieldsConfig()
+			if err != nil {
+				return err
+			}
+
+			lockdownTrustedOrgs, err := lockdownTrustedOrgsConfig()
+			if err != nil {
+				return err
+			}
+
+			var oauthAuthorizationServers []string
+			if viper.IsSet("oauth-authorization-servers") {
+				if err := viper.UnmarshalKey("oauth-authorization-servers", &oauthAuthorizationServers); err != nil {
+					return fmt.Errorf("failed to unmarshal oauth-authorization-servers: %w", err)
+				}
+			}
+
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			httpConfig := ghhttp.ServerConfig{
-				Version:              version,
-				Host:                 viper.GetString("host"),
-				Port:                 viper.GetInt("port"),
-				ListenHost:           viper.GetString("listen-host"),
-				BaseURL:              viper.GetString("base-url"),
-				ResourcePath:         viper.GetString("base-path"),
-				ExportTranslations:   viper.GetBool("export-translations"),
-				EnableCommandLogging: viper.GetBool("enable-command-logging"),
-				LogFilePath:          viper.GetString("log-file"),
-				ContentWindowSize:    viper.GetInt("content-window-size"),
-				LockdownMode:         viper.GetBool("lockdown-mode"),
-				RepoAccessCacheTTL:   &ttl,
-				ScopeChallenge:       viper.GetBool("scope-challenge"),
-				ReadOnly:             viper.GetBool("read-only"),
-				EnabledToolsets:      enabledToolsets,
-				EnabledTools:         enabledTools,
-				ExcludeTools:         excludeTools,
-				EnabledFeatures:      enabledFeatures,
-				InsidersMode:         viper.GetBool("insiders"),
-				TrustProxyHeaders:    viper.GetBool("trust-proxy-headers"),
+				Version:                   version,
+				Host:                      viper.GetString("host"),
+				UserAgent:                 viper.GetString("user-agent"),
+				Proxy:                     proxyConfig(),
+				TLS:                       tlsConfig(),
+				ResponseCache:             responseCacheConfig(),
+				Port:                      viper.GetInt("port"),
+				ListenHost:                viper.GetString("listen-host"),
+				BaseURL:                   viper.GetString("base-url"),
+				ResourcePath:              viper.GetString("base-path"),
+				ExportTranslations:        viper.GetBool("export-translations"),
+				EnableCommandLogging:      viper.GetBool("enable-command-logging"),
+				LogFilePath:               viper.GetString("log-file"),
+				ContentWindowSize:         viper.GetInt("content-window-size"),
+				MaxWorkflowJobPages:       viper.GetInt("max-workflow-job-pages"),
+				LockdownMode:              viper.GetBool("lockdown-mode"),
+				RepoAccessCacheTTL:        &ttl,
+				LockdownTrustedOrgs:       lockdownTrustedOrgs,
+				ScopeChallenge:            viper.GetBool("scope-challenge"),
+				ReadOnly:                  viper.GetBool("read-only"),
+				EnabledToolsets:           enabledToolsets,
+				EnabledTools:              enabledTools,
+				ExcludeTools:              excludeTools,
+				EnabledFeatures:           enabledFeatures,
+				InsidersMode:              viper.GetBool("insiders"),
+				TrustProxyHeaders:         viper.GetBool("trust-proxy-headers"),
+				OAuthAuthorizationServers: oauthAuthorizationServers,
+				OAuthResourceName:         viper.GetString("oauth-resource-name"),
+				WebhookSecret:             viper.GetString("webhook-secret"),
+				RedactFields:              redactFields,
+				EnableDebugConfigTool:     viper.GetBool("debug-config-tool"),
 			}
 
 			return ghhttp.RunHTTPServer(httpConfig)
@@ -240,10 +297,25 @@ func init() {
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
+	rootCmd.PersistentFlags().Bool("ghes-subdomain-isolation", false, "Whether the GitHub Enterprise Server host has subdomain isolation enabled. Skips detection via raw.<host>/_ping; by default it is detected")
+	rootCmd.PersistentFlags().String("user-agent", "", "User-Agent sent with GitHub API requests (default: github-mcp-server/<version>)")
+	// The proxy password has no flag because passing it in argv would expose it;
+	// set GITHUB_PROXY_PASSWORD instead.
+	rootCmd.PersistentFlags().String("proxy-url", "", "HTTP(S) proxy URL for GitHub API requests (default: HTTPS_PROXY/HTTP_PROXY environment variables)")
+	rootCmd.PersistentFlags().String("proxy-username", "", "Username for proxy basic authentication")
+	rootCmd.PersistentFlags().StringSlice("no-proxy", nil, "Comma-separated list of hosts, domains, or CIDRs that bypass --proxy-url")
+	rootCmd.PersistentFlags().String("client-cert", "", "Path to a PEM client certificate presented to GitHub (for GHES instances requiring mutual TLS)")
+	rootCmd.PersistentFlags().String("client-key", "", "Path to the PEM private key for --client-cert")
+	rootCmd.PersistentFlags().String("ca-cert", "", "Path to a PEM CA bundle trusted in addition to the system roots when connecting to GitHub")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
+	rootCmd.PersistentFlags().Int("max-workflow-job-pages", 10, "Maximum number of pages of 100 jobs read when collecting the jobs of a workflow run")
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
+	rootCmd.PersistentFlags().StringSlice("lockdown-trusted-orgs", nil, "Comma-separated organization or user logins whose repositories lockdown mode does not filter")
 	rootCmd.PersistentFlags().Bool("insiders", false, "Enable insiders features")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
+	rootCmd.PersistentFlags().Int("response-cache-size", 0, "Number of GitHub API GET responses to cache in memory (0 disables the cache)")
+	rootCmd.PersistentFlags().Duration("response-cache-ttl", time.Minute, "How long a cached GET response is served before it is revalidated with its ETag; GraphQL mutations and changes made outside the server are not seen until it lapses")
+	rootCmd.PersistentFlags().StringSlice("redact-fields", nil, "Comma-separated field names whose string values are redacted from tool output, for example "+strings.Join(github.CredentialRedactFields, ",")+" (default: no redaction)")
 
 	// stdio-specific OAuth flags. Provide --oauth-client-id (instead of a token)
 	// to log in via the browser-based OAuth flow on first use. Works for both
@@ -264,7 +336,10 @@ func init() {
 	httpCmd.Flags().String("base-url", "", "Base URL where this server is publicly accessible (for OAuth resource metadata)")
 	httpCmd.Flags().String("base-path", "", "Externally visible base path for the HTTP server (for OAuth resource metadata)")
 	httpCmd.Flags().Bool("scope-challenge", false, "Enable OAuth scope challenge responses")
-	httpCmd.Flags().Bool("trust-proxy-headers", false, "Honor X-Forwarded-Host and X-Forwarded-Proto when constructing OAuth resource metadata URLs. Only enable when the server is deployed behind a trusted proxy that sets these headers. Ignored when --base-url is set.")
+	httpCmd.Flags().String("oauth-resource-name", "", "Human-readable resource_name to advertise in the OAuth resource metadata (default: "+ghoauth.DefaultResourceName+")")
+	httpCmd.Flags().StringSlice("oauth-authorization-servers", nil, "Comma-separated OAuth authorization server URLs to advertise in the OAuth resource metadata after GitHub's")
+	httpCmd.Flags().Bool("debug-config-tool", false, "Expose a debug_effective_config tool that reports the read-only mode, toolsets, feature flags, lockdown mode and scope filtering resolved for each request")
+	httpCmd.Flags().Bool("trust-proxy-headers", false, "Honor X-Forwarded-Host, X-Forwarded-Proto and X-Forwarded-Prefix when constructing OAuth resource metadata URLs. Only enable when the server is deployed behind a trusted proxy that sets these headers. --base-url takes precedence over the host and scheme headers.")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
@@ -276,10 +351,23 @@ func init() {
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
+	_ = viper.BindPFlag("ghes-subdomain-isolation", rootCmd.PersistentFlags().Lookup("ghes-subdomain-isolation"))
+	_ = viper.BindPFlag("user-agent", rootCmd.PersistentFlags().Lookup("user-agent"))
+	_ = viper.BindPFlag("proxy-url", rootCmd.PersistentFlags().Lookup("proxy-url"))
+	_ = viper.BindPFlag("proxy-username", rootCmd.PersistentFlags().Lookup("proxy-username"))
+	_ = viper.BindPFlag("no-proxy", rootCmd.PersistentFlags().Lookup("no-proxy"))
+	_ = viper.BindPFlag("client-cert", rootCmd.PersistentFlags().Lookup("client-cert"))
+	_ = viper.BindPFlag("client-key", rootCmd.PersistentFlags().Lookup("client-key"))
+	_ = viper.BindPFlag("ca-cert", rootCmd.PersistentFlags().Lookup("ca-cert"))
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
+	_ = viper.BindPFlag("max-workflow-job-pages", rootCmd.PersistentFlags().Lookup("max-workflow-job-pages"))
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
+	_ = viper.BindPFlag("lockdown-trusted-orgs", rootCmd.PersistentFlags().Lookup("lockdown-trusted-orgs"))
 	_ = viper.BindPFlag("insiders", rootCmd.PersistentFlags().Lookup("insiders"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
+	_ = viper.BindPFlag("response-cache-size", rootCmd.PersistentFlags().Lookup("response-cache-size"))
+	_ = viper.BindPFlag("response-cache-ttl", rootCmd.PersistentFlags().Lookup("response-cache-ttl"))
+	_ = viper.BindPFlag("redact-fields", rootCmd.PersistentFlags().Lookup("redact-fields"))
 	_ = viper.BindPFlag("oauth-client-id", stdioCmd.Flags().Lookup("oauth-client-id"))
 	_ = viper.BindPFlag("oauth-client-secret", stdioCmd.Flags().Lookup("oauth-client-secret"))
 	_ = viper.BindPFlag("oauth-scopes", stdioCmd.Flags().Lookup("oauth-scopes"))
@@ -292,7 +380,10 @@ func init() {
 	_ = viper.BindPFlag("base-url", httpCmd.Flags().Lookup("base-url"))
 	_ = viper.BindPFlag("base-path", httpCmd.Flags().Lookup("base-path"))
 	_ = viper.BindPFlag("scope-challenge", httpCmd.Flags().Lookup("scope-challenge"))
+	_ = viper.BindPFlag("oauth-resource-name", httpCmd.Flags().Lookup("oauth-resource-name"))
+	_ = viper.BindPFlag("oauth-authorization-servers", httpCmd.Flags().Lookup("oauth-authorization-servers"))
 	_ = viper.BindPFlag("trust-proxy-headers", httpCmd.Flags().Lookup("trust-proxy-headers"))
+	_ = viper.BindPFlag("debug-config-tool", httpCmd.Flags().Lookup("debug-config-tool"))
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
 	rootCmd.AddCommand(httpCmd)
@@ -303,6 +394,8 @@ func initConfig() {
 	viper.SetEnvPrefix("github")
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	viper.AutomaticEnv()
+	// GITHUB_MCP_FEATURES mirrors the X-MCP-Features header used in HTTP mode.
+	_ = viper.BindEnv("features", "GITHUB_FEATURES", "GITHUB_MCP_FEATURES")
 }
 
 func main() {
@@ -312,13 +405,17 @@ func main() {
 	}
 }
 
-func newGitHubAppTokenProvider(appID, installationID, keyPath, keyInline, host string) (func() string, error) {
+func newGitHubAppTokenProvider(appID, installationID, keyPath, keyInline, host string) (*githubapp.Provider, error) {
 	keyBytes, err := loadAppPrivateKey(keyPath, keyInline)
 	if err != nil {
 		return nil, err
 	}
 
-	apiHost, err := utils.NewAPIHost(host)
+	outboundTransport, err := transport.NewOutboundTransport(transport.OutboundConfig{Proxy: proxyConfig(), TLS: tlsConfig()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure outbound transport: %w", err)
+	}
+	apiHost, err := utils.NewAPIHost(host, utils.WithHTTPClient(&http.Client{Transport: outboundTransport}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse host for GitHub App authentication: %w", err)
 	}
@@ -336,7 +433,7 @@ func newGitHubAppTokenProvider(appID, installationID, keyPath, keyInline, host s
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure GitHub App authentication: %w", err)
 	}
-	return provider.AccessToken, nil
+	return provider, nil
 }
 
 func loadAppPrivateKey(path, inline string) ([]byte, error) {
@@ -354,6 +451,67 @@ func loadAppPrivateKey(path, inline string) ([]byte, error) {
 	}
 }
 
+// proxyConfig builds the outbound proxy settings from flags and environment.
+func proxyConfig() transport.ProxyConfig {
+	return transport.ProxyConfig{
+		URL:      viper.GetString("proxy-url"),
+		Username: viper.GetString("proxy-username"),
+		Password: viper.GetString("proxy-password"),
+		NoProxy:  viper.GetStringSlice("no-proxy"),
+	}
+}
+
+// tlsConfig builds the outbound TLS settings from flags and environment.
+func tlsConfig() transport.TLSConfig {
+	return transport.TLSConfig{
+		ClientCertFile: viper.GetString("client-cert"),
+		ClientKeyFile:  viper.GetString("client-key"),
+		CACertFile:     viper.GetString("ca-cert"),
+	}
+}
+
+// responseCacheConfig builds the outbound GET response cache settings from flags and environment.
+func responseCacheConfig() transport.ResponseCacheConfig {
+	return transport.ResponseCacheConfig{
+		MaxEntries: viper.GetInt("response-cache-size"),
+		TTL:        viper.GetDuration("response-cache-ttl"),
+	}
+}
+
+// redactFieldsConfig returns the field names redacted from tool output. None
+// are redacted unless configured.
+func redactFieldsConfig() ([]string, error) {
+	var fields []string
+	if err := viper.UnmarshalKey("redact-fields", &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redact-fields: %w", err)
+	}
+	return slices.DeleteFunc(fields, func(f string) bool { return strings.TrimSpace(f) == "" }), nil
+}
+
+// applySubdomainIsolationOverride replaces subdomain isolation detection for
+// the configured host when --ghes-subdomain-isolation is set.
+func applySubdomainIsolationOverride() error {
+	host := viper.GetString("host")
+	if !viper.IsSet("ghes-subdomain-isolation") || host == "" {
+		return nil
+	}
+	if err := utils.SetSubdomainIsolation(host, viper.GetBool("ghes-subdomain-isolation")); err != nil {
+		return fmt.Errorf("failed to apply ghes-subdomain-isolation: %w", err)
+	}
+	return nil
+}
+
+func lockdownTrustedOrgsConfig() ([]string, error) {
+	if !viper.IsSet("lockdown-trusted-orgs") {
+		return nil, nil
+	}
+	var orgs []string
+	if err := viper.UnmarshalKey("lockdown-trusted-orgs", &orgs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lockdown-trusted-orgs: %w", err)
+	}
+	return orgs, nil
+}
+
 func wordSepNormalizeFunc(_ *pflag.FlagSet, name string) pflag.NormalizedName {
 	from := []string{"_"}
 	to := "-"
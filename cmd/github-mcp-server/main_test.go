@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -36,3 +37,18 @@ func TestGitHubAppFlagsAreStdioOnly(t *testing.T) {
 	assert.NotNil(t, stdioCmd.Flags().Lookup("app-id"))
 	assert.Nil(t, httpCmd.Flags().Lookup("app-id"))
 }
+
+func TestFeaturesFromEnv(t *testing.T) {
+	for _, name := range []string{"GITHUB_FEATURES", "GITHUB_MCP_FEATURES"} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("GITHUB_FEATURES", "")
+			t.Setenv("GITHUB_MCP_FEATURES", "")
+			t.Setenv(name, "file_blame,csv_output")
+			initConfig()
+
+			var features []string
+			require.NoError(t, viper.UnmarshalKey("features", &features))
+			assert.Equal(t, []string{"file_blame", "csv_output"}, features)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/github/github-mcp-server/internal/oauth"
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/github"
 	"github.com/github/github-mcp-server/pkg/http/transport"
@@ -35,7 +36,7 @@ type githubClients struct {
 	rest         *gogithub.Client
 	restUATransp *transport.UserAgentTransport
 	gql          *githubv4.Client
-	gqlHTTP      *http.Client // retained for middleware to modify transport
+	gqlUATransp  *transport.UserAgentTransport
 	raw          *raw.Client
 	repoAccess   *lockdown.RepoAccessCache
 }
@@ -66,9 +67,14 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 	// authenticate via BearerAuthTransport and skip go-github's WithAuthToken:
 	// the latter installs its own round tripper that would pin the static token
 	// and shadow the dynamic one.
+	baseTransport := cfg.Transport
+	if baseTransport == nil {
+		baseTransport = http.DefaultTransport
+	}
+	userAgent := github.UserAgent(cfg.UserAgent, cfg.Version)
 	restUATransport := &transport.UserAgentTransport{
-		Transport: http.DefaultTransport,
-		Agent:     fmt.Sprintf("github-mcp-server/%s", cfg.Version),
+		Transport: baseTransport,
+		Agent:     userAgent,
 	}
 	var restClient *gogithub.Client
 	if cfg.TokenProvider != nil {
@@ -92,10 +98,14 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 
 	// Construct GraphQL client
 	// We use NewEnterpriseClient unconditionally since we already parsed the API host
+	gqlUATransport := &transport.UserAgentTransport{
+		Transport: baseTransport,
+		Agent:     userAgent,
+	}
 	gqlHTTPClient := &http.Client{
 		Transport: &transport.BearerAuthTransport{
 			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
+				Transport: gqlUATransport,
 			},
 			Token:         cfg.Token,
 			TokenProvider: cfg.TokenProvider,
@@ -119,6 +129,9 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 		if cfg.RepoAccessTTL != nil {
 			opts = append(opts, lockdown.WithTTL(*cfg.RepoAccessTTL))
 		}
+		if len(cfg.LockdownTrustedOrgs) > 0 {
+			opts = append(opts, lockdown.WithTrustedOrgs(cfg.LockdownTrustedOrgs...))
+		}
 		repoAccessCache = lockdown.NewRepoAccessCache(gqlClient, restClient, opts...)
 	}
 
@@ -126,14 +139,18 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 		rest:         restClient,
 		restUATransp: restUATransport,
 		gql:          gqlClient,
-		gqlHTTP:      gqlHTTPClient,
+		gqlUATransp:  gqlUATransport,
 		raw:          rawClient,
 		repoAccess:   repoAccessCache,
 	}, nil
 }
 
 func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Server, error) {
-	apiHost, err := utils.NewAPIHost(cfg.Host)
+	var apiHostOpts []utils.APIHostOption
+	if cfg.Transport != nil {
+		apiHostOpts = append(apiHostOpts, utils.WithHTTPClient(&http.Client{Transport: cfg.Transport}))
+	}
+	apiHost, err := utils.NewAPIHost(cfg.Host, apiHostOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse API host: %w", err)
 	}
@@ -156,11 +173,13 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		clients.gql,
 		clients.raw,
 		clients.repoAccess,
+		raw.NewContentCache(raw.DefaultContentCacheEntries),
 		cfg.Translator,
 		github.FeatureFlags{
 			LockdownMode: cfg.LockdownMode,
 		},
 		cfg.ContentWindowSize,
+		cfg.MaxWorkflowJobPages,
 		featureChecker,
 		obs,
 	)
@@ -175,8 +194,10 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		WithFeatureChecker(featureChecker)
 
 	// Apply token scope filtering if scopes are known (for PAT filtering)
+	var middleware []mcp.Middleware
 	if cfg.TokenScopes != nil {
 		inventoryBuilder = inventoryBuilder.WithFilter(github.CreateToolScopeFilter(cfg.TokenScopes))
+		middleware = append(middleware, tokenScopesMiddleware(cfg.TokenScopes))
 	}
 
 	inventory, err := inventoryBuilder.Build()
@@ -184,12 +205,12 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		return nil, fmt.Errorf("failed to build inventory: %w", err)
 	}
 
-	ghServer, err := github.NewMCPServer(ctx, &cfg, deps, inventory)
+	ghServer, err := github.NewMCPServer(ctx, &cfg, deps, inventory, middleware...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GitHub MCP server: %w", err)
 	}
 
-	ghServer.AddReceivingMiddleware(addUserAgentsMiddleware(cfg, clients.restUATransp, clients.gqlHTTP))
+	ghServer.AddReceivingMiddleware(addUserAgentsMiddleware(cfg, clients.restUATransp, clients.gqlUATransp))
 
 	return ghServer, nil
 }
@@ -201,6 +222,22 @@ type StdioServerConfig struct {
 	// GitHub Host to target for API requests (e.g. github.com or github.enterprise.com)
 	Host string
 
+	// UserAgent overrides the User-Agent sent with outbound GitHub API requests.
+	// Defaults to github-mcp-server/<version> when empty.
+	UserAgent string
+
+	// Proxy routes outbound GitHub API requests through an HTTP(S) proxy.
+	// When Proxy.URL is empty, the standard proxy environment variables apply.
+	Proxy transport.ProxyConfig
+
+	// TLS configures client certificates and trusted CAs for outbound
+	// GitHub API requests (e.g. GHES instances requiring mutual TLS).
+	TLS transport.TLSConfig
+
+	// ResponseCache enables an in-memory cache of GET responses from the
+	// GitHub API when ResponseCache.MaxEntries is positive.
+	ResponseCache transport.ResponseCacheConfig
+
 	// GitHub Token to authenticate with the GitHub API
 	Token string
 
@@ -232,6 +269,10 @@ type StdioServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// MaxWorkflowJobPages bounds how many pages of 100 jobs are read when
+	// collecting the jobs of a workflow run. Zero uses the default.
+	MaxWorkflowJobPages int
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
@@ -243,9 +284,17 @@ type StdioServerConfig struct {
 	// explicitly listed in EnabledTools.
 	ExcludeTools []string
 
+	// RedactFields lists field names whose string values are redacted
+	// from tool output. Empty disables redaction.
+	RedactFields []string
+
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
 
+	// LockdownTrustedOrgs lists repository owners whose content lockdown mode
+	// never filters.
+	LockdownTrustedOrgs []string
+
 	// OAuthManager, when non-nil, enables OAuth 2.1 login for stdio mode. The
 	// server starts without a token and runs the authorization flow on the
 	// first tool call (see createOAuthMiddleware). It is mutually exclusive with
@@ -260,6 +309,11 @@ type StdioServerConfig struct {
 
 	// TokenProvider supplies a token for each GitHub API request.
 	TokenProvider func() string
+
+	// TokenPermissions, when non-nil, reports the fine-grained permissions
+	// granted to the tokens from TokenProvider, such as those of a GitHub App
+	// installation. They are mapped to OAuth scopes for tool filtering.
+	TokenPermissions func() (map[string]string, error)
 }
 
 // RunStdioServer is not concurrent safe.
@@ -274,6 +328,15 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		return fmt.Errorf("choose exactly one authentication mode: a static Token, OAuthManager, or TokenProvider")
 	}
 
+	outboundTransport, err := transport.NewOutboundTransport(transport.OutboundConfig{Proxy: cfg.Proxy, TLS: cfg.TLS})
+	if err != nil {
+		return fmt.Errorf("failed to configure outbound transport: %w", err)
+	}
+	var baseTransport http.RoundTripper = outboundTransport
+	if cfg.ResponseCache.Enabled() {
+		baseTransport = transport.NewResponseCacheTransport(outboundTransport, cfg.ResponseCache)
+	}
+
 	// Create app context
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -298,12 +361,14 @@ func RunStdioServer(cfg StdioServerConfig) error {
 
 	// Determine the scope set used to filter tools. Classic PATs expose their
 	// granted scopes via the API; OAuth uses the requested scopes (the default
-	// set hides nothing, a narrower explicit set filters accordingly). Other
-	// token types don't advertise scopes, so filtering is skipped.
+	// set hides nothing, a narrower explicit set filters accordingly); GitHub
+	// App installations report their permissions, which are mapped to scopes.
+	// Other token types, including fine-grained PATs, don't advertise what
+	// they can access, so filtering is skipped.
 	var tokenScopes []string
 	switch {
 	case strings.HasPrefix(cfg.Token, "ghp_"):
-		fetchedScopes, err := fetchTokenScopesForHost(ctx, cfg.Token, cfg.Host)
+		fetchedScopes, err := fetchTokenScopesForHost(ctx, cfg.Token, cfg.Host, outboundTransport)
 		if err != nil {
 			logger.Warn("failed to fetch token scopes, continuing without scope filtering", "error", err)
 		} else {
@@ -313,6 +378,14 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	case cfg.OAuthManager != nil:
 		tokenScopes = cfg.OAuthScopes
 		logger.Info("using requested OAuth scopes for tool filtering", "scopes", tokenScopes)
+	case cfg.TokenPermissions != nil:
+		permissions, err := cfg.TokenPermissions()
+		if err != nil {
+			logger.Warn("failed to determine token permissions, continuing without scope filtering", "error", err)
+		} else {
+			tokenScopes = scopes.ScopesFromPermissions(permissions)
+			logger.Info("token permissions mapped to scopes for filtering", "permissions", permissions, "scopes", tokenScopes)
+		}
 	default:
 		logger.Debug("skipping scope filtering for non-PAT token")
 	}
@@ -327,6 +400,8 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	ghServer, err := NewStdioMCPServer(ctx, github.MCPServerConfig{
 		Version:               cfg.Version,
 		Host:                  cfg.Host,
+		UserAgent:             cfg.UserAgent,
+		Transport:             baseTransport,
 		Token:                 cfg.Token,
 		EnabledToolsets:       cfg.EnabledToolsets,
 		EnabledTools:          cfg.EnabledTools,
@@ -334,11 +409,14 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		ReadOnly:              cfg.ReadOnly,
 		Translator:            t,
 		ContentWindowSize:     cfg.ContentWindowSize,
+		MaxWorkflowJobPages:   cfg.MaxWorkflowJobPages,
 		LockdownMode:          cfg.LockdownMode,
 		InsidersMode:          cfg.InsidersMode,
 		ExcludeTools:          cfg.ExcludeTools,
+		RedactFields:          cfg.RedactFields,
 		Logger:                logger,
 		RepoAccessTTL:         cfg.RepoAccessCacheTTL,
+		LockdownTrustedOrgs:   cfg.LockdownTrustedOrgs,
 		TokenScopes:           tokenScopes,
 		TokenProvider:         tokenProvider,
 		ToolHandlerMiddleware: toolHandlerMiddleware,
@@ -388,6 +466,16 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	return nil
 }
 
+// tokenScopesMiddleware places the token scopes fetched at startup in the
+// context of every request, as the WithPATScopes middleware does in HTTP mode.
+func tokenScopesMiddleware(tokenScopes []string) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, request mcp.Request) (mcp.Result, error) {
+			return next(ghcontext.WithTokenScopes(ctx, tokenScopes), method, request)
+		}
+	}
+}
+
 // createFeatureChecker returns a FeatureFlagChecker that resolves features
 // using the centralized ResolveFeatureFlags function. For the local server,
 // features are resolved once at startup from --features CLI flag and insiders mode.
@@ -398,7 +486,7 @@ func createFeatureChecker(enabledFeatures []string, insidersMode bool) inventory
 	}
 }
 
-func addUserAgentsMiddleware(cfg github.MCPServerConfig, restUATransp *transport.UserAgentTransport, gqlHTTPClient *http.Client) func(next mcp.MethodHandler) mcp.MethodHandler {
+func addUserAgentsMiddleware(cfg github.MCPServerConfig, restUATransp, gqlUATransp *transport.UserAgentTransport) func(next mcp.MethodHandler) mcp.MethodHandler {
 	return func(next mcp.MethodHandler) mcp.MethodHandler {
 		return func(ctx context.Context, method string, request mcp.Request) (result mcp.Result, err error) {
 			if method != "initialize" {
@@ -412,8 +500,8 @@ func addUserAgentsMiddleware(cfg github.MCPServerConfig, restUATransp *transport
 
 			message := initializeRequest
 			userAgent := fmt.Sprintf(
-				"github-mcp-server/%s (%s/%s)",
-				cfg.Version,
+				"%s (%s/%s)",
+				github.UserAgent(cfg.UserAgent, cfg.Version),
 				message.Params.ClientInfo.Name,
 				message.Params.ClientInfo.Version,
 			)
@@ -422,11 +510,7 @@ func addUserAgentsMiddleware(cfg github.MCPServerConfig, restUATransp *transport
 			}
 
 			restUATransp.Agent = userAgent
-
-			gqlHTTPClient.Transport = &transport.UserAgentTransport{
-				Transport: gqlHTTPClient.Transport,
-				Agent:     userAgent,
-			}
+			gqlUATransp.Agent = userAgent
 
 			return next(ctx, method, request)
 		}
@@ -434,9 +518,10 @@ func addUserAgentsMiddleware(cfg github.MCPServerConfig, restUATransp *transport
 }
 
 // fetchTokenScopesForHost fetches the OAuth scopes for a token from the GitHub API.
-// It constructs the appropriate API host URL based on the configured host.
-func fetchTokenScopesForHost(ctx context.Context, token, host string) ([]string, error) {
-	apiHost, err := utils.NewAPIHost(host)
+// It constructs the appropriate API host URL based on the configured host,
+// detecting GHES subdomain isolation through rt.
+func fetchTokenScopesForHost(ctx context.Context, token, host string, rt http.RoundTripper) ([]string, error) {
+	apiHost, err := utils.NewAPIHost(host, utils.WithHTTPClient(&http.Client{Transport: rt}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse API host: %w", err)
 	}
@@ -1 +1,122 @@
 package ghmcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateGitHubClientsUserAgent verifies that outbound requests carry the
+// configured User-Agent, falling back to the versioned default.
+func TestCreateGitHubClientsUserAgent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  string
+	}{
+		{
+			name:     "default user agent",
+			expected: "github-mcp-server/test",
+		},
+		{
+			name:      "configured user agent",
+			userAgent: "acme-bot/1.2",
+			expected:  "acme-bot/1.2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotUserAgent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUserAgent = r.Header.Get(headers.UserAgentHeader)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			apiHost, err := utils.NewAPIHost(server.URL)
+			require.NoError(t, err)
+
+			clients, err := createGitHubClients(github.MCPServerConfig{
+				Version:   "test",
+				UserAgent: tc.userAgent,
+				Token:     "token",
+			}, apiHost)
+			require.NoError(t, err)
+
+			resp, err := clients.rest.Client().Get(server.URL)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.expected, gotUserAgent)
+			assert.Equal(t, tc.expected, clients.gqlUATransp.Agent)
+		})
+	}
+}
+
+// TestCreateFeatureCheckerGatesTools verifies that features enabled for the
+// stdio server add and remove flag-gated tools from the inventory.
+func TestCreateFeatureCheckerGatesTools(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		enabledFeatures []string
+		insidersMode    bool
+		expectBlame     bool
+	}{
+		{
+			name:        "no features",
+			expectBlame: false,
+		},
+		{
+			name:            "feature enabled",
+			enabledFeatures: []string{github.FeatureFlagFileBlame},
+			expectBlame:     true,
+		},
+		{
+			name:            "unknown feature ignored",
+			enabledFeatures: []string{"not_a_feature"},
+			expectBlame:     false,
+		},
+		{
+			name:         "insiders mode",
+			insidersMode: true,
+			expectBlame:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			inv, err := github.NewInventory(translations.NullTranslationHelper).
+				WithToolsets([]string{"all"}).
+				WithFeatureChecker(createFeatureChecker(tc.enabledFeatures, tc.insidersMode)).
+				Build()
+			require.NoError(t, err)
+
+			var names []string
+			for _, tool := range inv.AvailableTools(context.Background()) {
+				names = append(names, tool.Tool.Name)
+			}
+			if tc.expectBlame {
+				assert.Contains(t, names, "get_file_blame")
+			} else {
+				assert.NotContains(t, names, "get_file_blame")
+			}
+		})
+	}
+}
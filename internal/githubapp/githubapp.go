@@ -113,6 +113,9 @@ type installationTokenSource struct {
 	cfg        Config
 	privateKey *rsa.PrivateKey
 	httpClient *http.Client
+
+	mu          sync.Mutex
+	permissions map[string]string
 }
 
 func newInstallationTokenSource(cfg Config, privateKey *rsa.PrivateKey, httpClient *http.Client) *installationTokenSource {
@@ -159,8 +162,9 @@ func (s *installationTokenSource) Token() (*oauth2.Token, error) {
 	}
 
 	var body struct {
-		Token     string    `json:"token"`
-		ExpiresAt time.Time `json:"expires_at"`
+		Token       string            `json:"token"`
+		ExpiresAt   time.Time         `json:"expires_at"`
+		Permissions map[string]string `json:"permissions"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
 		return nil, fmt.Errorf("decoding installation token response: %w", err)
@@ -171,6 +175,9 @@ func (s *installationTokenSource) Token() (*oauth2.Token, error) {
 	if body.ExpiresAt.IsZero() {
 		return nil, errors.New("installation token response did not contain an expiry")
 	}
+	s.mu.Lock()
+	s.permissions = body.Permissions
+	s.mu.Unlock()
 	return &oauth2.Token{
 		AccessToken: body.Token,
 		TokenType:   "token",
@@ -178,10 +185,18 @@ func (s *installationTokenSource) Token() (*oauth2.Token, error) {
 	}, nil
 }
 
+// grantedPermissions returns the permissions of the most recently minted token.
+func (s *installationTokenSource) grantedPermissions() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.permissions
+}
+
 // Provider caches and refreshes GitHub App installation access tokens.
 type Provider struct {
-	source oauth2.TokenSource
-	logger *slog.Logger
+	source       oauth2.TokenSource
+	installation *installationTokenSource
+	logger       *slog.Logger
 
 	mu        sync.Mutex
 	errLogged bool
@@ -198,8 +213,9 @@ func NewProvider(cfg Config, logger *slog.Logger) (*Provider, error) {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	source := oauth2.ReuseTokenSource(nil, newInstallationTokenSource(cfg, privateKey, nil))
-	return &Provider{source: source, logger: logger}, nil
+	installation := newInstallationTokenSource(cfg, privateKey, nil)
+	source := oauth2.ReuseTokenSource(nil, installation)
+	return &Provider{source: source, installation: installation, logger: logger}, nil
 }
 
 // AccessToken returns a cached token or refreshes it before expiry.
@@ -219,3 +235,17 @@ func (p *Provider) AccessToken() string {
 	p.mu.Unlock()
 	return tok.AccessToken
 }
+
+// Permissions returns the permissions granted to the installation token, such
+// as {"contents": "read", "issues": "write"}, minting a token if none has
+// been obtained yet.
+func (p *Provider) Permissions() (map[string]string, error) {
+	if _, err := p.source.Token(); err != nil {
+		return nil, err
+	}
+	permissions := p.installation.grantedPermissions()
+	if permissions == nil {
+		return nil, errors.New("installation token response did not contain permissions")
+	}
+	return permissions, nil
+}
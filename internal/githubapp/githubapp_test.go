@@ -162,14 +162,17 @@ func installationServer(t *testing.T, pub *rsa.PublicKey, token string, expiresA
 
 		w.WriteHeader(http.StatusCreated)
 		_ = json.NewEncoder(w).Encode(map[string]any{
-			"token":      token,
-			"expires_at": expiresAt.UTC().Format(time.RFC3339),
+			"token":       token,
+			"expires_at":  expiresAt.UTC().Format(time.RFC3339),
+			"permissions": testPermissions,
 		})
 	}))
 	t.Cleanup(srv.Close)
 	return srv, &calls
 }
 
+var testPermissions = map[string]string{"contents": "read", "issues": "write", "metadata": "read"}
+
 func newTestConfig(key *rsa.PrivateKey, baseURL string) Config {
 	return Config{AppID: "123", InstallationID: "456", PrivateKeyPEM: pkcs1PEMBytes(key), BaseRESTURL: baseURL + "/"}
 }
@@ -196,6 +199,37 @@ func TestProviderFetchesToken(t *testing.T) {
 	assert.Equal(t, int32(1), calls.Load())
 }
 
+func TestProviderPermissions(t *testing.T) {
+	key := newTestKey(t)
+	srv, calls := installationServer(t, &key.PublicKey, "ghs_fresh", time.Now().Add(time.Hour))
+
+	provider, err := NewProvider(newTestConfig(key, srv.URL), slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+	require.NoError(t, err)
+
+	permissions, err := provider.Permissions()
+	require.NoError(t, err)
+	assert.Equal(t, testPermissions, permissions)
+
+	// The token minted to read the permissions is reused for requests.
+	assert.Equal(t, "ghs_fresh", provider.AccessToken())
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestProviderPermissionsMintFailure(t *testing.T) {
+	key := newTestKey(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+
+	provider, err := NewProvider(newTestConfig(key, srv.URL), slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+	require.NoError(t, err)
+
+	_, err = provider.Permissions()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
+
 func TestProviderCachesToken(t *testing.T) {
 	key := newTestKey(t)
 	srv, calls := installationServer(t, &key.PublicKey, "ghs_cached", time.Now().Add(time.Hour))
@@ -6,6 +6,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -163,32 +164,8 @@ func NewGitHubAPIErrorResponse(ctx context.Context, message string, resp *github
 		_, _ = addGitHubAPIErrorToContext(ctx, apiErr) // Explicitly ignore error for graceful handling
 	}
 
-	var rateLimitErr *github.RateLimitError
-	if stderrors.As(err, &rateLimitErr) {
-		resetTime := rateLimitErr.Rate.Reset.Time
-		if !resetTime.IsZero() {
-			retryIn := time.Until(resetTime).Round(time.Second)
-			if retryIn > 0 {
-				return utils.NewToolResultError(fmt.Sprintf(
-					"%s: GitHub API rate limit exceeded. Retry after %v.", message, retryIn))
-			}
-		}
-		return utils.NewToolResultError(fmt.Sprintf(
-			"%s: GitHub API rate limit exceeded. Wait before retrying.", message))
-	}
-
-	var abuseErr *github.AbuseRateLimitError
-	if stderrors.As(err, &abuseErr) {
-		if abuseErr.RetryAfter != nil {
-			retryAfter := abuseErr.RetryAfter.Round(time.Second)
-			if retryAfter > 0 {
-				return utils.NewToolResultError(fmt.Sprintf(
-					"%s: GitHub secondary rate limit exceeded. Retry after %v.",
-					message, retryAfter))
-			}
-		}
-		return utils.NewToolResultError(fmt.Sprintf(
-			"%s: GitHub secondary rate limit exceeded. Wait before retrying.", message))
+	if result := rateLimitErrorResponse(message, err, resp); result != nil {
+		return result
 	}
 
 	return utils.NewToolResultErrorFromErr(message, err)
@@ -209,6 +186,11 @@ func NewGitHubRawAPIErrorResponse(ctx context.Context, message string, resp *htt
 	if ctx != nil {
 		_, _ = addRawAPIErrorToContext(ctx, rawErr) // Explicitly ignore error for graceful handling
 	}
+	if resp != nil {
+		if limit, ok := rateLimitFromResponse(resp.StatusCode, resp.Header); ok {
+			return limit.toolResult(message)
+		}
+	}
 	return utils.NewToolResultErrorFromErr(message, err)
 }
 
@@ -220,6 +202,126 @@ func NewGitHubAPIStatusErrorResponse(ctx context.Context, message string, resp *
 	return NewGitHubAPIErrorResponse(ctx, message, resp, err)
 }
 
+// RateLimitDetails is the structured content of the tool result returned when
+// a GitHub request fails because a rate limit was exceeded, so that agents can
+// back off until the limit resets instead of retrying blindly.
+type RateLimitDetails struct {
+	// Secondary is true for secondary (abuse) rate limits, which have no
+	// fixed budget and only report how long to wait.
+	Secondary bool `json:"secondary"`
+	// Resource is the rate limit bucket the request counted against, such as
+	// "core" or "code_search", when GitHub reported it. Search endpoints have
+	// their own buckets, so an exhausted one does not block other tools.
+	Resource string `json:"resource,omitempty"`
+	// Limit and Remaining are the request budget of the exhausted primary
+	// rate limit, when GitHub reported them.
+	Limit     int `json:"limit,omitempty"`
+	Remaining int `json:"remaining"`
+	// ResetAt is when the primary rate limit resets, if known.
+	ResetAt *time.Time `json:"reset_at,omitempty"`
+	// RetryAfterSeconds is how long to wait before retrying, if known.
+	RetryAfterSeconds int `json:"retry_after_seconds,omitempty"`
+}
+
+// rateLimitErrorResponse returns the tool result for err if it, or the response
+// it came with, reports an exceeded rate limit, and nil otherwise.
+func rateLimitErrorResponse(message string, err error, resp *github.Response) *mcp.CallToolResult {
+	var rateLimitErr *github.RateLimitError
+	if stderrors.As(err, &rateLimitErr) {
+		details := &RateLimitDetails{
+			Resource:  rateLimitErr.Rate.Resource,
+			Limit:     rateLimitErr.Rate.Limit,
+			Remaining: rateLimitErr.Rate.Remaining,
+		}
+		if reset := rateLimitErr.Rate.Reset.Time; !reset.IsZero() {
+			details.ResetAt = &reset
+			details.RetryAfterSeconds = int(time.Until(reset).Round(time.Second).Seconds())
+		}
+		return details.toolResult(message)
+	}
+
+	var abuseErr *github.AbuseRateLimitError
+	if stderrors.As(err, &abuseErr) {
+		details := &RateLimitDetails{Secondary: true}
+		if abuseErr.RetryAfter != nil {
+			details.RetryAfterSeconds = int(abuseErr.RetryAfter.Round(time.Second).Seconds())
+		}
+		return details.toolResult(message)
+	}
+
+	if resp != nil && resp.Response != nil {
+		if details, ok := rateLimitFromResponse(resp.StatusCode, resp.Header); ok {
+			return details.toolResult(message)
+		}
+	}
+	return nil
+}
+
+// rateLimitFromResponse inspects a 403 or 429 response for the headers GitHub
+// sets when a rate limit is exceeded: X-RateLimit-Remaining of 0 for the
+// primary limit, or Retry-After for a secondary limit.
+func rateLimitFromResponse(statusCode int, header http.Header) (*RateLimitDetails, bool) {
+	if statusCode != http.StatusForbidden && statusCode != http.StatusTooManyRequests {
+		return nil, false
+	}
+
+	if header.Get("X-RateLimit-Remaining") == "0" {
+		details := &RateLimitDetails{Resource: header.Get("X-RateLimit-Resource")}
+		details.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+		if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset > 0 {
+			resetAt := time.Unix(reset, 0).UTC()
+			details.ResetAt = &resetAt
+			details.RetryAfterSeconds = int(time.Until(resetAt).Round(time.Second).Seconds())
+		}
+		return details, true
+	}
+
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		details := &RateLimitDetails{Secondary: true}
+		details.RetryAfterSeconds, _ = strconv.Atoi(retryAfter)
+		return details, true
+	}
+
+	// GitHub answers 429 only for rate limits, even without the usual headers.
+	if statusCode == http.StatusTooManyRequests {
+		return &RateLimitDetails{Secondary: true}, true
+	}
+	return nil, false
+}
+
+// toolResult renders d as an error result whose text tells the agent when to
+// retry and whose structured content carries the details.
+func (d *RateLimitDetails) toolResult(message string) *mcp.CallToolResult {
+	if d.RetryAfterSeconds < 0 {
+		d.RetryAfterSeconds = 0
+	}
+
+	kind := "GitHub API rate limit exceeded"
+	if d.Secondary {
+		kind = "GitHub secondary rate limit exceeded"
+	}
+	if d.Resource != "" {
+		kind += fmt.Sprintf(" for the %s resource", d.Resource)
+	}
+	text := fmt.Sprintf("%s: %s.", message, kind)
+	if d.RetryAfterSeconds > 0 {
+		text += fmt.Sprintf(" Retry after %v.", time.Duration(d.RetryAfterSeconds)*time.Second)
+	} else {
+		text += " Wait before retrying."
+	}
+	if d.ResetAt != nil {
+		text += fmt.Sprintf(" The limit resets at %s", d.ResetAt.UTC().Format(time.RFC3339))
+		if d.Limit > 0 {
+			text += fmt.Sprintf(" (%d of %d requests remaining)", d.Remaining, d.Limit)
+		}
+		text += "."
+	}
+
+	result := utils.NewToolResultError(text)
+	result.StructuredContent = d
+	return result
+}
+
 // StructuredResolutionError is a machine-readable error returned by name-resolution
 // helpers (e.g. resolving a project field or single-select option by name). Agents
 // can parse the JSON body to self-correct without re-prompting.
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -672,6 +673,141 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		assert.NotContains(t, text, "https://")
 	})
 
+	t.Run("RateLimitError reports reset time and remaining budget", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resetTime := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+		rateLimitErr := &github.RateLimitError{
+			Rate:     github.Rate{Limit: 5000, Remaining: 0, Reset: github.Timestamp{Time: resetTime}},
+			Response: &http.Response{StatusCode: 403},
+			Message:  "API rate limit exceeded",
+		}
+		resp := &github.Response{Response: rateLimitErr.Response}
+
+		result := NewGitHubAPIErrorResponse(ctx, "list issues", resp, rateLimitErr)
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, fmt.Sprintf("The limit resets at %s (0 of 5000 requests remaining).", resetTime.UTC().Format(time.RFC3339)))
+
+		details, ok := result.StructuredContent.(*RateLimitDetails)
+		require.True(t, ok, "expected *RateLimitDetails, got %T", result.StructuredContent)
+		assert.False(t, details.Secondary)
+		assert.Equal(t, 5000, details.Limit)
+		assert.Equal(t, 0, details.Remaining)
+		require.NotNil(t, details.ResetAt)
+		assert.True(t, resetTime.Equal(*details.ResetAt))
+		assert.Positive(t, details.RetryAfterSeconds)
+	})
+
+	t.Run("status error with exhausted rate limit headers is reported as a rate limit", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resetTime := time.Now().Add(15 * time.Minute).Truncate(time.Second)
+		header := http.Header{}
+		header.Set("X-RateLimit-Limit", "60")
+		header.Set("X-RateLimit-Remaining", "0")
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(resetTime.Unix(), 10))
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusForbidden, Header: header}}
+
+		result := NewGitHubAPIStatusErrorResponse(ctx, "get file contents", resp, []byte(`{"message":"API rate limit exceeded"}`))
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "get file contents: GitHub API rate limit exceeded. Retry after")
+		assert.Contains(t, text, "(0 of 60 requests remaining)")
+		assert.NotContains(t, text, "403")
+
+		details, ok := result.StructuredContent.(*RateLimitDetails)
+		require.True(t, ok)
+		assert.Equal(t, 60, details.Limit)
+		require.NotNil(t, details.ResetAt)
+		assert.True(t, resetTime.Equal(*details.ResetAt))
+	})
+
+	t.Run("exhausted search rate limit names its resource", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resetTime := time.Now().Add(time.Minute).Truncate(time.Second)
+		rateLimitErr := &github.RateLimitError{
+			Rate:     github.Rate{Limit: 10, Remaining: 0, Reset: github.Timestamp{Time: resetTime}, Resource: "code_search"},
+			Response: &http.Response{StatusCode: 403},
+			Message:  "API rate limit exceeded",
+		}
+		resp := &github.Response{Response: rateLimitErr.Response}
+
+		result := NewGitHubAPIErrorResponse(ctx, "failed to search code", resp, rateLimitErr)
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "failed to search code: GitHub API rate limit exceeded for the code_search resource. Retry after")
+		assert.Contains(t, text, "(0 of 10 requests remaining)")
+
+		details, ok := result.StructuredContent.(*RateLimitDetails)
+		require.True(t, ok)
+		assert.Equal(t, "code_search", details.Resource)
+	})
+
+	t.Run("status error reads the rate limit resource header", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", "0")
+		header.Set("X-RateLimit-Resource", "search")
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusForbidden, Header: header}}
+
+		result := NewGitHubAPIStatusErrorResponse(ctx, "failed to search issues", resp, []byte(`{"message":"API rate limit exceeded"}`))
+
+		text := requireErrorText(t, result)
+		assert.Equal(t, "failed to search issues: GitHub API rate limit exceeded for the search resource. Wait before retrying.", text)
+
+		details, ok := result.StructuredContent.(*RateLimitDetails)
+		require.True(t, ok)
+		assert.Equal(t, "search", details.Resource)
+	})
+
+	t.Run("429 with Retry-After is reported as a secondary rate limit", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		header := http.Header{}
+		header.Set("Retry-After", "90")
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}}
+
+		result := NewGitHubAPIErrorResponse(ctx, "create comment", resp, fmt.Errorf("too many requests"))
+
+		text := requireErrorText(t, result)
+		assert.Equal(t, "create comment: GitHub secondary rate limit exceeded. Retry after 1m30s.", text)
+
+		details, ok := result.StructuredContent.(*RateLimitDetails)
+		require.True(t, ok)
+		assert.True(t, details.Secondary)
+		assert.Equal(t, 90, details.RetryAfterSeconds)
+	})
+
+	t.Run("raw API response with exhausted rate limit is reported as a rate limit", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", "0")
+		resp := &http.Response{StatusCode: http.StatusForbidden, Header: header}
+
+		result := NewGitHubRawAPIErrorResponse(ctx, "get raw content", resp, fmt.Errorf("forbidden"))
+
+		text := requireErrorText(t, result)
+		assert.Equal(t, "get raw content: GitHub API rate limit exceeded. Wait before retrying.", text)
+	})
+
+	t.Run("403 without rate limit headers passes through the original error message", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", "4999")
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusForbidden, Header: header}}
+
+		result := NewGitHubAPIErrorResponse(ctx, "merge pull request", resp, fmt.Errorf("resource not accessible by integration"))
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "resource not accessible by integration")
+		assert.Nil(t, result.StructuredContent)
+	})
+
 	t.Run("non-rate-limit GitHub API error passes through the original error message", func(t *testing.T) {
 		// Given a context with GitHub error tracking enabled
 		ctx := ContextWithGitHubErrors(context.Background())
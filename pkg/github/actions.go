@@ -1,13 +1,24 @@
 package github
 
 import (
+	"archive/zip"
+	"bufio"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"math"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/profiler"
 	buffer "github.com/github/github-mcp-server/pkg/buffer"
@@ -38,28 +49,102 @@ const (
 	actionsMethodGetWorkflowJob           = "get_workflow_job"
 	actionsMethodGetWorkflowRunUsage      = "get_workflow_run_usage"
 	actionsMethodGetWorkflowRunLogsURL    = "get_workflow_run_logs_url"
+	actionsMethodDownloadWorkflowRunLogs  = "download_workflow_run_logs"
 	actionsMethodDownloadWorkflowArtifact = "download_workflow_run_artifact"
 	actionsMethodRunWorkflow              = "run_workflow"
 	actionsMethodRerunWorkflowRun         = "rerun_workflow_run"
 	actionsMethodRerunFailedJobs          = "rerun_failed_jobs"
 	actionsMethodCancelWorkflowRun        = "cancel_workflow_run"
 	actionsMethodDeleteWorkflowRunLogs    = "delete_workflow_run_logs"
+	actionsMethodCancelAllRuns            = "cancel_all_runs"
+	actionsMethodDeleteWorkflowRun        = "delete_workflow_run"
+	actionsMethodGetWorkflowRunAttempt    = "get_workflow_run_attempt"
+	actionsMethodGetWorkflowRunTiming     = "get_workflow_run_timing"
+	actionsMethodWaitForWorkflowRun       = "wait_for_workflow_run"
+	actionsMethodGetWorkflowSuccessRate   = "get_workflow_success_rate"
+	actionsMethodGetWorkflowUsage         = "get_workflow_usage"
+	actionsMethodListActionsCaches        = "list_actions_caches"
+	actionsMethodDeleteActionsCache       = "delete_actions_cache"
 )
 
+// maxCancelAllRuns bounds how many runs a single cancel_all_runs call will cancel.
+const maxCancelAllRuns = 50
+
+// activeWorkflowRunStatuses are the statuses of runs that have not finished
+// and can still be cancelled.
+var activeWorkflowRunStatuses = []string{"in_progress", "queued", "waiting", "pending", "requested"}
+
+const (
+	// defaultWorkflowRunPollInterval and defaultWorkflowRunWaitTimeout apply
+	// to wait_for_workflow_run when the caller does not set them.
+	defaultWorkflowRunPollInterval = 10 * time.Second
+	defaultWorkflowRunWaitTimeout  = 5 * time.Minute
+	// maxWorkflowRunWaitTimeout caps how long a single wait_for_workflow_run
+	// call may block, whatever timeout the caller asks for.
+	maxWorkflowRunWaitTimeout = 10 * time.Minute
+)
+
+const (
+	// defaultWorkflowSuccessRateLookback and maxWorkflowSuccessRateLookback
+	// bound how many recent runs get_workflow_success_rate aggregates.
+	defaultWorkflowSuccessRateLookback = 50
+	maxWorkflowSuccessRateLookback     = 100
+)
+
+// defaultMaxWorkflowJobPages bounds how many pages of 100 jobs are read when
+// collecting the jobs of a run, unless the server is configured otherwise.
+const defaultMaxWorkflowJobPages = 10
+
+// maxWorkflowJobPagesOrDefault returns pages, or defaultMaxWorkflowJobPages
+// when pages is not positive.
+func maxWorkflowJobPagesOrDefault(pages int) int {
+	if pages <= 0 {
+		return defaultMaxWorkflowJobPages
+	}
+	return pages
+}
+
+// listWorkflowJobPages collects the jobs returned by list page by page, up to
+// maxPages pages of 100. truncated reports whether pages remained unread. On
+// error the failing response is returned for error reporting.
+func listWorkflowJobPages(maxPages int, list func(opts github.ListOptions) (*github.Jobs, *github.Response, error)) (jobs *github.Jobs, truncated bool, resp *github.Response, err error) {
+	jobs = &github.Jobs{}
+	opts := github.ListOptions{PerPage: 100}
+	for page := 1; ; page++ {
+		pageJobs, resp, err := list(opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		_ = resp.Body.Close()
+		if jobs.TotalCount == nil {
+			jobs.TotalCount = pageJobs.TotalCount
+		}
+		jobs.Jobs = append(jobs.Jobs, pageJobs.Jobs...)
+
+		if resp.NextPage == 0 {
+			return jobs, false, resp, nil
+		}
+		if page == maxPages {
+			return jobs, true, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // handleFailedJobLogs gets logs for all failed jobs in a workflow run
-func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
-	// First, get all jobs for the workflow run
-	jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{
-		Filter: "latest",
+func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines int, contentWindowSize int, maxJobPages int) (*mcp.CallToolResult, any, error) {
+	// First, get all jobs for the workflow run, page by page
+	allJobs, truncated, resp, err := listWorkflowJobPages(maxJobPages, func(opts github.ListOptions) (*github.Jobs, *github.Response, error) {
+		return client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{Filter: "latest", ListOptions: opts})
 	})
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil, nil
 	}
-	defer func() { _ = resp.Body.Close() }()
+	jobs := allJobs.Jobs
 
 	// Filter for failed jobs
 	var failedJobs []*github.WorkflowJob
-	for _, job := range jobs.Jobs {
+	for _, job := range jobs {
 		if job.GetConclusion() == "failure" {
 			failedJobs = append(failedJobs, job)
 		}
@@ -69,9 +154,12 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 		result := map[string]any{
 			"message":     "No failed jobs found in this workflow run",
 			"run_id":      runID,
-			"total_jobs":  len(jobs.Jobs),
+			"total_jobs":  len(jobs),
 			"failed_jobs": 0,
 		}
+		if truncated {
+			result["jobs_truncated"] = true
+		}
 		r, _ := json.Marshal(result)
 		return utils.NewToolResultText(string(r)), nil, nil
 	}
@@ -97,11 +185,15 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 	result := map[string]any{
 		"message":       fmt.Sprintf("Retrieved logs for %d failed jobs", len(failedJobs)),
 		"run_id":        runID,
-		"total_jobs":    len(jobs.Jobs),
+		"total_jobs":    len(jobs),
 		"failed_jobs":   len(failedJobs),
 		"logs":          logResults,
 		"return_format": map[string]bool{"content": returnContent, "urls": !returnContent},
 	}
+	if truncated {
+		// Only the first maxJobPages pages of jobs were searched.
+		result["jobs_truncated"] = true
+	}
 
 	r, err := json.Marshal(result)
 	if err != nil {
@@ -206,6 +298,7 @@ func ActionsList(t translations.TranslationHelperFunc) inventory.ServerTool {
 			Description: t("TOOL_ACTIONS_LIST_DESCRIPTION",
 				`Tools for listing GitHub Actions resources.
 Use this tool to list workflows in a repository, or list workflow runs, jobs, and artifacts for a specific workflow or workflow run.
+Use 'list_actions_caches' to see the Actions caches stored for a repository.
 `),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_ACTIONS_LIST_USER_TITLE", "List GitHub Actions workflows in a repository"),
@@ -222,6 +315,7 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 							actionsMethodListWorkflowRuns,
 							actionsMethodListWorkflowJobs,
 							actionsMethodListWorkflowArtifacts,
+							actionsMethodListActionsCaches,
 						},
 					},
 					"owner": {
@@ -235,9 +329,10 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 					"resource_id": {
 						Type: "string",
 						Description: `The unique identifier of the resource. This will vary based on the "method" provided, so ensure you provide the correct ID:
-- Do not provide any resource ID for 'list_workflows' method.
+- Do not provide any resource ID for 'list_workflows' and 'list_actions_caches' methods.
 - Provide a workflow ID or workflow file name (e.g. ci.yaml) for 'list_workflow_runs' method, or omit to list all workflow runs in the repository.
-- Provide a workflow run ID for 'list_workflow_jobs' and 'list_workflow_run_artifacts' methods.
+- Provide a workflow run ID for 'list_workflow_jobs' method.
+- Provide a workflow run ID for 'list_workflow_run_artifacts' method, or omit to list all artifacts in the repository.
 `,
 					},
 					"workflow_runs_filter": {
@@ -292,8 +387,8 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 							},
 							"status": {
 								Type:        "string",
-								Description: "Filter workflow runs to only runs with a specific status",
-								Enum:        []any{"queued", "in_progress", "completed", "requested", "waiting"},
+								Description: "Filter workflow runs to only runs with a specific status or conclusion, e.g. 'failure' for failed runs",
+								Enum:        workflowRunStatuses,
 							},
 						},
 					},
@@ -308,6 +403,20 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 							},
 						},
 					},
+					"actions_caches_filter": {
+						Type:        "object",
+						Description: "Filters for Actions caches. **ONLY** used when method is 'list_actions_caches'",
+						Properties: map[string]*jsonschema.Schema{
+							"ref": {
+								Type:        "string",
+								Description: "Only list caches for this Git ref, e.g. refs/heads/main or refs/pull/42/merge",
+							},
+							"key": {
+								Type:        "string",
+								Description: "Only list caches whose key starts with this prefix",
+							},
+						},
+					},
 					"page": {
 						Type:        "number",
 						Description: "Page number for pagination (default: 1)",
@@ -366,11 +475,20 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 			var resourceIDInt int64
 			var parseErr error
 			switch method {
-			case actionsMethodListWorkflows:
+			case actionsMethodListWorkflows, actionsMethodListActionsCaches:
 				// Do nothing, no resource ID needed
 			case actionsMethodListWorkflowRuns:
 				// resource_id is optional for list_workflow_runs
 				// If not provided, list all workflow runs in the repository
+			case actionsMethodListWorkflowArtifacts:
+				// resource_id is optional for list_workflow_run_artifacts
+				// If not provided, list all artifacts in the repository
+				if resourceID != "" {
+					resourceIDInt, parseErr = strconv.ParseInt(resourceID, 10, 64)
+					if parseErr != nil {
+						return utils.NewToolResultError(fmt.Sprintf("invalid resource_id, must be an integer for method %s: %v", method, parseErr)), nil, nil
+					}
+				}
 			default:
 				if resourceID == "" {
 					return utils.NewToolResultError(fmt.Sprintf("missing required parameter for method %s: resource_id", method)), nil, nil
@@ -396,6 +514,9 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 			case actionsMethodListWorkflowArtifacts:
 				result, payload, err := listWorkflowArtifacts(ctx, client, owner, repo, resourceIDInt, pagination)
 				return attachIFC(result), payload, err
+			case actionsMethodListActionsCaches:
+				result, payload, err := listActionsCaches(ctx, client, args, owner, repo, pagination)
+				return attachIFC(result), payload, err
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
@@ -412,6 +533,7 @@ func ActionsGet(t translations.TranslationHelperFunc) inventory.ServerTool {
 			Name: "actions_get",
 			Description: t("TOOL_ACTIONS_GET_DESCRIPTION", `Get details about specific GitHub Actions resources.
 Use this tool to get details about individual workflows, workflow runs, jobs, and artifacts by their unique IDs.
+Use 'wait_for_workflow_run' to block until a workflow run completes and get its conclusion.
 `),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_ACTIONS_GET_USER_TITLE", "Get details of GitHub Actions resources (workflows, workflow runs, jobs, and artifacts)"),
@@ -430,6 +552,12 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 							actionsMethodDownloadWorkflowArtifact,
 							actionsMethodGetWorkflowRunUsage,
 							actionsMethodGetWorkflowRunLogsURL,
+							actionsMethodDownloadWorkflowRunLogs,
+							actionsMethodGetWorkflowRunAttempt,
+							actionsMethodGetWorkflowRunTiming,
+							actionsMethodWaitForWorkflowRun,
+							actionsMethodGetWorkflowSuccessRate,
+							actionsMethodGetWorkflowUsage,
 						},
 					},
 					"owner": {
@@ -443,12 +571,56 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 					"resource_id": {
 						Type: "string",
 						Description: `The unique identifier of the resource. This will vary based on the "method" provided, so ensure you provide the correct ID:
-- Provide a workflow ID or workflow file name (e.g. ci.yaml) for 'get_workflow' method.
-- Provide a workflow run ID for 'get_workflow_run', 'get_workflow_run_usage', and 'get_workflow_run_logs_url' methods.
+- Provide a workflow ID or workflow file name (e.g. ci.yaml) for 'get_workflow', 'get_workflow_success_rate', and 'get_workflow_usage' methods.
+- Provide a workflow run ID for 'get_workflow_run', 'get_workflow_run_usage', 'get_workflow_run_logs_url', 'download_workflow_run_logs', 'get_workflow_run_attempt', 'get_workflow_run_timing', and 'wait_for_workflow_run' methods.
 - Provide an artifact ID for 'download_workflow_run_artifact' method.
 - Provide a job ID for 'get_workflow_job' method.
 `,
 					},
+					"attempt_number": {
+						Type:        "number",
+						Description: "The attempt number of the workflow run. Required for 'get_workflow_run_attempt' method.",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"poll_interval": {
+						Type:        "number",
+						Description: "Seconds between status checks. Used for 'wait_for_workflow_run' method.",
+						Minimum:     jsonschema.Ptr(1.0),
+						Default:     json.RawMessage(`10`),
+					},
+					"timeout": {
+						Type:        "number",
+						Description: "Maximum seconds to wait for the run to complete, capped at 600. Used for 'wait_for_workflow_run' method.",
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(600.0),
+						Default:     json.RawMessage(`300`),
+					},
+					"file_path": {
+						Type:        "string",
+						Description: "Path of a single file inside the artifact to extract and return, e.g. reports/junit.xml. Text is returned directly and binary content as base64. Used for 'download_workflow_run_artifact' method; when omitted only the archive's download URL is returned.",
+					},
+					"lookback": {
+						Type:        "number",
+						Description: "Number of most recent completed runs to aggregate, up to 100. Used for 'get_workflow_success_rate' method.",
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(100.0),
+						Default:     json.RawMessage(`50`),
+					},
+					"include_jobs": {
+						Type:        "boolean",
+						Description: "Also return the run's latest jobs with their status, conclusion, and steps. Used for 'get_workflow_run' method.",
+						Default:     json.RawMessage(`false`),
+					},
+					"return_content": {
+						Type:        "boolean",
+						Description: "Download the log archive and return the end of each job's log instead of only the archive URL. Used for 'download_workflow_run_logs' method.",
+						Default:     json.RawMessage(`false`),
+					},
+					"tail_lines": {
+						Type:        "number",
+						Description: "Number of lines to return from the end of each job's log. Used for 'download_workflow_run_logs' method with return_content.",
+						Default:     json.RawMessage(`500`),
+					},
 				},
 				Required: []string{"method", "owner", "repo", "resource_id"},
 			},
@@ -473,6 +645,43 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			attemptNumber, err := OptionalIntParam(args, "attempt_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if method == actionsMethodGetWorkflowRunAttempt && attemptNumber <= 0 {
+				return utils.NewToolResultError("missing required parameter for method get_workflow_run_attempt: attempt_number"), nil, nil
+			}
+
+			includeJobs, err := OptionalBoolParamWithDefault(args, "include_jobs", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pollInterval, err := OptionalIntParamWithDefault(args, "poll_interval", int(defaultWorkflowRunPollInterval/time.Second))
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			timeout, err := OptionalIntParamWithDefault(args, "timeout", int(defaultWorkflowRunWaitTimeout/time.Second))
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			lookback, err := OptionalIntParamWithDefault(args, "lookback", defaultWorkflowSuccessRateLookback)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			filePath, err := OptionalParam[string](args, "file_path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			returnContent, err := OptionalBoolParamWithDefault(args, "return_content", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			tailLines, err := OptionalIntParamWithDefault(args, "tail_lines", 500)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
@@ -489,7 +698,7 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 			var resourceIDInt int64
 			var parseErr error
 			switch method {
-			case actionsMethodGetWorkflow:
+			case actionsMethodGetWorkflow, actionsMethodGetWorkflowSuccessRate, actionsMethodGetWorkflowUsage:
 				// Do nothing, we accept both a string workflow ID or filename
 			default:
 				// For other methods, resource ID must be an integer
@@ -504,13 +713,13 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 				result, payload, err := getWorkflow(ctx, client, owner, repo, resourceID)
 				return attachIFC(result), payload, err
 			case actionsMethodGetWorkflowRun:
-				result, payload, err := getWorkflowRun(ctx, client, owner, repo, resourceIDInt)
+				result, payload, err := getWorkflowRun(ctx, client, owner, repo, resourceIDInt, includeJobs, deps.GetMaxWorkflowJobPages())
 				return attachIFC(result), payload, err
 			case actionsMethodGetWorkflowJob:
 				result, payload, err := getWorkflowJob(ctx, client, owner, repo, resourceIDInt)
 				return attachIFC(result), payload, err
 			case actionsMethodDownloadWorkflowArtifact:
-				result, payload, err := downloadWorkflowArtifact(ctx, client, owner, repo, resourceIDInt)
+				result, payload, err := downloadWorkflowArtifact(ctx, client, owner, repo, resourceIDInt, filePath, deps.GetContentWindowSize())
 				return attachIFC(result), payload, err
 			case actionsMethodGetWorkflowRunUsage:
 				result, payload, err := getWorkflowRunUsage(ctx, client, owner, repo, resourceIDInt)
@@ -518,6 +727,25 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 			case actionsMethodGetWorkflowRunLogsURL:
 				result, payload, err := getWorkflowRunLogsURL(ctx, client, owner, repo, resourceIDInt)
 				return attachIFC(result), payload, err
+			case actionsMethodDownloadWorkflowRunLogs:
+				result, payload, err := downloadWorkflowRunLogs(ctx, client, owner, repo, resourceIDInt, returnContent, max(tailLines, 1), deps.GetContentWindowSize())
+				return attachIFC(result), payload, err
+			case actionsMethodGetWorkflowRunAttempt:
+				result, payload, err := getWorkflowRunAttempt(ctx, client, owner, repo, resourceIDInt, attemptNumber, deps.GetMaxWorkflowJobPages())
+				return attachIFC(result), payload, err
+			case actionsMethodGetWorkflowRunTiming:
+				result, payload, err := getWorkflowRunTiming(ctx, client, owner, repo, resourceIDInt, deps.GetMaxWorkflowJobPages())
+				return attachIFC(result), payload, err
+			case actionsMethodGetWorkflowSuccessRate:
+				result, payload, err := getWorkflowSuccessRate(ctx, client, owner, repo, resourceID, min(max(lookback, 1), maxWorkflowSuccessRateLookback))
+				return attachIFC(result), payload, err
+			case actionsMethodGetWorkflowUsage:
+				result, payload, err := getWorkflowUsage(ctx, client, owner, repo, resourceID)
+				return attachIFC(result), payload, err
+			case actionsMethodWaitForWorkflowRun:
+				result, payload, err := waitForWorkflowRun(ctx, client, owner, repo, resourceIDInt,
+					time.Duration(max(pollInterval, 1))*time.Second, time.Duration(max(timeout, 1))*time.Second)
+				return attachIFC(result), payload, err
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
@@ -532,7 +760,7 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 		ToolsetMetadataActions,
 		mcp.Tool{
 			Name:        "actions_run_trigger",
-			Description: t("TOOL_ACTIONS_RUN_TRIGGER_DESCRIPTION", "Trigger GitHub Actions workflow operations, including running, re-running, cancelling workflow runs, and deleting workflow run logs."),
+			Description: t("TOOL_ACTIONS_RUN_TRIGGER_DESCRIPTION", "Trigger GitHub Actions workflow operations, including running, re-running, cancelling, and deleting workflow runs, deleting workflow run logs, and deleting Actions caches."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_ACTIONS_RUN_TRIGGER_USER_TITLE", "Trigger GitHub Actions workflow actions"),
 				ReadOnlyHint:    false,
@@ -550,6 +778,9 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 							actionsMethodRerunFailedJobs,
 							actionsMethodCancelWorkflowRun,
 							actionsMethodDeleteWorkflowRunLogs,
+							actionsMethodCancelAllRuns,
+							actionsMethodDeleteWorkflowRun,
+							actionsMethodDeleteActionsCache,
 						},
 					},
 					"owner": {
@@ -562,20 +793,39 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 					},
 					"workflow_id": {
 						Type:        "string",
-						Description: "The workflow ID (numeric) or workflow file name (e.g., main.yml, ci.yaml). Required for 'run_workflow' method.",
+						Description: "The workflow ID (numeric) or workflow file name (e.g., main.yml, ci.yaml). Required for 'run_workflow' method. Optionally used to filter runs for 'cancel_all_runs' method.",
+					},
+					"branch": {
+						Type:        "string",
+						Description: "Only cancel runs on this branch. Only used for 'cancel_all_runs' method.",
+					},
+					"actor": {
+						Type:        "string",
+						Description: "Only cancel runs triggered by this user. Only used for 'cancel_all_runs' method.",
 					},
 					"ref": {
 						Type:        "string",
-						Description: "The git reference for the workflow. The reference can be a branch or tag name. Required for 'run_workflow' method.",
+						Description: "The git reference for the workflow. The reference can be a branch or tag name. Required for 'run_workflow' method. For 'delete_actions_cache' with cache_key, limits the deletion to caches for this ref (e.g. refs/heads/main).",
 					},
 					"inputs": {
 						Type:        "object",
-						Description: "Inputs the workflow accepts. Only used for 'run_workflow' method.",
+						Description: "Inputs the workflow accepts, as a map of input names to string values. Only used for 'run_workflow' method.",
 						Properties:  map[string]*jsonschema.Schema{},
+						AdditionalProperties: &jsonschema.Schema{
+							Type: "string",
+						},
 					},
 					"run_id": {
 						Type:        "number",
-						Description: "The ID of the workflow run. Required for all methods except 'run_workflow'.",
+						Description: "The ID of the workflow run. Required for all methods except 'run_workflow', 'cancel_all_runs', and 'delete_actions_cache'.",
+					},
+					"cache_id": {
+						Type:        "number",
+						Description: "The ID of the Actions cache to delete. Provide either cache_id or cache_key for 'delete_actions_cache' method.",
+					},
+					"cache_key": {
+						Type:        "string",
+						Description: "The key of the Actions caches to delete; every cache with this key is deleted. Provide either cache_id or cache_key for 'delete_actions_cache' method.",
 					},
 				},
 				Required: []string{"method", "owner", "repo"},
@@ -599,6 +849,8 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 			// Get optional parameters
 			workflowID, _ := OptionalParam[string](args, "workflow_id")
 			ref, _ := OptionalParam[string](args, "ref")
+			branch, _ := OptionalParam[string](args, "branch")
+			actor, _ := OptionalParam[string](args, "actor")
 			runID, _ := OptionalIntParam(args, "run_id")
 
 			// Get optional inputs parameter
@@ -615,7 +867,10 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 				if ref == "" {
 					return utils.NewToolResultError("ref is required for run_workflow action"), nil, nil
 				}
-			} else if runID == 0 {
+				if err := validateWorkflowDispatchInputs(inputs); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			} else if method != actionsMethodCancelAllRuns && method != actionsMethodDeleteActionsCache && runID == 0 {
 				return utils.NewToolResultError("missing required parameter: run_id"), nil, nil
 			}
 
@@ -635,6 +890,12 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 				return cancelWorkflowRun(ctx, client, owner, repo, int64(runID))
 			case actionsMethodDeleteWorkflowRunLogs:
 				return deleteWorkflowRunLogs(ctx, client, owner, repo, int64(runID))
+			case actionsMethodCancelAllRuns:
+				return cancelAllWorkflowRuns(ctx, client, owner, repo, workflowID, branch, actor)
+			case actionsMethodDeleteWorkflowRun:
+				return deleteWorkflowRun(ctx, client, owner, repo, int64(runID))
+			case actionsMethodDeleteActionsCache:
+				return deleteActionsCache(ctx, client, args, owner, repo, ref)
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
@@ -756,7 +1017,7 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 
 			if failedOnly && runID > 0 {
 				// Handle failed-only mode: get logs for all failed jobs in the workflow run
-				result, payload, err := handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, tailLines, deps.GetContentWindowSize())
+				result, payload, err := handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, tailLines, deps.GetContentWindowSize(), deps.GetMaxWorkflowJobPages())
 				return attachIFC(result), payload, err
 			} else if jobID > 0 {
 				// Handle single job mode
@@ -770,6 +1031,187 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 	return tool
 }
 
+// defaultDiagnoseTailLines is how many trailing log lines diagnose_workflow_run
+// inspects per failed job when tail_lines is not given.
+const defaultDiagnoseTailLines = 20
+
+// ActionsDiagnoseWorkflowRun returns the tool and handler for summarizing why a workflow run failed.
+func ActionsDiagnoseWorkflowRun(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "diagnose_workflow_run",
+			Description: t("TOOL_DIAGNOSE_WORKFLOW_RUN_DESCRIPTION", `Summarize why a GitHub Actions workflow run failed.
+Returns each failed job with the step that failed, the error annotations from its log, and the last lines of the log, so the cause can be read without fetching jobs and logs separately.
+`),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DIAGNOSE_WORKFLOW_RUN_USER_TITLE", "Diagnose a failed workflow run"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"run_id": {
+						Type:        "number",
+						Description: "The unique identifier of the workflow run",
+					},
+					"tail_lines": {
+						Type:        "number",
+						Description: "Number of lines to inspect from the end of each failed job's log",
+						Default:     json.RawMessage(`20`),
+					},
+				},
+				Required: []string{"owner", "repo", "run_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			runID, err := RequiredBigInt(args, "run_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			tailLines, err := OptionalIntParamWithDefault(args, "tail_lines", defaultDiagnoseTailLines)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if tailLines <= 0 {
+				tailLines = defaultDiagnoseTailLines
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result, payload, err := diagnoseWorkflowRun(ctx, client, owner, repo, runID, tailLines, deps.GetContentWindowSize(), deps.GetMaxWorkflowJobPages())
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), payload, err
+		},
+	)
+	return tool
+}
+
+// failedJobDiagnosis is the per-job entry of a diagnose_workflow_run summary.
+type failedJobDiagnosis struct {
+	JobID      int64    `json:"job_id"`
+	JobName    string   `json:"job_name"`
+	FailedStep string   `json:"failed_step,omitempty"`
+	ErrorLines []string `json:"error_lines,omitempty"`
+	LastLines  []string `json:"last_lines,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// diagnoseWorkflowRun lists the failed jobs of a run and extracts the tail of
+// each one's log. The content window is shared between the failed jobs so the
+// summary stays bounded however many jobs failed.
+func diagnoseWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, runID int64, tailLines int, contentWindowSize int, maxJobPages int) (*mcp.CallToolResult, any, error) {
+	jobs, truncated, resp, err := listWorkflowJobPages(maxJobPages, func(opts github.ListOptions) (*github.Jobs, *github.Response, error) {
+		return client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{Filter: "latest", ListOptions: opts})
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil, nil
+	}
+
+	var failedJobs []*github.WorkflowJob
+	for _, job := range jobs.Jobs {
+		if job.GetConclusion() == "failure" {
+			failedJobs = append(failedJobs, job)
+		}
+	}
+
+	diagnoses := make([]failedJobDiagnosis, 0, len(failedJobs))
+	if len(failedJobs) > 0 {
+		maxLines := max(contentWindowSize/len(failedJobs), 1)
+		lines := min(tailLines, maxLines)
+		for _, job := range failedJobs {
+			diagnoses = append(diagnoses, diagnoseFailedJob(ctx, client, owner, repo, job, lines))
+		}
+	}
+
+	result := map[string]any{
+		"run_id":      runID,
+		"total_jobs":  jobs.GetTotalCount(),
+		"failed_jobs": diagnoses,
+	}
+	if len(failedJobs) == 0 {
+		result["message"] = "No failed jobs found in this workflow run"
+	}
+	if truncated {
+		result["jobs_truncated"] = true
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+func diagnoseFailedJob(ctx context.Context, client *github.Client, owner, repo string, job *github.WorkflowJob, tailLines int) failedJobDiagnosis {
+	diagnosis := failedJobDiagnosis{
+		JobID:   job.GetID(),
+		JobName: job.GetName(),
+	}
+	for _, step := range job.Steps {
+		if step.GetConclusion() == "failure" {
+			diagnosis.FailedStep = step.GetName()
+			break
+		}
+	}
+
+	url, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, job.GetID(), 1)
+	if err != nil {
+		diagnosis.Error = fmt.Sprintf("failed to get job logs: %v", err)
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err)
+		return diagnosis
+	}
+	_ = resp.Body.Close()
+
+	content, _, _, err := downloadLogContent(ctx, url.String(), tailLines, tailLines) //nolint:bodyclose // Response body is closed in downloadLogContent
+	if err != nil {
+		diagnosis.Error = err.Error()
+		return diagnosis
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		line = stripLogTimestamp(line)
+		if msg, ok := strings.CutPrefix(line, "##[error]"); ok {
+			diagnosis.ErrorLines = append(diagnosis.ErrorLines, msg)
+		}
+		diagnosis.LastLines = append(diagnosis.LastLines, line)
+	}
+	return diagnosis
+}
+
+// stripLogTimestamp removes the RFC 3339 timestamp GitHub Actions prefixes to
+// every log line.
+func stripLogTimestamp(line string) string {
+	ts, rest, ok := strings.Cut(line, " ")
+	if !ok {
+		return line
+	}
+	if _, err := time.Parse(time.RFC3339Nano, ts); err != nil {
+		return line
+	}
+	return rest
+}
+
 // Helper functions for consolidated actions tools
 
 func getWorkflow(ctx context.Context, client *github.Client, owner, repo, resourceID string) (*mcp.CallToolResult, any, error) {
@@ -796,19 +1238,64 @@ func getWorkflow(ctx context.Context, client *github.Client, owner, repo, resour
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func getWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
+func getWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, resourceID int64, includeJobs bool, maxJobPages int) (*mcp.CallToolResult, any, error) {
 	workflowRun, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, resourceID)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", resp, err), nil, nil
 	}
-	defer func() { _ = resp.Body.Close() }()
-	r, err := json.Marshal(workflowRun)
+	_ = resp.Body.Close()
+
+	var payload any = workflowRun
+	if includeJobs {
+		jobs, truncated, resp, err := listWorkflowJobPages(maxJobPages, func(opts github.ListOptions) (*github.Jobs, *github.Response, error) {
+			return client.Actions.ListWorkflowJobs(ctx, owner, repo, resourceID, &github.ListWorkflowJobsOptions{Filter: "latest", ListOptions: opts})
+		})
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil, nil
+		}
+
+		payload = struct {
+			*github.WorkflowRun
+			Jobs          []map[string]any `json:"jobs"`
+			JobsTruncated bool             `json:"jobs_truncated,omitempty"`
+		}{workflowRun, workflowJobSummaries(jobs.Jobs), truncated}
+	}
+
+	r, err := json.Marshal(payload)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal workflow run: %w", err)
 	}
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// workflowJobSummaries reduces jobs to their outcome and the outcome of each
+// step, which is what a caller needs to see where a run stands.
+func workflowJobSummaries(jobs []*github.WorkflowJob) []map[string]any {
+	summaries := make([]map[string]any, 0, len(jobs))
+	for _, job := range jobs {
+		steps := make([]map[string]any, 0, len(job.Steps))
+		for _, step := range job.Steps {
+			steps = append(steps, map[string]any{
+				"number":     step.GetNumber(),
+				"name":       step.GetName(),
+				"status":     step.GetStatus(),
+				"conclusion": step.GetConclusion(),
+			})
+		}
+		summaries = append(summaries, map[string]any{
+			"id":           job.GetID(),
+			"name":         job.GetName(),
+			"status":       job.GetStatus(),
+			"conclusion":   job.GetConclusion(),
+			"started_at":   job.StartedAt,
+			"completed_at": job.CompletedAt,
+			"html_url":     job.GetHTMLURL(),
+			"steps":        steps,
+		})
+	}
+	return summaries
+}
+
 func getWorkflowJob(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
 	workflowJob, resp, err := client.Actions.GetWorkflowJobByID(ctx, owner, repo, resourceID)
 	if err != nil {
@@ -842,6 +1329,13 @@ func listWorkflows(ctx context.Context, client *github.Client, owner, repo strin
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// workflowRunStatuses are the values the workflow runs API accepts for its
+// status filter: run statuses as well as run conclusions.
+var workflowRunStatuses = []any{
+	"queued", "in_progress", "completed", "requested", "waiting", "pending",
+	"action_required", "cancelled", "failure", "neutral", "skipped", "stale", "success", "timed_out",
+}
+
 func listWorkflowRuns(ctx context.Context, client *github.Client, args map[string]any, owner, repo, resourceID string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
 	filterArgs, err := OptionalParam[map[string]any](args, "workflow_runs_filter")
 	if err != nil {
@@ -857,6 +1351,10 @@ func listWorkflowRuns(ctx context.Context, client *github.Client, args map[strin
 		}
 	}
 
+	if status := filterArgsTyped["status"]; status != "" && !slices.Contains(workflowRunStatuses, any(status)) {
+		return utils.NewToolResultError(fmt.Sprintf("invalid workflow_runs_filter.status %q: must be one of %v", status, workflowRunStatuses)), nil, nil
+	}
+
 	listWorkflowRunsOptions := &github.ListWorkflowRunsOptions{
 		Actor:  filterArgsTyped["actor"],
 		Branch: filterArgsTyped["branch"],
@@ -937,7 +1435,14 @@ func listWorkflowArtifacts(ctx context.Context, client *github.Client, owner, re
 		Page:    pagination.Page,
 	}
 
-	artifacts, resp, err := client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, resourceID, opts)
+	var artifacts *github.ArtifactList
+	var resp *github.Response
+	var err error
+	if resourceID == 0 {
+		artifacts, resp, err = client.Actions.ListArtifacts(ctx, owner, repo, &github.ListArtifactsOptions{ListOptions: *opts})
+	} else {
+		artifacts, resp, err = client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, resourceID, opts)
+	}
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow run artifacts", resp, err), nil, nil
 	}
@@ -951,45 +1456,45 @@ func listWorkflowArtifacts(ctx context.Context, client *github.Client, owner, re
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func downloadWorkflowArtifact(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
-	// Get the download URL for the artifact
-	url, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, resourceID, 1)
+// artifactDownloadURL returns the signed URL of an artifact's ZIP archive, or
+// an error result explaining that the artifact has expired.
+func artifactDownloadURL(ctx context.Context, client *github.Client, owner, repo string, artifactID int64) (*url.URL, *mcp.CallToolResult) {
+	downloadURL, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, artifactID, 1)
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact download URL", resp, err), nil, nil
+		if resp != nil && resp.StatusCode == http.StatusGone {
+			return nil, utils.NewToolResultError(fmt.Sprintf("artifact %d is no longer available: it has expired or was deleted (HTTP 410 Gone)", artifactID))
+		}
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact download URL", resp, err)
 	}
-	defer func() { _ = resp.Body.Close() }()
+	_ = resp.Body.Close()
+	return downloadURL, nil
+}
 
-	// Create response with the download URL and information
-	result := map[string]any{
-		"download_url": url.String(),
-		"message":      "Artifact is available for download",
-		"note":         "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
-		"artifact_id":  resourceID,
+func downloadWorkflowArtifact(ctx context.Context, client *github.Client, owner, repo string, resourceID int64, filePath string, contentWindowSize int) (*mcp.CallToolResult, any, error) {
+	downloadURL, errResult := artifactDownloadURL(ctx, client, owner, repo, resourceID)
+	if errResult != nil {
+		return errResult, nil, nil
 	}
 
-	r, err := json.Marshal(result)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	if filePath != "" {
+		return extractArtifactFile(ctx, downloadURL.String(), resourceID, filePath, contentWindowSize)
 	}
 
-	return utils.NewToolResultText(string(r)), nil, nil
-}
-
-func getWorkflowRunLogsURL(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
-	// Get the download URL for the logs
-	url, resp, err := client.Actions.GetWorkflowRunLogs(ctx, owner, repo, runID, 1)
+	artifact, resp, err := client.Actions.GetArtifact(ctx, owner, repo, resourceID)
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run logs", resp, err), nil, nil
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact", resp, err), nil, nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	// Create response with the logs URL and information
+	// Create response with the download URL and information
 	result := map[string]any{
-		"logs_url":         url.String(),
-		"message":          "Workflow run logs are available for download",
-		"note":             "The logs_url provides a download link for the complete workflow run logs as a ZIP archive. You can download this archive to extract and examine individual job logs.",
-		"warning":          "This downloads ALL logs as a ZIP file which can be large and expensive. For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id instead.",
-		"optimization_tip": "Use: get_job_logs with parameters {run_id: " + fmt.Sprintf("%d", runID) + ", failed_only: true} for more efficient failed job debugging",
+		"download_url":  downloadURL.String(),
+		"message":       "Artifact is available for download",
+		"note":          "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
+		"artifact_id":   resourceID,
+		"name":          artifact.GetName(),
+		"size_in_bytes": artifact.GetSizeInBytes(),
+		"expires_at":    artifact.ExpiresAt,
 	}
 
 	r, err := json.Marshal(result)
@@ -1000,12 +1505,253 @@ func getWorkflowRunLogsURL(ctx context.Context, client *github.Client, owner, re
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func getWorkflowRunUsage(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
-	usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, resourceID)
-	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run usage", resp, err), nil, nil
-	}
-	defer func() { _ = resp.Body.Close() }()
+// maxArtifactArchiveSize bounds how much of an artifact archive is downloaded
+// to extract a single file from it. The archive is spooled to a temporary file
+// rather than held in memory.
+const maxArtifactArchiveSize = 100 << 20
+
+// maxArtifactTextFileSize bounds how much of a text file is read from an
+// artifact before it is cut down to the requested number of lines.
+const maxArtifactTextFileSize = 10 << 20
+
+// maxArtifactBinaryFileSize bounds the size of a binary file returned from an
+// artifact as base64, matching what get_file_contents returns inline.
+const maxArtifactBinaryFileSize = 1 << 20
+
+// zipArchive is a downloaded ZIP archive backed by a temporary file.
+type zipArchive struct {
+	*zip.Reader
+	file *os.File
+}
+
+// Close closes and removes the temporary file backing the archive.
+func (a *zipArchive) Close() error {
+	_ = a.file.Close()
+	return os.Remove(a.file.Name())
+}
+
+// downloadZipArchive downloads the ZIP archive at archiveURL, up to
+// maxArtifactArchiveSize, into a temporary file and opens it. The caller must
+// close the returned archive. what names the archive in errors.
+func downloadZipArchive(ctx context.Context, archiveURL, what string) (*zipArchive, *mcp.CallToolResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s download request: %w", what, err)
+	}
+	httpResp, err := http.DefaultClient.Do(req) //nolint:gosec // URL comes from the GitHub API
+	if err != nil {
+		return nil, utils.NewToolResultErrorFromErr("failed to download "+what, err), nil
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, utils.NewToolResultError(fmt.Sprintf("failed to download %s: HTTP %d", what, httpResp.StatusCode)), nil
+	}
+
+	file, err := os.CreateTemp("", "github-mcp-server-*.zip")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create temporary file for %s: %w", what, err)
+	}
+	archive := &zipArchive{file: file}
+	size, err := io.Copy(file, io.LimitReader(httpResp.Body, maxArtifactArchiveSize+1))
+	if err != nil {
+		_ = archive.Close()
+		return nil, utils.NewToolResultErrorFromErr("failed to download "+what, err), nil
+	}
+	if size > maxArtifactArchiveSize {
+		_ = archive.Close()
+		return nil, utils.NewToolResultError(fmt.Sprintf("%s archive is larger than %d MB; use the download URL instead", what, maxArtifactArchiveSize>>20)), nil
+	}
+
+	archive.Reader, err = zip.NewReader(file, size)
+	if err != nil {
+		_ = archive.Close()
+		return nil, utils.NewToolResultErrorFromErr("failed to read "+what+" archive", err), nil
+	}
+	return archive, nil, nil
+}
+
+// extractArtifactFile downloads an artifact's ZIP archive and returns the
+// content of the entry at filePath. Text content is limited to maxLines lines.
+func extractArtifactFile(ctx context.Context, archiveURL string, artifactID int64, filePath string, maxLines int) (*mcp.CallToolResult, any, error) {
+	zr, errResult, err := downloadZipArchive(ctx, archiveURL, "artifact")
+	if errResult != nil || err != nil {
+		return errResult, nil, err
+	}
+	defer func() { _ = zr.Close() }()
+	filePath = strings.TrimPrefix(filePath, "/")
+	var entry *zip.File
+	for _, f := range zr.File {
+		if f.Name == filePath {
+			entry = f
+			break
+		}
+	}
+	if entry == nil {
+		names := make([]string, 0, len(zr.File))
+		for _, f := range zr.File {
+			if !f.FileInfo().IsDir() {
+				names = append(names, f.Name)
+			}
+		}
+		return utils.NewToolResultError(fmt.Sprintf("file %q not found in artifact; available files: %s", filePath, strings.Join(names, ", "))), nil, nil
+	}
+
+	rc, err := entry.Open()
+	if err != nil {
+		return utils.NewToolResultErrorFromErr("failed to open file in artifact", err), nil, nil
+	}
+	defer func() { _ = rc.Close() }()
+
+	result := map[string]any{
+		"artifact_id": artifactID,
+		"file_path":   filePath,
+		"size":        entry.UncompressedSize64,
+	}
+	// Content sniffing only looks at the first 512 bytes.
+	br := bufio.NewReader(rc)
+	head, err := br.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return utils.NewToolResultErrorFromErr("failed to read file in artifact", err), nil, nil
+	}
+	if isTextContentType(http.DetectContentType(head)) {
+		content, err := io.ReadAll(io.LimitReader(br, maxArtifactTextFileSize+1))
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read file in artifact", err), nil, nil
+		}
+		if len(content) > maxArtifactTextFileSize {
+			content = content[:maxArtifactTextFileSize]
+			result["truncated"] = true
+		}
+		text := string(content)
+		if lines := strings.SplitAfter(strings.TrimSuffix(text, "\n"), "\n"); len(lines) > maxLines {
+			text = strings.Join(lines[:max(maxLines, 1)], "")
+			result["truncated"] = true
+		}
+		result["content"] = text
+	} else {
+		content, err := io.ReadAll(io.LimitReader(br, maxArtifactBinaryFileSize+1))
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read file in artifact", err), nil, nil
+		}
+		if len(content) > maxArtifactBinaryFileSize {
+			return utils.NewToolResultError(fmt.Sprintf("binary file %q is larger than %d MB; use the download URL instead", filePath, maxArtifactBinaryFileSize>>20)), nil, nil
+		}
+		result["content_base64"] = base64.StdEncoding.EncodeToString(content)
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// workflowRunLogsArchiveURL returns the signed URL of a workflow run's log
+// archive, or an error result explaining that the logs have expired when
+// GitHub answers 410 Gone.
+func workflowRunLogsArchiveURL(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*url.URL, *mcp.CallToolResult) {
+	logsURL, resp, err := client.Actions.GetWorkflowRunLogs(ctx, owner, repo, runID, 1)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusGone {
+			return nil, utils.NewToolResultError(fmt.Sprintf("logs for workflow run %d are no longer available: they have expired or were deleted (HTTP 410 Gone)", runID))
+		}
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run logs", resp, err)
+	}
+	_ = resp.Body.Close()
+	return logsURL, nil
+}
+
+// downloadWorkflowRunLogs returns the signed URL of a workflow run's log
+// archive, or with returnContent the last lines of each job's log from it.
+// Lines are capped at tailLines per job and contentWindowSize overall.
+func downloadWorkflowRunLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines, contentWindowSize int) (*mcp.CallToolResult, any, error) {
+	logsURL, errResult := workflowRunLogsArchiveURL(ctx, client, owner, repo, runID)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	result := map[string]any{
+		"run_id":   runID,
+		"logs_url": logsURL.String(),
+	}
+	if !returnContent {
+		result["message"] = "Workflow run logs are available for download as a ZIP archive. Use return_content=true to get the log content."
+		return MarshalledTextResult(result), nil, nil
+	}
+
+	zr, errResult, err := downloadZipArchive(ctx, logsURL.String(), "workflow run logs")
+	if errResult != nil || err != nil {
+		return errResult, nil, err
+	}
+	defer func() { _ = zr.Close() }()
+
+	// The archive holds one top-level file per job with its full log, and a
+	// directory per job repeating the log split by step.
+	var jobLogs []*zip.File
+	for _, f := range zr.File {
+		if !f.FileInfo().IsDir() && !strings.Contains(f.Name, "/") {
+			jobLogs = append(jobLogs, f)
+		}
+	}
+	if len(jobLogs) == 0 {
+		return utils.NewToolResultError(fmt.Sprintf("workflow run %d logs archive contains no job logs", runID)), nil, nil
+	}
+
+	linesPerJob := min(tailLines, max(contentWindowSize/len(jobLogs), 1))
+	logs := make([]map[string]any, 0, len(jobLogs))
+	for _, f := range jobLogs {
+		rc, err := f.Open()
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to open "+f.Name+" in logs archive", err), nil, nil
+		}
+		content, totalLines, _, err := buffer.ProcessResponseAsRingBufferToEnd(&http.Response{Body: rc}, linesPerJob) //nolint:bodyclose // rc is closed below
+		_ = rc.Close()
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read "+f.Name+" in logs archive", err), nil, nil
+		}
+		logs = append(logs, map[string]any{
+			"file":            f.Name,
+			"logs_content":    content,
+			"original_length": totalLines,
+		})
+	}
+	result["logs"] = logs
+	result["message"] = "Workflow run logs content retrieved successfully"
+
+	return MarshalledTextResult(result), nil, nil
+}
+
+func getWorkflowRunLogsURL(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
+	// Get the download URL for the logs
+	url, errResult := workflowRunLogsArchiveURL(ctx, client, owner, repo, runID)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	// Create response with the logs URL and information
+	result := map[string]any{
+		"logs_url":         url.String(),
+		"message":          "Workflow run logs are available for download",
+		"note":             "The logs_url provides a download link for the complete workflow run logs as a ZIP archive. You can download this archive to extract and examine individual job logs.",
+		"warning":          "This downloads ALL logs as a ZIP file which can be large and expensive. For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id instead.",
+		"optimization_tip": "Use: get_job_logs with parameters {run_id: " + fmt.Sprintf("%d", runID) + ", failed_only: true} for more efficient failed job debugging",
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+func getWorkflowRunUsage(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
+	usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, resourceID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run usage", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
 
 	r, err := json.Marshal(usage)
 	if err != nil {
@@ -1015,6 +1761,272 @@ func getWorkflowRunUsage(ctx context.Context, client *github.Client, owner, repo
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// getWorkflowUsage reports the billable time of a workflow in the current
+// billing cycle, broken down by runner OS.
+func getWorkflowUsage(ctx context.Context, client *github.Client, owner, repo, resourceID string) (*mcp.CallToolResult, any, error) {
+	var usage *github.WorkflowUsage
+	var resp *github.Response
+	var err error
+
+	if workflowIDInt, parseErr := strconv.ParseInt(resourceID, 10, 64); parseErr == nil {
+		usage, resp, err = client.Actions.GetWorkflowUsageByID(ctx, owner, repo, workflowIDInt)
+	} else {
+		usage, resp, err = client.Actions.GetWorkflowUsageByFileName(ctx, owner, repo, resourceID)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow usage", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	billable := map[string]int64{}
+	var totalMS int64
+	if usage.Billable != nil {
+		for runnerOS, bill := range *usage.Billable {
+			billable[runnerOS] = bill.GetTotalMS()
+			totalMS += bill.GetTotalMS()
+		}
+	}
+
+	result := map[string]any{
+		"workflow_id":       resourceID,
+		"billable_ms":       billable,
+		"total_billable_ms": totalMS,
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+func getWorkflowRunAttempt(ctx context.Context, client *github.Client, owner, repo string, runID int64, attemptNumber int, maxJobPages int) (*mcp.CallToolResult, any, error) {
+	run, resp, err := client.Actions.GetWorkflowRunAttempt(ctx, owner, repo, runID, attemptNumber, nil)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run attempt", resp, err), nil, nil
+	}
+	_ = resp.Body.Close()
+
+	jobs, truncated, resp, err := listWorkflowJobPages(maxJobPages, func(opts github.ListOptions) (*github.Jobs, *github.Response, error) {
+		return client.Actions.ListWorkflowJobsAttempt(ctx, owner, repo, runID, int64(attemptNumber), &opts)
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow run attempt jobs", resp, err), nil, nil
+	}
+
+	result := map[string]any{
+		"run_id":         runID,
+		"attempt_number": attemptNumber,
+		"name":           run.GetName(),
+		"status":         run.GetStatus(),
+		"conclusion":     run.GetConclusion(),
+		"head_sha":       run.GetHeadSHA(),
+		"run_started_at": run.RunStartedAt,
+		"html_url":       run.GetHTMLURL(),
+		"total_jobs":     jobs.GetTotalCount(),
+		"jobs":           workflowJobSummaries(jobs.Jobs),
+	}
+	if truncated {
+		result["jobs_truncated"] = true
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// waitForWorkflowRun polls a workflow run every interval until it completes
+// or timeout elapses, and reports its final status and conclusion. The wait
+// is capped at maxWorkflowRunWaitTimeout and ends early if ctx is cancelled.
+func waitForWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, runID int64, interval, timeout time.Duration) (*mcp.CallToolResult, any, error) {
+	timeout = min(timeout, maxWorkflowRunWaitTimeout)
+	start := time.Now()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	polls := 0
+	for {
+		run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", resp, err), nil, nil
+		}
+		_ = resp.Body.Close()
+		polls++
+
+		timedOut := false
+		if run.GetStatus() != "completed" {
+			select {
+			case <-ctx.Done():
+				return utils.NewToolResultError(fmt.Sprintf("stopped waiting for workflow run %d: %v", runID, ctx.Err())), nil, nil
+			case <-deadline.C:
+				timedOut = true
+			case <-time.After(interval):
+				continue
+			}
+		}
+
+		result := map[string]any{
+			"run_id":     runID,
+			"status":     run.GetStatus(),
+			"conclusion": run.GetConclusion(),
+			"html_url":   run.GetHTMLURL(),
+			"polls":      polls,
+			"waited_ms":  time.Since(start).Milliseconds(),
+			"timed_out":  timedOut,
+		}
+		r, err := json.Marshal(result)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+		return utils.NewToolResultText(string(r)), nil, nil
+	}
+}
+
+// getWorkflowSuccessRate aggregates the conclusions and durations of a
+// workflow's most recent completed runs.
+func getWorkflowSuccessRate(ctx context.Context, client *github.Client, owner, repo, workflowID string, lookback int) (*mcp.CallToolResult, any, error) {
+	opts := &github.ListWorkflowRunsOptions{
+		Status:      "completed",
+		ListOptions: github.ListOptions{PerPage: lookback},
+	}
+
+	var runs *github.WorkflowRuns
+	var resp *github.Response
+	var err error
+	if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+		runs, resp, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repo, workflowIDInt, opts)
+	} else {
+		runs, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow runs", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	conclusions := make(map[string]int)
+	durations := make([]int64, 0, len(runs.WorkflowRuns))
+	for _, run := range runs.WorkflowRuns {
+		conclusions[run.GetConclusion()]++
+		if run.RunStartedAt != nil && run.UpdatedAt != nil {
+			durations = append(durations, run.UpdatedAt.Sub(run.RunStartedAt.Time).Milliseconds())
+		}
+	}
+
+	total := len(runs.WorkflowRuns)
+	rate := func(conclusion string) float64 {
+		if total == 0 {
+			return 0
+		}
+		return math.Round(float64(conclusions[conclusion])*1000/float64(total)) / 10
+	}
+
+	result := map[string]any{
+		"workflow_id":          workflowID,
+		"runs_analyzed":        total,
+		"conclusions":          conclusions,
+		"success_percent":      rate("success"),
+		"failure_percent":      rate("failure"),
+		"cancelled_percent":    rate("cancelled"),
+		"median_duration_ms":   medianInt64(durations),
+		"durations_considered": len(durations),
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// medianInt64 returns the median of values, or 0 when there are none.
+func medianInt64(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// getWorkflowRunTiming combines the billable usage of a run with the wall
+// clock duration of each of its jobs, so slow or flaky jobs stand out.
+func getWorkflowRunTiming(ctx context.Context, client *github.Client, owner, repo string, runID int64, maxJobPages int) (*mcp.CallToolResult, any, error) {
+	usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, runID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run usage", resp, err), nil, nil
+	}
+	_ = resp.Body.Close()
+
+	jobs, truncated, resp, err := listWorkflowJobPages(maxJobPages, func(opts github.ListOptions) (*github.Jobs, *github.Response, error) {
+		return client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{Filter: "latest", ListOptions: opts})
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil, nil
+	}
+
+	jobTimings := make([]map[string]any, 0, len(jobs.Jobs))
+	for _, job := range jobs.Jobs {
+		jobTiming := map[string]any{
+			"id":          job.GetID(),
+			"name":        job.GetName(),
+			"conclusion":  job.GetConclusion(),
+			"run_attempt": job.GetRunAttempt(),
+		}
+		if job.StartedAt != nil && job.CompletedAt != nil {
+			jobTiming["duration_ms"] = job.CompletedAt.Sub(job.StartedAt.Time).Milliseconds()
+		}
+		jobTimings = append(jobTimings, jobTiming)
+	}
+
+	result := map[string]any{
+		"run_id":          runID,
+		"run_duration_ms": usage.GetRunDurationMS(),
+		"billable":        usage.Billable,
+		"jobs":            jobTimings,
+	}
+	if truncated {
+		result["jobs_truncated"] = true
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// validateWorkflowDispatchInputs checks that every workflow_dispatch input is a
+// string. GitHub rejects other JSON types, including for boolean and number
+// inputs, whose values must be passed as "true" or "42".
+func validateWorkflowDispatchInputs(inputs map[string]any) error {
+	for _, name := range slices.Sorted(maps.Keys(inputs)) {
+		switch v := inputs[name].(type) {
+		case string:
+		case map[string]any:
+			return fmt.Errorf("input %q must be a string, got an object: workflow_dispatch inputs cannot be nested", name)
+		case []any:
+			return fmt.Errorf("input %q must be a string, got an array", name)
+		case float64:
+			return fmt.Errorf("input %q must be a string, got a number: pass it as %q", name, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			return fmt.Errorf("input %q must be a string, got a boolean: pass it as %q", name, strconv.FormatBool(v))
+		default:
+			return fmt.Errorf("input %q must be a string, got %T", name, v)
+		}
+	}
+	return nil
+}
+
 func runWorkflow(ctx context.Context, client *github.Client, owner, repo, workflowID, ref string, inputs map[string]any) (*mcp.CallToolResult, any, error) {
 	event := github.CreateWorkflowDispatchEventRequest{
 		Ref:    ref,
@@ -1029,7 +2041,9 @@ func runWorkflow(ctx context.Context, client *github.Client, owner, repo, workfl
 		_, resp, err = client.Actions.CreateWorkflowDispatchEventByID(ctx, owner, repo, workflowIDInt, event)
 		workflowType = "workflow_id"
 	} else {
-		_, resp, err = client.Actions.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowID, event)
+		// The dispatch endpoint takes the workflow file name, so a path such
+		// as .github/workflows/ci.yml is reduced to ci.yml.
+		_, resp, err = client.Actions.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, path.Base(workflowID), event)
 		workflowType = "workflow_file"
 	}
 
@@ -1056,10 +2070,20 @@ func runWorkflow(ctx context.Context, client *github.Client, owner, repo, workfl
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// rerunErrorResponse explains a refused re-run. GitHub answers 403 when the
+// run is too old to be re-run or the token may not write to Actions, which
+// the API message alone does not make obvious.
+func rerunErrorResponse(ctx context.Context, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		message += ": GitHub refused the re-run (HTTP 403). Workflow runs can only be re-run within 30 days of when they were created, and the token needs write access to Actions"
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+}
+
 func rerunWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
 	resp, err := client.Actions.RerunWorkflowByID(ctx, owner, repo, runID)
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to rerun workflow run", resp, err), nil, nil
+		return rerunErrorResponse(ctx, "failed to rerun workflow run", resp, err), nil, nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -1081,7 +2105,7 @@ func rerunWorkflowRun(ctx context.Context, client *github.Client, owner, repo st
 func rerunFailedJobs(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
 	resp, err := client.Actions.RerunFailedJobsByID(ctx, owner, repo, runID)
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to rerun failed jobs", resp, err), nil, nil
+		return rerunErrorResponse(ctx, "failed to rerun failed jobs", resp, err), nil, nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -1125,6 +2149,137 @@ func cancelWorkflowRun(ctx context.Context, client *github.Client, owner, repo s
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+func deleteWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
+	resp, err := client.Actions.DeleteWorkflowRun(ctx, owner, repo, runID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, deleteWorkflowRunErrMsg("failed to delete workflow run", resp), resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result := map[string]any{
+		"message":     "Workflow run has been deleted",
+		"run_id":      runID,
+		"status":      resp.Status,
+		"status_code": resp.StatusCode,
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// listActionsCaches lists a repository's Actions caches, optionally filtered
+// by ref and key prefix, most recently accessed first.
+func listActionsCaches(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+	filterArgs, err := OptionalParam[map[string]any](args, "actions_caches_filter")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	opts := &github.ActionsCacheListOptions{
+		ListOptions: github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		},
+	}
+	if ref, ok := filterArgs["ref"].(string); ok && ref != "" {
+		opts.Ref = github.Ptr(ref)
+	}
+	if key, ok := filterArgs["key"].(string); ok && key != "" {
+		opts.Key = github.Ptr(key)
+	}
+
+	caches, resp, err := client.Actions.ListCaches(ctx, owner, repo, opts)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list actions caches", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	entries := make([]map[string]any, 0, len(caches.ActionsCaches))
+	for _, cache := range caches.ActionsCaches {
+		entries = append(entries, map[string]any{
+			"id":               cache.GetID(),
+			"key":              cache.GetKey(),
+			"ref":              cache.GetRef(),
+			"size_in_bytes":    cache.GetSizeInBytes(),
+			"last_accessed_at": cache.LastAccessedAt,
+		})
+	}
+
+	r, err := json.Marshal(map[string]any{
+		"total_count": caches.TotalCount,
+		"caches":      entries,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// deleteActionsCache deletes a single Actions cache by ID, or every cache
+// with a given key, optionally limited to one ref.
+func deleteActionsCache(ctx context.Context, client *github.Client, args map[string]any, owner, repo, ref string) (*mcp.CallToolResult, any, error) {
+	cacheID, err := OptionalIntParam(args, "cache_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	cacheKey, err := OptionalParam[string](args, "cache_key")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	result := map[string]any{}
+	var resp *github.Response
+	switch {
+	case cacheID != 0 && cacheKey != "":
+		return utils.NewToolResultError("provide either cache_id or cache_key, not both"), nil, nil
+	case cacheID != 0:
+		resp, err = client.Actions.DeleteCachesByID(ctx, owner, repo, int64(cacheID))
+		result["message"] = "Actions cache has been deleted"
+		result["cache_id"] = cacheID
+	case cacheKey != "":
+		var refFilter *string
+		if ref != "" {
+			refFilter = github.Ptr(ref)
+		}
+		resp, err = client.Actions.DeleteCachesByKey(ctx, owner, repo, cacheKey, refFilter)
+		result["message"] = "Actions caches with the key have been deleted"
+		result["cache_key"] = cacheKey
+		if ref != "" {
+			result["ref"] = ref
+		}
+	default:
+		return utils.NewToolResultError("missing required parameter: cache_id or cache_key"), nil, nil
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete actions cache", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result["status_code"] = resp.StatusCode
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// deleteWorkflowRunErrMsg enhances error messages for workflow run deletion
+// failures. GitHub answers 403 both when the token lacks write access and
+// when the run has not finished yet, so point the caller at both causes.
+func deleteWorkflowRunErrMsg(base string, resp *github.Response) string {
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		return base + ". Workflow runs that are still queued or in progress cannot be deleted; " +
+			"cancel the run and wait for it to complete first, and check that the token has write access to Actions"
+	}
+	return base
+}
+
 func deleteWorkflowRunLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
 	resp, err := client.Actions.DeleteWorkflowRunLogs(ctx, owner, repo, runID)
 	if err != nil {
@@ -1146,3 +2301,101 @@ func deleteWorkflowRunLogs(ctx context.Context, client *github.Client, owner, re
 
 	return utils.NewToolResultText(string(r)), nil, nil
 }
+
+// listActiveWorkflowRuns returns up to limit runs that have not finished,
+// optionally scoped to a single workflow, branch and/or actor. truncated
+// reports whether more active runs exist than were returned.
+func listActiveWorkflowRuns(ctx context.Context, client *github.Client, owner, repo, workflowID, branch, actor string, limit int) (runs []*github.WorkflowRun, truncated bool, resp *github.Response, err error) {
+	for _, status := range activeWorkflowRunStatuses {
+		opts := &github.ListWorkflowRunsOptions{
+			Actor:       actor,
+			Branch:      branch,
+			Status:      status,
+			ListOptions: github.ListOptions{PerPage: 100},
+		}
+		for {
+			var workflowRuns *github.WorkflowRuns
+			if workflowID == "" {
+				workflowRuns, resp, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+			} else if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+				workflowRuns, resp, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repo, workflowIDInt, opts)
+			} else {
+				workflowRuns, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+			}
+			if err != nil {
+				return nil, false, resp, err
+			}
+			_ = resp.Body.Close()
+
+			remaining := limit - len(runs)
+			if len(workflowRuns.WorkflowRuns) > remaining {
+				return append(runs, workflowRuns.WorkflowRuns[:remaining]...), true, nil, nil
+			}
+			runs = append(runs, workflowRuns.WorkflowRuns...)
+
+			if resp.NextPage == 0 {
+				break
+			}
+			if len(runs) == limit {
+				return runs, true, nil, nil
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+	return runs, false, nil, nil
+}
+
+func cancelAllWorkflowRuns(ctx context.Context, client *github.Client, owner, repo, workflowID, branch, actor string) (*mcp.CallToolResult, any, error) {
+	runs, truncated, resp, err := listActiveWorkflowRuns(ctx, client, owner, repo, workflowID, branch, actor, maxCancelAllRuns)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow runs", resp, err), nil, nil
+	}
+
+	results := make([]map[string]any, 0, len(runs))
+	cancelled := 0
+	for _, run := range runs {
+		runResult := map[string]any{
+			"run_id": run.GetID(),
+			"name":   run.GetName(),
+			"branch": run.GetHeadBranch(),
+			"status": run.GetStatus(),
+		}
+
+		resp, err := client.Actions.CancelWorkflowRunByID(ctx, owner, repo, run.GetID())
+		var acceptedErr *github.AcceptedError
+		if err != nil && !errors.As(err, &acceptedErr) {
+			// Continue with other runs even if one fails
+			runResult["cancelled"] = false
+			runResult["error"] = err.Error()
+			if resp != nil {
+				runResult["status_code"] = resp.StatusCode
+			}
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to cancel workflow run", resp, err)
+		} else {
+			runResult["cancelled"] = true
+			cancelled++
+		}
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		results = append(results, runResult)
+	}
+
+	result := map[string]any{
+		"message":   fmt.Sprintf("Cancelled %d of %d active workflow runs", cancelled, len(runs)),
+		"total":     len(runs),
+		"cancelled": cancelled,
+		"failed":    len(runs) - cancelled,
+		"max_runs":  maxCancelAllRuns,
+		"truncated": truncated,
+		"runs":      results,
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
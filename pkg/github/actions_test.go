@@ -1,10 +1,18 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -207,6 +215,129 @@ func Test_ActionsList_ListWorkflowRuns(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 2, *response.TotalCount)
 	})
+
+	t.Run("filters are passed to the API", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepo: expectQueryParams(t, map[string]string{
+				"actor":    "octocat",
+				"branch":   "main",
+				"event":    "push",
+				"status":   "failure",
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(mockResponse(t, http.StatusOK, &github.WorkflowRuns{TotalCount: github.Ptr(0)})),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "list_workflow_runs",
+			"owner":  "owner",
+			"repo":   "repo",
+			"workflow_runs_filter": map[string]any{
+				"actor":  "octocat",
+				"branch": "main",
+				"event":  "push",
+				"status": "failure",
+			},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+	})
+
+	t.Run("unknown status is rejected", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "list_workflow_runs",
+			"owner":  "owner",
+			"repo":   "repo",
+			"workflow_runs_filter": map[string]any{
+				"status": "failed",
+			},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, `invalid workflow_runs_filter.status "failed"`)
+	})
+}
+
+func Test_ActionsList_ListWorkflowRunArtifacts(t *testing.T) {
+	toolDef := ActionsList(translations.NullTranslationHelper)
+
+	artifacts := &github.ArtifactList{
+		TotalCount: github.Ptr(int64(1)),
+		Artifacts: []*github.Artifact{
+			{
+				ID:          github.Ptr(int64(42)),
+				Name:        github.Ptr("coverage"),
+				SizeInBytes: github.Ptr(int64(2048)),
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		resourceID string
+		handlers   map[string]http.HandlerFunc
+	}{
+		{
+			name:       "artifacts of a workflow run",
+			resourceID: "123",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsRunsArtifactsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, artifacts),
+			},
+		},
+		{
+			name: "artifacts of the repository",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsArtifactsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, artifacts)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := toolDef.Handler(deps)
+
+			args := map[string]any{
+				"method": "list_workflow_run_artifacts",
+				"owner":  "owner",
+				"repo":   "repo",
+				"page":   float64(2),
+			}
+			if tc.resourceID != "" {
+				args["resource_id"] = tc.resourceID
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response github.ArtifactList
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			require.Len(t, response.Artifacts, 1)
+			assert.Equal(t, "coverage", response.Artifacts[0].GetName())
+		})
+	}
 }
 
 func Test_ActionsGet(t *testing.T) {
@@ -227,17 +358,1379 @@ func Test_ActionsGet(t *testing.T) {
 func Test_ActionsGet_GetWorkflow(t *testing.T) {
 	toolDef := ActionsGet(translations.NullTranslationHelper)
 
-	t.Run("successful workflow get", func(t *testing.T) {
+	t.Run("successful workflow get", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsWorkflowsByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				workflow := &github.Workflow{
+					ID:    github.Ptr(int64(1)),
+					Name:  github.Ptr("CI"),
+					Path:  github.Ptr(".github/workflows/ci.yml"),
+					State: github.Ptr("active"),
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(workflow)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":      "get_workflow",
+			"owner":       "owner",
+			"repo":        "repo",
+			"resource_id": "ci.yml",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response github.Workflow
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.NotNil(t, response.ID)
+		assert.Equal(t, "CI", *response.Name)
+	})
+}
+
+func Test_ActionsGet_GetWorkflowRun(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	t.Run("successful workflow run get", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				run := &github.WorkflowRun{
+					ID:         github.Ptr(int64(12345)),
+					Name:       github.Ptr("CI"),
+					Status:     github.Ptr("completed"),
+					Conclusion: github.Ptr("success"),
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(run)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":      "get_workflow_run",
+			"owner":       "owner",
+			"repo":        "repo",
+			"resource_id": "12345",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response github.WorkflowRun
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.NotNil(t, response.ID)
+		assert.Equal(t, int64(12345), *response.ID)
+
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &raw))
+		assert.NotContains(t, raw, "jobs")
+	})
+
+	t.Run("include_jobs embeds the run's jobs", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, &github.WorkflowRun{
+				ID:         github.Ptr(int64(12345)),
+				Name:       github.Ptr("CI"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+			}),
+			GetReposActionsRunsJobsByOwnerByRepoByRunID: expectQueryParams(t, map[string]string{
+				"filter":   "latest",
+				"per_page": "100",
+			}).andThen(mockResponse(t, http.StatusOK, &github.Jobs{
+				TotalCount: github.Ptr(1),
+				Jobs: []*github.WorkflowJob{
+					{
+						ID:         github.Ptr(int64(1)),
+						Name:       github.Ptr("test"),
+						Status:     github.Ptr("completed"),
+						Conclusion: github.Ptr("failure"),
+						Steps: []*github.TaskStep{
+							{Number: github.Ptr(int64(1)), Name: github.Ptr("Checkout"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+							{Number: github.Ptr(int64(2)), Name: github.Ptr("Run tests"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+						},
+					},
+				},
+			})),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":       "get_workflow_run",
+			"owner":        "owner",
+			"repo":         "repo",
+			"resource_id":  "12345",
+			"include_jobs": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response struct {
+			ID         int64  `json:"id"`
+			Conclusion string `json:"conclusion"`
+			Jobs       []struct {
+				ID         int64  `json:"id"`
+				Name       string `json:"name"`
+				Conclusion string `json:"conclusion"`
+				Steps      []struct {
+					Name       string `json:"name"`
+					Conclusion string `json:"conclusion"`
+				} `json:"steps"`
+			} `json:"jobs"`
+			JobsTruncated bool `json:"jobs_truncated"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, int64(12345), response.ID)
+		assert.Equal(t, "failure", response.Conclusion)
+		require.Len(t, response.Jobs, 1)
+		assert.Equal(t, "test", response.Jobs[0].Name)
+		assert.Equal(t, "failure", response.Jobs[0].Conclusion)
+		require.Len(t, response.Jobs[0].Steps, 2)
+		assert.Equal(t, "Run tests", response.Jobs[0].Steps[1].Name)
+		assert.Equal(t, "failure", response.Jobs[0].Steps[1].Conclusion)
+		assert.False(t, response.JobsTruncated)
+	})
+
+	t.Run("include_jobs reports truncated job listing", func(t *testing.T) {
+		var requests int
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, &github.WorkflowRun{ID: github.Ptr(int64(12345))}),
+			GetReposActionsRunsJobsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/actions/runs/12345/jobs?page=%d>; rel="next"`, requests+1))
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&github.Jobs{
+					TotalCount: github.Ptr(5000),
+					Jobs:       []*github.WorkflowJob{{ID: github.Ptr(int64(requests))}},
+				})
+			}),
+		})
+
+		deps := BaseDeps{Client: mustNewGHClient(t, mockedClient)}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":       "get_workflow_run",
+			"owner":        "owner",
+			"repo":         "repo",
+			"resource_id":  "12345",
+			"include_jobs": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, defaultMaxWorkflowJobPages, requests)
+		assert.Len(t, response["jobs"], defaultMaxWorkflowJobPages)
+		assert.Equal(t, true, response["jobs_truncated"])
+	})
+}
+
+func Test_ActionsGet_GetWorkflowRunAttempt(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	t.Run("successful workflow run attempt get", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsAttemptsByOwnerByRepoByRunIDByAttempt: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/owner/repo/actions/runs/12345/attempts/2", r.URL.Path)
+				run := &github.WorkflowRun{
+					ID:         github.Ptr(int64(12345)),
+					Name:       github.Ptr("CI"),
+					RunAttempt: github.Ptr(2),
+					Status:     github.Ptr("completed"),
+					Conclusion: github.Ptr("failure"),
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(run)
+			}),
+			GetReposActionsRunsAttemptsJobsByOwnerByRepoByRunIDByAttempt: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				jobs := &github.Jobs{TotalCount: github.Ptr(2)}
+				if r.URL.Query().Get("page") == "2" {
+					jobs.Jobs = []*github.WorkflowJob{
+						{ID: github.Ptr(int64(2)), Name: github.Ptr("test"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+					}
+				} else {
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/actions/runs/12345/attempts/2/jobs?page=2>; rel="next"`)
+					jobs.Jobs = []*github.WorkflowJob{
+						{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+					}
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(jobs)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":         "get_workflow_run_attempt",
+			"owner":          "owner",
+			"repo":           "repo",
+			"resource_id":    "12345",
+			"attempt_number": float64(2),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.Equal(t, float64(2), response["attempt_number"])
+		assert.Equal(t, "failure", response["conclusion"])
+		assert.Equal(t, float64(2), response["total_jobs"])
+		jobs, ok := response["jobs"].([]any)
+		require.True(t, ok)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "test", jobs[1].(map[string]any)["name"])
+		assert.Equal(t, "failure", jobs[1].(map[string]any)["conclusion"])
+		assert.NotContains(t, response, "jobs_truncated")
+	})
+
+	t.Run("reports truncated job listing", func(t *testing.T) {
+		var requests int
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsAttemptsByOwnerByRepoByRunIDByAttempt: mockResponse(t, http.StatusOK, &github.WorkflowRun{ID: github.Ptr(int64(12345))}),
+			GetReposActionsRunsAttemptsJobsByOwnerByRepoByRunIDByAttempt: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/actions/runs/12345/attempts/2/jobs?page=%d>; rel="next"`, requests+1))
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&github.Jobs{
+					TotalCount: github.Ptr(5000),
+					Jobs:       []*github.WorkflowJob{{ID: github.Ptr(int64(requests))}},
+				})
+			}),
+		})
+
+		deps := BaseDeps{Client: mustNewGHClient(t, mockedClient)}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":         "get_workflow_run_attempt",
+			"owner":          "owner",
+			"repo":           "repo",
+			"resource_id":    "12345",
+			"attempt_number": float64(2),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, defaultMaxWorkflowJobPages, requests)
+		assert.Len(t, response["jobs"], defaultMaxWorkflowJobPages)
+		assert.Equal(t, float64(5000), response["total_jobs"])
+		assert.Equal(t, true, response["jobs_truncated"])
+	})
+
+	t.Run("honours the configured job page limit", func(t *testing.T) {
+		var requests int
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsAttemptsByOwnerByRepoByRunIDByAttempt: mockResponse(t, http.StatusOK, &github.WorkflowRun{ID: github.Ptr(int64(12345))}),
+			GetReposActionsRunsAttemptsJobsByOwnerByRepoByRunIDByAttempt: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/actions/runs/12345/attempts/2/jobs?page=%d>; rel="next"`, requests+1))
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&github.Jobs{
+					TotalCount: github.Ptr(5000),
+					Jobs:       []*github.WorkflowJob{{ID: github.Ptr(int64(requests))}},
+				})
+			}),
+		})
+
+		deps := BaseDeps{Client: mustNewGHClient(t, mockedClient), MaxWorkflowJobPages: 2}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":         "get_workflow_run_attempt",
+			"owner":          "owner",
+			"repo":           "repo",
+			"resource_id":    "12345",
+			"attempt_number": float64(2),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 2, requests)
+		assert.Len(t, response["jobs"], 2)
+		assert.Equal(t, true, response["jobs_truncated"])
+	})
+
+	t.Run("missing attempt_number", func(t *testing.T) {
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":      "get_workflow_run_attempt",
+			"owner":       "owner",
+			"repo":        "repo",
+			"resource_id": "12345",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		assert.Equal(t, "missing required parameter for method get_workflow_run_attempt: attempt_number", textContent.Text)
+	})
+}
+
+func Test_ActionsGet_GetWorkflowRunTiming(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsRunsTimingByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			usage := &github.WorkflowRunUsage{
+				Billable: &github.WorkflowRunBillMap{
+					"UBUNTU": &github.WorkflowRunBill{
+						TotalMS: github.Ptr(int64(180000)),
+						Jobs:    github.Ptr(2),
+					},
+				},
+				RunDurationMS: github.Ptr(int64(240000)),
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(usage)
+		}),
+		GetReposActionsRunsJobsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			started := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+			jobs := &github.Jobs{
+				TotalCount: github.Ptr(2),
+				Jobs: []*github.WorkflowJob{
+					{
+						ID:          github.Ptr(int64(1)),
+						Name:        github.Ptr("build"),
+						Conclusion:  github.Ptr("success"),
+						StartedAt:   &github.Timestamp{Time: started},
+						CompletedAt: &github.Timestamp{Time: started.Add(time.Minute)},
+					},
+					{
+						ID:          github.Ptr(int64(2)),
+						Name:        github.Ptr("test"),
+						Conclusion:  github.Ptr("success"),
+						StartedAt:   &github.Timestamp{Time: started},
+						CompletedAt: &github.Timestamp{Time: started.Add(2 * time.Minute)},
+					},
+				},
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(jobs)
+		}),
+	})
+
+	client := mustNewGHClient(t, mockedClient)
+	deps := BaseDeps{
+		Client: client,
+	}
+	handler := toolDef.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"method":      "get_workflow_run_timing",
+		"owner":       "owner",
+		"repo":        "repo",
+		"resource_id": "12345",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		RunDurationMS int64                     `json:"run_duration_ms"`
+		Billable      github.WorkflowRunBillMap `json:"billable"`
+		Jobs          []struct {
+			Name       string `json:"name"`
+			DurationMS int64  `json:"duration_ms"`
+		} `json:"jobs"`
+	}
+	err = json.Unmarshal([]byte(textContent.Text), &response)
+	require.NoError(t, err)
+	assert.Equal(t, int64(240000), response.RunDurationMS)
+	require.Contains(t, response.Billable, "UBUNTU")
+	assert.Equal(t, int64(180000), response.Billable["UBUNTU"].GetTotalMS())
+	require.Len(t, response.Jobs, 2)
+	assert.Equal(t, int64(60000), response.Jobs[0].DurationMS)
+	assert.Equal(t, int64(120000), response.Jobs[1].DurationMS)
+}
+
+func Test_waitForWorkflowRun(t *testing.T) {
+	newRunClient := func(t *testing.T, completeAfter int) (*github.Client, *int) {
+		calls := 0
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepoByRunID: func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				run := &github.WorkflowRun{
+					ID:      github.Ptr(int64(12345)),
+					Status:  github.Ptr("in_progress"),
+					HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
+				}
+				if completeAfter > 0 && calls >= completeAfter {
+					run.Status = github.Ptr("completed")
+					run.Conclusion = github.Ptr("success")
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(run)
+			},
+		})
+		return mustNewGHClient(t, mockedClient), &calls
+	}
+
+	t.Run("returns the conclusion once the run completes", func(t *testing.T) {
+		client, calls := newRunClient(t, 2)
+
+		result, _, err := waitForWorkflowRun(context.Background(), client, "owner", "repo", 12345, time.Millisecond, time.Minute)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "completed", response["status"])
+		assert.Equal(t, "success", response["conclusion"])
+		assert.Equal(t, float64(2), response["polls"])
+		assert.Equal(t, false, response["timed_out"])
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("reports the last status when the timeout is hit", func(t *testing.T) {
+		client, calls := newRunClient(t, 0)
+
+		result, _, err := waitForWorkflowRun(context.Background(), client, "owner", "repo", 12345, 5*time.Millisecond, 30*time.Millisecond)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "in_progress", response["status"])
+		assert.Equal(t, true, response["timed_out"])
+		assert.GreaterOrEqual(t, *calls, 2)
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		client, _ := newRunClient(t, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result, _, err := waitForWorkflowRun(ctx, client, "owner", "repo", 12345, time.Minute, time.Minute)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+	})
+}
+
+func Test_ActionsGet_GetWorkflowSuccessRate(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	run := func(conclusion string, minutes int) *github.WorkflowRun {
+		return &github.WorkflowRun{
+			Status:       github.Ptr("completed"),
+			Conclusion:   github.Ptr(conclusion),
+			RunStartedAt: &github.Timestamp{Time: start},
+			UpdatedAt:    &github.Timestamp{Time: start.Add(time.Duration(minutes) * time.Minute)},
+		}
+	}
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowID: expectQueryParams(t, map[string]string{
+			"status":   "completed",
+			"per_page": "5",
+		}).andThen(mockResponse(t, http.StatusOK, &github.WorkflowRuns{
+			TotalCount: github.Ptr(5),
+			WorkflowRuns: []*github.WorkflowRun{
+				run("success", 4),
+				run("success", 6),
+				run("failure", 2),
+				run("cancelled", 1),
+				run("success", 5),
+			},
+		})),
+	})
+
+	client := mustNewGHClient(t, mockedClient)
+	deps := BaseDeps{
+		Client: client,
+	}
+	handler := toolDef.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"method":      "get_workflow_success_rate",
+		"owner":       "owner",
+		"repo":        "repo",
+		"resource_id": "ci.yml",
+		"lookback":    float64(5),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, float64(5), response["runs_analyzed"])
+	assert.Equal(t, float64(60), response["success_percent"])
+	assert.Equal(t, float64(20), response["failure_percent"])
+	assert.Equal(t, float64(20), response["cancelled_percent"])
+	assert.Equal(t, float64(4*time.Minute/time.Millisecond), response["median_duration_ms"])
+	assert.Equal(t, map[string]any{"success": float64(3), "failure": float64(1), "cancelled": float64(1)}, response["conclusions"])
+}
+
+func Test_ActionsGet_GetWorkflowUsage(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	usage := &github.WorkflowUsage{
+		Billable: &github.WorkflowBillMap{
+			"UBUNTU":  {TotalMS: github.Ptr(int64(180000))},
+			"WINDOWS": {TotalMS: github.Ptr(int64(60000))},
+		},
+	}
+
+	for _, resourceID := range []string{"161335", "ci.yml"} {
+		t.Run(resourceID, func(t *testing.T) {
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowID: expectPath(t, "/repos/owner/repo/actions/workflows/"+resourceID+"/timing").
+					andThen(mockResponse(t, http.StatusOK, usage)),
+			})
+
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, mockedClient),
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":      "get_workflow_usage",
+				"owner":       "owner",
+				"repo":        "repo",
+				"resource_id": resourceID,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response struct {
+				WorkflowID      string           `json:"workflow_id"`
+				BillableMS      map[string]int64 `json:"billable_ms"`
+				TotalBillableMS int64            `json:"total_billable_ms"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, resourceID, response.WorkflowID)
+			assert.Equal(t, map[string]int64{"UBUNTU": 180000, "WINDOWS": 60000}, response.BillableMS)
+			assert.Equal(t, int64(240000), response.TotalBillableMS)
+		})
+	}
+}
+
+func Test_ActionsGet_DownloadArtifact(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	expiresAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		handlers    map[string]http.HandlerFunc
+		expectError string
+	}{
+		{
+			name: "returns download URL and artifact details",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsArtifactsByOwnerByRepoByArtifactIDByArchiveFormat: func(w http.ResponseWriter, r *http.Request) {
+					http.Redirect(w, r, "https://pipelines.example.com/artifact.zip?sig=abc", http.StatusFound)
+				},
+				GetReposActionsArtifactsByOwnerByRepoByArtifactID: mockResponse(t, http.StatusOK, &github.Artifact{
+					ID:          github.Ptr(int64(42)),
+					Name:        github.Ptr("coverage"),
+					SizeInBytes: github.Ptr(int64(2048)),
+					ExpiresAt:   &github.Timestamp{Time: expiresAt},
+				}),
+			},
+		},
+		{
+			name: "expired artifact",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsArtifactsByOwnerByRepoByArtifactIDByArchiveFormat: mockResponse(t, http.StatusGone, map[string]string{
+					"message": "Artifact has expired",
+				}),
+			},
+			expectError: "artifact 42 is no longer available: it has expired or was deleted (HTTP 410 Gone)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":      "download_workflow_run_artifact",
+				"owner":       "owner",
+				"repo":        "repo",
+				"resource_id": "42",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectError, getErrorResult(t, result).Text)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "https://pipelines.example.com/artifact.zip?sig=abc", response["download_url"])
+			assert.Equal(t, "coverage", response["name"])
+			assert.Equal(t, float64(2048), response["size_in_bytes"])
+			assert.Equal(t, expiresAt.Format(time.RFC3339), response["expires_at"])
+		})
+	}
+}
+
+func Test_ActionsGet_DownloadArtifactFile(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, content := range map[string]string{
+		"reports/junit.xml": "<testsuite>\n<testcase name=\"a\"/>\n<testcase name=\"b\"/>\n</testsuite>\n",
+		"coverage.out":      "mode: set\n",
+		"badge.png":         "\x89PNG\r\n\x1a\n\x00\x00",
+	} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	archiveServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer archiveServer.Close()
+
+	tests := []struct {
+		name            string
+		filePath        string
+		windowSize      int
+		expectError     string
+		expectedContent string
+		expectedBase64  string
+		expectTruncated bool
+	}{
+		{
+			name:            "extracts requested file",
+			filePath:        "reports/junit.xml",
+			windowSize:      100,
+			expectedContent: "<testsuite>\n<testcase name=\"a\"/>\n<testcase name=\"b\"/>\n</testsuite>\n",
+		},
+		{
+			name:            "truncates to content window",
+			filePath:        "reports/junit.xml",
+			windowSize:      2,
+			expectedContent: "<testsuite>\n<testcase name=\"a\"/>\n",
+			expectTruncated: true,
+		},
+		{
+			name:           "encodes binary file",
+			filePath:       "badge.png",
+			windowSize:     100,
+			expectedBase64: base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n\x00\x00")),
+		},
+		{
+			name:        "missing file lists available entries",
+			filePath:    "missing.txt",
+			windowSize:  100,
+			expectError: "available files:",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsArtifactsByOwnerByRepoByArtifactIDByArchiveFormat: func(w http.ResponseWriter, r *http.Request) {
+					http.Redirect(w, r, archiveServer.URL, http.StatusFound)
+				},
+			})
+
+			deps := BaseDeps{
+				Client:            mustNewGHClient(t, mockedClient),
+				ContentWindowSize: tc.windowSize,
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":      "download_workflow_run_artifact",
+				"owner":       "owner",
+				"repo":        "repo",
+				"resource_id": "42",
+				"file_path":   tc.filePath,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectError)
+				assert.Contains(t, getErrorResult(t, result).Text, "coverage.out")
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.filePath, response["file_path"])
+			if tc.expectedBase64 != "" {
+				assert.Equal(t, tc.expectedBase64, response["content_base64"])
+				assert.NotContains(t, response, "content")
+				return
+			}
+			assert.Equal(t, tc.expectedContent, response["content"])
+			if tc.expectTruncated {
+				assert.Equal(t, true, response["truncated"])
+			} else {
+				assert.NotContains(t, response, "truncated")
+			}
+		})
+	}
+}
+
+func Test_ActionsGet_DownloadWorkflowRunLogs(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for _, file := range []struct{ name, content string }{
+		{"0_build.txt", "build 1\nbuild 2\nbuild 3\n"},
+		{"1_test.txt", "test 1\ntest 2\ntest 3\n"},
+		{"build/1_Set up job.txt", "build 1\n"},
+	} {
+		w, err := zw.Create(file.name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(file.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	archiveServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer archiveServer.Close()
+
+	redirectToArchive := func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, archiveServer.URL, http.StatusFound)
+	}
+
+	tests := []struct {
+		name          string
+		handler       http.HandlerFunc
+		returnContent bool
+		expectError   string
+		expectedLogs  []any
+	}{
+		{
+			name:    "returns archive URL",
+			handler: redirectToArchive,
+		},
+		{
+			name:          "returns the end of each job log",
+			handler:       redirectToArchive,
+			returnContent: true,
+			expectedLogs: []any{
+				map[string]any{"file": "0_build.txt", "logs_content": "build 2\nbuild 3", "original_length": float64(3)},
+				map[string]any{"file": "1_test.txt", "logs_content": "test 2\ntest 3", "original_length": float64(3)},
+			},
+		},
+		{
+			name:        "expired logs",
+			handler:     mockResponse(t, http.StatusGone, map[string]string{"message": "Gone"}),
+			expectError: "logs for workflow run 42 are no longer available",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsRunsLogsByOwnerByRepoByRunID: tc.handler,
+			})
+			deps := BaseDeps{
+				Client:            mustNewGHClient(t, mockedClient),
+				ContentWindowSize: 4,
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":         "download_workflow_run_logs",
+				"owner":          "owner",
+				"repo":           "repo",
+				"resource_id":    "42",
+				"return_content": tc.returnContent,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectError)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, archiveServer.URL, response["logs_url"])
+			if tc.expectedLogs == nil {
+				assert.NotContains(t, response, "logs")
+				return
+			}
+			assert.Equal(t, tc.expectedLogs, response["logs"])
+		})
+	}
+}
+
+func Test_ActionsRunTrigger(t *testing.T) {
+	// Verify tool definition once
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "actions_run_trigger", toolDef.Tool.Name)
+	assert.NotEmpty(t, toolDef.Tool.Description)
+	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, inputSchema.Properties, "method")
+	assert.Contains(t, inputSchema.Properties, "owner")
+	assert.Contains(t, inputSchema.Properties, "repo")
+	assert.Contains(t, inputSchema.Properties, "workflow_id")
+	assert.Contains(t, inputSchema.Properties, "ref")
+	assert.Contains(t, inputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
+}
+
+func Test_ActionsRunTrigger_RunWorkflow(t *testing.T) {
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful workflow run",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+				"ref":         "main",
+			},
+			expectError: false,
+		},
+		{
+			name:         "missing required parameter workflow_id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method": "run_workflow",
+				"owner":  "owner",
+				"repo":   "repo",
+				"ref":    "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "workflow_id is required for run_workflow action",
+		},
+		{
+			name:         "missing required parameter ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+			},
+			expectError:    true,
+			expectedErrMsg: "ref is required for run_workflow action",
+		},
+		{
+			name: "successful workflow run with inputs",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: expectRequestBody(t, map[string]any{
+					"ref":    "main",
+					"inputs": map[string]any{"FIELD1": "value1", "FIELD2": "value2"},
+				}).andThen(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+				"ref":         "main",
+				"inputs":      map[string]any{"FIELD1": "value1", "FIELD2": "value2"},
+			},
+			expectError: false,
+		},
+		{
+			name:         "invalid inputs type returns error",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+				"ref":         "main",
+				"inputs":      "not a map",
+			},
+			expectError:    true,
+			expectedErrMsg: "parameter inputs is not of type map[string]interface {}, is string",
+		},
+		{
+			name:         "number input returns error",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+				"ref":         "main",
+				"inputs":      map[string]any{"environment": "prod", "replicas": float64(3)},
+			},
+			expectError:    true,
+			expectedErrMsg: `input "replicas" must be a string, got a number: pass it as "3"`,
+		},
+		{
+			name:         "nested input returns error",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+				"ref":         "main",
+				"inputs":      map[string]any{"config": map[string]any{"debug": "true"}},
+			},
+			expectError:    true,
+			expectedErrMsg: `input "config" must be a string, got an object: workflow_dispatch inputs cannot be nested`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var response map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, "Workflow run has been queued", response["message"])
+		})
+	}
+}
+
+func Test_ActionsRunTrigger_RunWorkflowByFileName(t *testing.T) {
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+
+	tests := []struct {
+		name       string
+		workflowID string
+	}{
+		{name: "file name", workflowID: "ci.yml"},
+		{name: "workflow path", workflowID: ".github/workflows/ci.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/owner/repo/actions/workflows/ci.yml/dispatches", r.URL.Path)
+					w.WriteHeader(http.StatusNoContent)
+				}),
+			})
+
+			client := mustNewGHClient(t, mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": tc.workflowID,
+				"ref":         "main",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response map[string]any
+			err = json.Unmarshal([]byte(getTextResult(t, result).Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, "workflow_file", response["workflow_type"])
+			assert.Equal(t, tc.workflowID, response["workflow_id"])
+		})
+	}
+}
+
+func Test_ActionsRunTrigger_CancelWorkflowRun(t *testing.T) {
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+
+	t.Run("successful workflow run cancellation", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(12345),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "Workflow run has been cancelled", response["message"])
+	})
+
+	t.Run("conflict when cancelling a workflow run", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusConflict)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(12345),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		assert.Contains(t, textContent.Text, "failed to cancel workflow run")
+	})
+
+	t.Run("missing run_id for non-run_workflow methods", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		assert.Equal(t, "missing required parameter: run_id", textContent.Text)
+	})
+}
+
+func Test_ActionsRunTrigger_CancelAllRuns(t *testing.T) {
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+
+	t.Run("cancels active runs and reports per-run failures", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepo: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "main", r.URL.Query().Get("branch"))
+				runs := &github.WorkflowRuns{}
+				switch r.URL.Query().Get("status") {
+				case "in_progress":
+					runs.TotalCount = github.Ptr(3)
+					runs.WorkflowRuns = []*github.WorkflowRun{
+						{ID: github.Ptr(int64(1)), Name: github.Ptr("CI"), Status: github.Ptr("in_progress")},
+						{ID: github.Ptr(int64(2)), Name: github.Ptr("CI"), Status: github.Ptr("in_progress")},
+						{ID: github.Ptr(int64(3)), Name: github.Ptr("Deploy"), Status: github.Ptr("in_progress")},
+					}
+				case "queued":
+					runs.TotalCount = github.Ptr(1)
+					runs.WorkflowRuns = []*github.WorkflowRun{
+						{ID: github.Ptr(int64(4)), Name: github.Ptr("Lint"), Status: github.Ptr("queued")},
+					}
+				case "waiting", "pending", "requested":
+					runs.TotalCount = github.Ptr(0)
+				default:
+					t.Errorf("unexpected status filter %q", r.URL.Query().Get("status"))
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(runs)
+			}),
+			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/repos/owner/repo/actions/runs/4/cancel" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"message": "Cannot cancel a workflow run that is completed."}`))
+					return
+				}
+				w.WriteHeader(http.StatusAccepted)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_all_runs",
+			"owner":  "owner",
+			"repo":   "repo",
+			"branch": "main",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response struct {
+			Total     int              `json:"total"`
+			Cancelled int              `json:"cancelled"`
+			Failed    int              `json:"failed"`
+			Truncated bool             `json:"truncated"`
+			Runs      []map[string]any `json:"runs"`
+		}
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.Equal(t, 4, response.Total)
+		assert.False(t, response.Truncated)
+		assert.Equal(t, 3, response.Cancelled)
+		assert.Equal(t, 1, response.Failed)
+		require.Len(t, response.Runs, 4)
+
+		for _, run := range response.Runs {
+			if run["run_id"] == float64(4) {
+				assert.Equal(t, false, run["cancelled"])
+				assert.Equal(t, float64(http.StatusConflict), run["status_code"])
+				assert.Contains(t, run["error"], "Cannot cancel")
+				continue
+			}
+			assert.Equal(t, true, run["cancelled"])
+		}
+	})
+
+	t.Run("scopes listing to a workflow file", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/owner/repo/actions/workflows/ci.yml/runs", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&github.WorkflowRuns{TotalCount: github.Ptr(0)})
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":      "cancel_all_runs",
+			"owner":       "owner",
+			"repo":        "repo",
+			"workflow_id": "ci.yml",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.Equal(t, float64(0), response["total"])
+	})
+
+	t.Run("filters by actor and stops at the run limit", func(t *testing.T) {
+		var statuses []string
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepo: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "octocat", r.URL.Query().Get("actor"))
+				status := r.URL.Query().Get("status")
+				statuses = append(statuses, status)
+
+				runs := &github.WorkflowRuns{TotalCount: github.Ptr(0)}
+				if status == "waiting" {
+					// Every page is full and links to another one.
+					page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+					if page == 0 {
+						page = 1
+					}
+					for i := range 30 {
+						runs.WorkflowRuns = append(runs.WorkflowRuns, &github.WorkflowRun{ID: github.Ptr(int64(page*100 + i))})
+					}
+					w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/actions/runs?page=%d>; rel="next"`, page+1))
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(runs)
+			}),
+			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			}),
+		})
+
+		deps := BaseDeps{Client: mustNewGHClient(t, mockedClient)}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_all_runs",
+			"owner":  "owner",
+			"repo":   "repo",
+			"actor":  "octocat",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, float64(maxCancelAllRuns), response["total"])
+		assert.Equal(t, true, response["truncated"])
+		assert.Equal(t, []string{"in_progress", "queued", "waiting", "waiting"}, statuses)
+	})
+}
+
+func Test_ActionsRunTrigger_DeleteWorkflowRun(t *testing.T) {
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+
+	t.Run("successful workflow run deletion", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			DeleteReposActionsRunsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "delete_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(12345),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "Workflow run has been deleted", response["message"])
+		assert.Equal(t, float64(12345), response["run_id"])
+	})
+
+	t.Run("forbidden when the run cannot be deleted", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			GetReposActionsWorkflowsByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-				workflow := &github.Workflow{
-					ID:    github.Ptr(int64(1)),
-					Name:  github.Ptr("CI"),
-					Path:  github.Ptr(".github/workflows/ci.yml"),
-					State: github.Ptr("active"),
-				}
-				w.WriteHeader(http.StatusOK)
-				_ = json.NewEncoder(w).Encode(workflow)
+			DeleteReposActionsRunsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message": "Cannot delete an active workflow run"}`))
 			}),
 		})
 
@@ -248,39 +1741,25 @@ func Test_ActionsGet_GetWorkflow(t *testing.T) {
 		handler := toolDef.Handler(deps)
 
 		request := createMCPRequest(map[string]any{
-			"method":      "get_workflow",
-			"owner":       "owner",
-			"repo":        "repo",
-			"resource_id": "ci.yml",
+			"method": "delete_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(12345),
 		})
 		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 		require.NoError(t, err)
-		require.False(t, result.IsError)
+		require.True(t, result.IsError)
 
 		textContent := getTextResult(t, result)
-		var response github.Workflow
-		err = json.Unmarshal([]byte(textContent.Text), &response)
-		require.NoError(t, err)
-		assert.NotNil(t, response.ID)
-		assert.Equal(t, "CI", *response.Name)
+		assert.Contains(t, textContent.Text, "failed to delete workflow run")
+		assert.Contains(t, textContent.Text, "still queued or in progress")
 	})
-}
-
-func Test_ActionsGet_GetWorkflowRun(t *testing.T) {
-	toolDef := ActionsGet(translations.NullTranslationHelper)
 
-	t.Run("successful workflow run get", func(t *testing.T) {
+	t.Run("conflict when deleting a workflow run", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			GetReposActionsRunsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-				run := &github.WorkflowRun{
-					ID:         github.Ptr(int64(12345)),
-					Name:       github.Ptr("CI"),
-					Status:     github.Ptr("completed"),
-					Conclusion: github.Ptr("success"),
-				}
-				w.WriteHeader(http.StatusOK)
-				_ = json.NewEncoder(w).Encode(run)
+			DeleteReposActionsRunsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusConflict)
 			}),
 		})
 
@@ -291,161 +1770,179 @@ func Test_ActionsGet_GetWorkflowRun(t *testing.T) {
 		handler := toolDef.Handler(deps)
 
 		request := createMCPRequest(map[string]any{
-			"method":      "get_workflow_run",
-			"owner":       "owner",
-			"repo":        "repo",
-			"resource_id": "12345",
+			"method": "delete_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(12345),
 		})
 		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 		require.NoError(t, err)
-		require.False(t, result.IsError)
+		require.True(t, result.IsError)
 
 		textContent := getTextResult(t, result)
-		var response github.WorkflowRun
-		err = json.Unmarshal([]byte(textContent.Text), &response)
+		assert.Contains(t, textContent.Text, "failed to delete workflow run")
+		assert.NotContains(t, textContent.Text, "still queued or in progress")
+	})
+
+	t.Run("missing run_id", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "delete_workflow_run",
+			"owner":  "owner",
+			"repo":   "repo",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
 		require.NoError(t, err)
-		assert.NotNil(t, response.ID)
-		assert.Equal(t, int64(12345), *response.ID)
+		require.True(t, result.IsError)
+		assert.Equal(t, "missing required parameter: run_id", getErrorResult(t, result).Text)
 	})
 }
 
-func Test_ActionsRunTrigger(t *testing.T) {
-	// Verify tool definition once
-	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
-	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+func Test_ActionsList_ListActionsCaches(t *testing.T) {
+	toolDef := ActionsList(translations.NullTranslationHelper)
 
-	assert.Equal(t, "actions_run_trigger", toolDef.Tool.Name)
-	assert.NotEmpty(t, toolDef.Tool.Description)
-	inputSchema := toolDef.Tool.InputSchema.(*jsonschema.Schema)
-	assert.Contains(t, inputSchema.Properties, "method")
-	assert.Contains(t, inputSchema.Properties, "owner")
-	assert.Contains(t, inputSchema.Properties, "repo")
-	assert.Contains(t, inputSchema.Properties, "workflow_id")
-	assert.Contains(t, inputSchema.Properties, "ref")
-	assert.Contains(t, inputSchema.Properties, "run_id")
-	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsCachesByOwnerByRepo: expectQueryParams(t, map[string]string{
+			"ref":      "refs/heads/main",
+			"page":     "1",
+			"per_page": "30",
+		}).andThen(mockResponse(t, http.StatusOK, &github.ActionsCacheList{
+			TotalCount: 1,
+			ActionsCaches: []*github.ActionsCache{
+				{
+					ID:             github.Ptr(int64(505)),
+					Key:            github.Ptr("Linux-node-958aff96"),
+					Ref:            github.Ptr("refs/heads/main"),
+					Version:        github.Ptr("73885106f58cc52a7df9ec4d4a5622a5614813162cb516c759a30af6bf56e6f0"),
+					SizeInBytes:    github.Ptr(int64(1024)),
+					LastAccessedAt: &github.Timestamp{Time: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+		})),
+	})
+
+	client := mustNewGHClient(t, mockedClient)
+	deps := BaseDeps{
+		Client: client,
+	}
+	handler := toolDef.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"method": "list_actions_caches",
+		"owner":  "owner",
+		"repo":   "repo",
+		"actions_caches_filter": map[string]any{
+			"ref": "refs/heads/main",
+		},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		TotalCount int              `json:"total_count"`
+		Caches     []map[string]any `json:"caches"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, 1, response.TotalCount)
+	require.Len(t, response.Caches, 1)
+	assert.Equal(t, float64(505), response.Caches[0]["id"])
+	assert.Equal(t, "Linux-node-958aff96", response.Caches[0]["key"])
+	assert.Equal(t, "refs/heads/main", response.Caches[0]["ref"])
+	assert.Equal(t, float64(1024), response.Caches[0]["size_in_bytes"])
+	assert.Equal(t, "2026-05-01T12:00:00Z", response.Caches[0]["last_accessed_at"])
+	assert.NotContains(t, response.Caches[0], "version")
 }
 
-func Test_ActionsRunTrigger_RunWorkflow(t *testing.T) {
+func Test_ActionsRunTrigger_Rerun(t *testing.T) {
 	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]any
-		expectError    bool
-		expectedErrMsg string
+		name            string
+		method          string
+		handlers        map[string]http.HandlerFunc
+		expectError     string
+		expectedMessage string
 	}{
 		{
-			name: "successful workflow run",
-			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-					w.WriteHeader(http.StatusNoContent)
-				}),
-			}),
-			requestArgs: map[string]any{
-				"method":      "run_workflow",
-				"owner":       "owner",
-				"repo":        "repo",
-				"workflow_id": "12345",
-				"ref":         "main",
-			},
-			expectError: false,
-		},
-		{
-			name:         "missing required parameter workflow_id",
-			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
-			requestArgs: map[string]any{
-				"method": "run_workflow",
-				"owner":  "owner",
-				"repo":   "repo",
-				"ref":    "main",
+			name:   "rerun workflow run",
+			method: "rerun_workflow_run",
+			handlers: map[string]http.HandlerFunc{
+				PostReposActionsRunsRerunByOwnerByRepoByRunID: mockResponse(t, http.StatusCreated, map[string]any{}),
 			},
-			expectError:    true,
-			expectedErrMsg: "workflow_id is required for run_workflow action",
+			expectedMessage: "Workflow run has been queued for re-run",
 		},
 		{
-			name:         "missing required parameter ref",
-			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
-			requestArgs: map[string]any{
-				"method":      "run_workflow",
-				"owner":       "owner",
-				"repo":        "repo",
-				"workflow_id": "12345",
+			name:   "rerun failed jobs",
+			method: "rerun_failed_jobs",
+			handlers: map[string]http.HandlerFunc{
+				PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunID: mockResponse(t, http.StatusCreated, map[string]any{}),
 			},
-			expectError:    true,
-			expectedErrMsg: "ref is required for run_workflow action",
+			expectedMessage: "Failed jobs have been queued for re-run",
 		},
 		{
-			name: "successful workflow run with inputs",
-			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-					w.WriteHeader(http.StatusNoContent)
+			name:   "run too old to rerun",
+			method: "rerun_failed_jobs",
+			handlers: map[string]http.HandlerFunc{
+				PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunID: mockResponse(t, http.StatusForbidden, map[string]string{
+					"message": "Unable to re-run this workflow run because it was created over a month ago",
 				}),
-			}),
-			requestArgs: map[string]any{
-				"method":      "run_workflow",
-				"owner":       "owner",
-				"repo":        "repo",
-				"workflow_id": "12345",
-				"ref":         "main",
-				"inputs":      map[string]any{"FIELD1": "value1", "FIELD2": "value2"},
-			},
-			expectError: false,
-		},
-		{
-			name:         "invalid inputs type returns error",
-			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
-			requestArgs: map[string]any{
-				"method":      "run_workflow",
-				"owner":       "owner",
-				"repo":        "repo",
-				"workflow_id": "12345",
-				"ref":         "main",
-				"inputs":      "not a map",
 			},
-			expectError:    true,
-			expectedErrMsg: "parameter inputs is not of type map[string]interface {}, is string",
+			expectError: "Workflow runs can only be re-run within 30 days",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			client := mustNewGHClient(t, tc.mockedClient)
 			deps := BaseDeps{
-				Client: client,
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
 			}
 			handler := toolDef.Handler(deps)
 
-			request := createMCPRequest(tc.requestArgs)
+			request := createMCPRequest(map[string]any{
+				"method": tc.method,
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			})
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
-
 			require.NoError(t, err)
-			require.Equal(t, tc.expectError, result.IsError)
-
-			textContent := getTextResult(t, result)
 
-			if tc.expectedErrMsg != "" {
-				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+			if tc.expectError != "" {
+				require.True(t, result.IsError)
+				text := getErrorResult(t, result).Text
+				assert.Contains(t, text, tc.expectError)
+				assert.Contains(t, text, "created over a month ago")
 				return
 			}
 
+			require.False(t, result.IsError, getTextResult(t, result).Text)
 			var response map[string]any
-			err = json.Unmarshal([]byte(textContent.Text), &response)
-			require.NoError(t, err)
-			assert.Equal(t, "Workflow run has been queued", response["message"])
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedMessage, response["message"])
+			assert.Equal(t, float64(12345), response["run_id"])
 		})
 	}
 }
 
-func Test_ActionsRunTrigger_CancelWorkflowRun(t *testing.T) {
+func Test_ActionsRunTrigger_DeleteActionsCache(t *testing.T) {
 	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
 
-	t.Run("successful workflow run cancellation", func(t *testing.T) {
+	t.Run("deletes caches by key and ref", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-				w.WriteHeader(http.StatusAccepted)
+			DeleteReposActionsCachesByOwnerByRepo: expectQueryParams(t, map[string]string{
+				"key": "Linux-node-958aff96",
+				"ref": "refs/heads/main",
+			}).andThen(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"total_count":1,"actions_caches":[]}`))
 			}),
 		})
 
@@ -456,28 +1953,30 @@ func Test_ActionsRunTrigger_CancelWorkflowRun(t *testing.T) {
 		handler := toolDef.Handler(deps)
 
 		request := createMCPRequest(map[string]any{
-			"method": "cancel_workflow_run",
-			"owner":  "owner",
-			"repo":   "repo",
-			"run_id": float64(12345),
+			"method":    "delete_actions_cache",
+			"owner":     "owner",
+			"repo":      "repo",
+			"cache_key": "Linux-node-958aff96",
+			"ref":       "refs/heads/main",
 		})
 		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 		require.NoError(t, err)
-		require.False(t, result.IsError)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
 
-		textContent := getTextResult(t, result)
 		var response map[string]any
-		err = json.Unmarshal([]byte(textContent.Text), &response)
-		require.NoError(t, err)
-		assert.Equal(t, "Workflow run has been cancelled", response["message"])
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "Linux-node-958aff96", response["cache_key"])
+		assert.Equal(t, "refs/heads/main", response["ref"])
 	})
 
-	t.Run("conflict when cancelling a workflow run", func(t *testing.T) {
+	t.Run("deletes a cache by id", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-				w.WriteHeader(http.StatusConflict)
-			}),
+			DeleteReposActionsCachesByOwnerByRepoByCacheID: expectPath(t, "/repos/owner/repo/actions/caches/505").andThen(
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			),
 		})
 
 		client := mustNewGHClient(t, mockedClient)
@@ -487,31 +1986,27 @@ func Test_ActionsRunTrigger_CancelWorkflowRun(t *testing.T) {
 		handler := toolDef.Handler(deps)
 
 		request := createMCPRequest(map[string]any{
-			"method": "cancel_workflow_run",
-			"owner":  "owner",
-			"repo":   "repo",
-			"run_id": float64(12345),
+			"method":   "delete_actions_cache",
+			"owner":    "owner",
+			"repo":     "repo",
+			"cache_id": float64(505),
 		})
 		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 		require.NoError(t, err)
-		require.True(t, result.IsError)
-
-		textContent := getTextResult(t, result)
-		assert.Contains(t, textContent.Text, "failed to cancel workflow run")
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.Contains(t, getTextResult(t, result).Text, `"cache_id":505`)
 	})
 
-	t.Run("missing run_id for non-run_workflow methods", func(t *testing.T) {
-		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
-
-		client := mustNewGHClient(t, mockedClient)
+	t.Run("requires cache_id or cache_key", func(t *testing.T) {
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))
 		deps := BaseDeps{
 			Client: client,
 		}
 		handler := toolDef.Handler(deps)
 
 		request := createMCPRequest(map[string]any{
-			"method": "cancel_workflow_run",
+			"method": "delete_actions_cache",
 			"owner":  "owner",
 			"repo":   "repo",
 		})
@@ -519,9 +2014,7 @@ func Test_ActionsRunTrigger_CancelWorkflowRun(t *testing.T) {
 
 		require.NoError(t, err)
 		require.True(t, result.IsError)
-
-		textContent := getTextResult(t, result)
-		assert.Equal(t, "missing required parameter: run_id", textContent.Text)
+		assert.Contains(t, getTextResult(t, result).Text, "missing required parameter: cache_id or cache_key")
 	})
 }
 
@@ -583,6 +2076,103 @@ func Test_ActionsGetJobLogs_SingleJob(t *testing.T) {
 	})
 }
 
+func Test_ActionsDiagnoseWorkflowRun(t *testing.T) {
+	toolDef := ActionsDiagnoseWorkflowRun(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+	assert.True(t, toolDef.Tool.Annotations.ReadOnlyHint)
+
+	logs := map[string]string{
+		"/logs/2": strings.Join([]string{
+			"2024-05-01T10:00:00.0000000Z ##[group]Run go test ./...",
+			"2024-05-01T10:00:01.0000000Z --- FAIL: TestParse (0.00s)",
+			"2024-05-01T10:00:01.1000000Z FAIL\tgithub.com/octo/app/parser",
+			"2024-05-01T10:00:02.0000000Z ##[error]Process completed with exit code 1.",
+		}, "\n"),
+		"/logs/3": strings.Join([]string{
+			"2024-05-01T10:00:00.0000000Z ##[group]Run golangci-lint run",
+			"2024-05-01T10:00:01.0000000Z main.go:10:2: unused variable x",
+			"2024-05-01T10:00:02.0000000Z ##[error]issues found",
+		}, "\n"),
+	}
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(logs[r.URL.Path]))
+	}))
+	defer logServer.Close()
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsRunsJobsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, &github.Jobs{
+			TotalCount: github.Ptr(3),
+			Jobs: []*github.WorkflowJob{
+				{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Conclusion: github.Ptr("success")},
+				{
+					ID:         github.Ptr(int64(2)),
+					Name:       github.Ptr("test"),
+					Conclusion: github.Ptr("failure"),
+					Steps: []*github.TaskStep{
+						{Name: github.Ptr("Checkout"), Conclusion: github.Ptr("success")},
+						{Name: github.Ptr("Run tests"), Conclusion: github.Ptr("failure")},
+						{Name: github.Ptr("Upload coverage"), Conclusion: github.Ptr("skipped")},
+					},
+				},
+				{
+					ID:         github.Ptr(int64(3)),
+					Name:       github.Ptr("lint"),
+					Conclusion: github.Ptr("failure"),
+					Steps: []*github.TaskStep{
+						{Name: github.Ptr("Lint"), Conclusion: github.Ptr("failure")},
+					},
+				},
+			},
+		}),
+		GetReposActionsJobsLogsByOwnerByRepoByJobID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Path is /repos/{owner}/{repo}/actions/jobs/{job_id}/logs
+			parts := strings.Split(r.URL.Path, "/")
+			w.Header().Set("Location", logServer.URL+"/logs/"+parts[len(parts)-2])
+			w.WriteHeader(http.StatusFound)
+		}),
+	})
+
+	client := mustNewGHClient(t, mockedClient)
+	deps := BaseDeps{
+		Client:            client,
+		ContentWindowSize: 5000,
+	}
+	handler := toolDef.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"run_id": float64(456),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		RunID      int64                `json:"run_id"`
+		TotalJobs  int                  `json:"total_jobs"`
+		FailedJobs []failedJobDiagnosis `json:"failed_jobs"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+
+	assert.Equal(t, int64(456), response.RunID)
+	assert.Equal(t, 3, response.TotalJobs)
+	require.Len(t, response.FailedJobs, 2)
+
+	testJob := response.FailedJobs[0]
+	assert.Equal(t, "test", testJob.JobName)
+	assert.Equal(t, "Run tests", testJob.FailedStep)
+	assert.Equal(t, []string{"Process completed with exit code 1."}, testJob.ErrorLines)
+	assert.Contains(t, testJob.LastLines, "--- FAIL: TestParse (0.00s)")
+	assert.Empty(t, testJob.Error)
+
+	lintJob := response.FailedJobs[1]
+	assert.Equal(t, "lint", lintJob.JobName)
+	assert.Equal(t, "Lint", lintJob.FailedStep)
+	assert.Equal(t, []string{"issues found"}, lintJob.ErrorLines)
+	assert.Equal(t, "main.go:10:2: unused variable x", lintJob.LastLines[1])
+}
+
 func Test_ActionsGetJobLogs_FailedJobs(t *testing.T) {
 	toolDef := ActionsGetJobLogs(translations.NullTranslationHelper)
 
@@ -692,4 +2282,63 @@ func Test_ActionsGetJobLogs_FailedJobs(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "No failed jobs found in this workflow run", response["message"])
 	})
+
+	t.Run("failed jobs across pages", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsJobsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+				jobs := &github.Jobs{TotalCount: github.Ptr(4)}
+				if r.URL.Query().Get("page") == "2" {
+					jobs.Jobs = []*github.WorkflowJob{
+						{ID: github.Ptr(int64(3)), Name: github.Ptr("lint"), Conclusion: github.Ptr("success")},
+						{ID: github.Ptr(int64(4)), Name: github.Ptr("e2e"), Conclusion: github.Ptr("failure")},
+					}
+				} else {
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/actions/runs/456/jobs?page=2>; rel="next"`)
+					jobs.Jobs = []*github.WorkflowJob{
+						{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Conclusion: github.Ptr("failure")},
+						{ID: github.Ptr(int64(2)), Name: github.Ptr("test"), Conclusion: github.Ptr("success")},
+					}
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(jobs)
+			}),
+			GetReposActionsJobsLogsByOwnerByRepoByJobID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Location", "https://github.com/logs/job/"+r.URL.Path[len(r.URL.Path)-1:])
+				w.WriteHeader(http.StatusFound)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client:            client,
+			ContentWindowSize: 5000,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"run_id":      float64(456),
+			"failed_only": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response struct {
+			TotalJobs  int `json:"total_jobs"`
+			FailedJobs int `json:"failed_jobs"`
+			Logs       []struct {
+				JobName string `json:"job_name"`
+			} `json:"logs"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 4, response.TotalJobs)
+		assert.Equal(t, 2, response.FailedJobs)
+		require.Len(t, response.Logs, 2)
+		assert.Equal(t, "build", response.Logs[0].JobName)
+		assert.Equal(t, "e2e", response.Logs[1].JobName)
+	})
 }
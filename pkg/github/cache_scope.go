@@ -0,0 +1,32 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+)
+
+// cacheScope identifies who a request is made for in the keys of
+// process-wide caches whose contents depend on what the caller can see. It
+// combines the REST API base URL with a hash of the request's token, so that
+// in multi-tenant HTTP mode one user's cached results are never served to
+// another. Without a token in the context, as on the stdio server, the scope
+// is the API base URL alone.
+func cacheScope(ctx context.Context) string {
+	var apiURL string
+	if deps, ok := DepsFromContext(ctx); ok {
+		if client, err := deps.GetClient(ctx); err == nil && client != nil {
+			apiURL = client.BaseURL()
+		}
+	}
+
+	var identity string
+	if tokenInfo, ok := ghcontext.GetTokenInfo(ctx); ok && tokenInfo != nil && tokenInfo.Token != "" {
+		sum := sha256.Sum256([]byte(tokenInfo.Token))
+		identity = hex.EncodeToString(sum[:])
+	}
+
+	return apiURL + "|" + identity
+}
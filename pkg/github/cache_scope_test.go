@@ -0,0 +1,38 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	gogithub "github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CacheScope(t *testing.T) {
+	dotcom := BaseDeps{Client: mustNewGHClient(t, nil)}
+	ghes, err := gogithub.NewClient(gogithub.WithEnterpriseURLs("https://ghes.example.com/api/v3/", "https://ghes.example.com/api/uploads/"))
+	require.NoError(t, err)
+	enterprise := BaseDeps{Client: ghes}
+
+	ctxFor := func(deps ToolDependencies, token string) context.Context {
+		ctx := ContextWithDeps(context.Background(), deps)
+		return ghcontext.WithTokenInfo(ctx, &ghcontext.TokenInfo{Token: token})
+	}
+
+	userA := ctxFor(dotcom, "token-a")
+	userB := ctxFor(dotcom, "token-b")
+	userAOnGHES := ctxFor(enterprise, "token-a")
+
+	assert.Equal(t, cacheScope(userA), cacheScope(ctxFor(dotcom, "token-a")))
+	assert.NotEqual(t, cacheScope(userA), cacheScope(userB))
+	assert.NotEqual(t, cacheScope(userA), cacheScope(userAOnGHES))
+	assert.NotContains(t, cacheScope(userA), "token-a")
+
+	assert.NotEqual(t,
+		projectFieldCacheKey(userA, "octo-org", "org", 1),
+		projectFieldCacheKey(userB, "octo-org", "org", 1),
+	)
+	assert.Equal(t, "|", cacheScope(context.Background()))
+}
@@ -3,8 +3,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"slices"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
@@ -223,3 +225,135 @@ func ListCodeScanningAlerts(t translations.TranslationHelperFunc) inventory.Serv
 		},
 	)
 }
+
+// codeScanningDismissedReasons are the reasons GitHub accepts for dismissing a
+// code scanning alert.
+var codeScanningDismissedReasons = []any{"false positive", "won't fix", "used in tests"}
+
+// UpdateCodeScanningAlert creates a tool to dismiss or reopen a code scanning alert.
+func UpdateCodeScanningAlert(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataCodeSecurity,
+		mcp.Tool{
+			Name:        "update_code_scanning_alert",
+			Description: t("TOOL_UPDATE_CODE_SCANNING_ALERT_DESCRIPTION", "Dismiss or reopen a code scanning alert in a GitHub repository. Dismissing requires a dismissed_reason. Returns the updated alert."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_CODE_SCANNING_ALERT_USER_TITLE", "Update code scanning alert"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert.",
+					},
+					"state": {
+						Type:        "string",
+						Description: "The new state of the alert. Use 'open' to reopen a dismissed alert.",
+						Enum:        []any{"dismissed", "open"},
+					},
+					"dismissed_reason": {
+						Type:        "string",
+						Description: "The reason for dismissing the alert. Required when state is 'dismissed'.",
+						Enum:        codeScanningDismissedReasons,
+					},
+					"dismissed_comment": {
+						Type:        "string",
+						Description: "An optional comment explaining the dismissal.",
+					},
+				},
+				Required: []string{"owner", "repo", "alertNumber", "state"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			state, err := RequiredParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			dismissedReason, err := OptionalParam[string](args, "dismissed_reason")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			dismissedComment, err := OptionalParam[string](args, "dismissed_comment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			update := &github.CodeScanningAlertState{State: state}
+			switch state {
+			case "dismissed":
+				if dismissedReason == "" {
+					return utils.NewToolResultError("dismissed_reason is required when state is 'dismissed'"), nil, nil
+				}
+				if !slices.Contains(codeScanningDismissedReasons, any(dismissedReason)) {
+					return utils.NewToolResultError(fmt.Sprintf("invalid dismissed_reason %q: must be one of 'false positive', 'won't fix', 'used in tests'", dismissedReason)), nil, nil
+				}
+				update.DismissedReason = github.Ptr(dismissedReason)
+				if dismissedComment != "" {
+					update.DismissedComment = github.Ptr(dismissedComment)
+				}
+			case "open":
+				if dismissedReason != "" || dismissedComment != "" {
+					return utils.NewToolResultError("dismissed_reason and dismissed_comment can only be set when state is 'dismissed'"), nil, nil
+				}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid state %q: must be 'dismissed' or 'open'", state)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			alert, resp, err := client.CodeScanning.UpdateAlert(ctx, owner, repo, int64(alertNumber), update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to update alert",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update alert", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal alert", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// The updated alert carries the same label as get_code_scanning_alert.
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelSecurityAlert())
+			return result, nil, nil
+		},
+	)
+}
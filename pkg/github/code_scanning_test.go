@@ -270,3 +270,134 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateCodeScanningAlert(t *testing.T) {
+	toolDef := UpdateCodeScanningAlert(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "update_code_scanning_alert", toolDef.Tool.Name)
+	assert.NotEmpty(t, toolDef.Tool.Description)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "dismissed_reason")
+	assert.Contains(t, schema.Properties, "dismissed_comment")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "alertNumber", "state"})
+
+	dismissedAlert := &github.Alert{
+		Number:          github.Ptr(42),
+		State:           github.Ptr("dismissed"),
+		DismissedReason: github.Ptr("false positive"),
+	}
+	openAlert := &github.Alert{
+		Number: github.Ptr(42),
+		State:  github.Ptr("open"),
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectedAlert  *github.Alert
+		expectedErrMsg string
+	}{
+		{
+			name: "dismiss alert",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state":             "dismissed",
+					"dismissed_reason":  "false positive",
+					"dismissed_comment": "Input is sanitized upstream",
+				}).andThen(mockResponse(t, http.StatusOK, dismissedAlert)),
+			},
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(42),
+				"state":             "dismissed",
+				"dismissed_reason":  "false positive",
+				"dismissed_comment": "Input is sanitized upstream",
+			},
+			expectedAlert: dismissedAlert,
+		},
+		{
+			name: "reopen alert",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(mockResponse(t, http.StatusOK, openAlert)),
+			},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectedAlert: openAlert,
+		},
+		{
+			name:     "dismiss without reason",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "dismissed",
+			},
+			expectedErrMsg: "dismissed_reason is required when state is 'dismissed'",
+		},
+		{
+			name:     "invalid reason",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "dismissed",
+				"dismissed_reason": "not a bug",
+			},
+			expectedErrMsg: `invalid dismissed_reason "not a bug"`,
+		},
+		{
+			name: "update fails",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+				}),
+			},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectedErrMsg: "failed to update alert",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returnedAlert github.Alert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+			assert.Equal(t, *tc.expectedAlert.Number, *returnedAlert.Number)
+			assert.Equal(t, *tc.expectedAlert.State, *returnedAlert.State)
+			assert.Equal(t, tc.expectedAlert.GetDismissedReason(), returnedAlert.GetDismissedReason())
+		})
+	}
+}
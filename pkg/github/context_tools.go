@@ -3,14 +3,20 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
 	"time"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shurcooL/githubv4"
@@ -301,3 +307,237 @@ func GetTeamMembers(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 	)
 }
+
+// ToolInfo describes where a tool sits in the server's tool surface.
+type ToolInfo struct {
+	Name           string   `json:"name"`
+	Toolset        string   `json:"toolset"`
+	ReadOnly       bool     `json:"read_only"`
+	RequiredScopes []string `json:"required_scopes"`
+	AcceptedScopes []string `json:"accepted_scopes,omitempty"`
+}
+
+// lazyToolsByName returns a function that indexes AllTools by name on first
+// use. It is resolved lazily because the tool list includes the tools that
+// call it. Feature-flag variants of a tool share its toolset and scopes, so
+// only the first variant is kept.
+func lazyToolsByName(t translations.TranslationHelperFunc) func() map[string]*inventory.ServerTool {
+	return sync.OnceValue(func() map[string]*inventory.ServerTool {
+		tools := AllTools(t)
+		byName := make(map[string]*inventory.ServerTool, len(tools))
+		for i := range tools {
+			if _, ok := byName[tools[i].Tool.Name]; !ok {
+				byName[tools[i].Tool.Name] = &tools[i]
+			}
+		}
+		return byName
+	})
+}
+
+// GetToolInfo creates a tool that reports the toolset, read-only status and
+// OAuth scopes of any tool the server knows about, whether or not its toolset
+// is enabled for the current session.
+func GetToolInfo(t translations.TranslationHelperFunc) inventory.ServerTool {
+	knownTools := lazyToolsByName(t)
+
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "get_tool_info",
+			Description: t("TOOL_GET_TOOL_INFO_DESCRIPTION", "Get the toolset, read-only status and required OAuth scopes of a GitHub MCP Server tool, including tools whose toolset is not enabled."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_TOOL_INFO_USER_TITLE", "Get tool info"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"tool_name": {
+						Type:        "string",
+						Description: t("TOOL_GET_TOOL_INFO_TOOL_NAME_DESCRIPTION", "Name of the tool. Deprecated tool names are resolved to their replacement."),
+					},
+				},
+				Required: []string{"tool_name"},
+			},
+		},
+		nil,
+		func(_ context.Context, _ ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			toolName, err := RequiredParam[string](args, "tool_name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			if canonical, ok := DeprecatedToolAliases[toolName]; ok {
+				toolName = canonical
+			}
+			tool, ok := knownTools()[toolName]
+			if !ok {
+				return utils.NewToolResultError(fmt.Sprintf("unknown tool %q", toolName)), nil, nil
+			}
+
+			requiredScopes := tool.RequiredScopes
+			if requiredScopes == nil {
+				requiredScopes = []string{}
+			}
+			return MarshalledTextResult(ToolInfo{
+				Name:           tool.Tool.Name,
+				Toolset:        string(tool.Toolset.ID),
+				ReadOnly:       tool.IsReadOnly(),
+				RequiredScopes: requiredScopes,
+				AcceptedScopes: tool.AcceptedScopes,
+			}), nil, nil
+		},
+	)
+}
+
+// ScopeFilteredTool is a tool hidden from the session because the token lacks
+// the OAuth scopes it needs.
+type ScopeFilteredTool struct {
+	Name           string   `json:"name"`
+	Toolset        string   `json:"toolset"`
+	RequiredScopes []string `json:"required_scopes"`
+	AcceptedScopes []string `json:"accepted_scopes"`
+}
+
+// ScopeFilteredTools is the result of list_scope_filtered_tools.
+type ScopeFilteredTools struct {
+	TokenScopes   []string            `json:"token_scopes"`
+	MissingScopes []string            `json:"missing_scopes"`
+	Tools         []ScopeFilteredTool `json:"tools"`
+}
+
+// ListScopeFilteredTools creates a tool that lists the tools hidden by
+// CreateToolScopeFilter for the current token, along with the scopes that
+// would make each of them available.
+func ListScopeFilteredTools(t translations.TranslationHelperFunc) inventory.ServerTool {
+	knownTools := lazyToolsByName(t)
+
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "list_scope_filtered_tools",
+			Description: t("TOOL_LIST_SCOPE_FILTERED_TOOLS_DESCRIPTION", "List the tools that are hidden because the current classic personal access token lacks the OAuth scopes they need, and the scopes to add to the token to enable them."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_SCOPE_FILTERED_TOOLS_USER_TITLE", "List tools hidden by missing token scopes"),
+				ReadOnlyHint: true,
+			},
+			// Use json.RawMessage to ensure "properties" is included even when empty.
+			// OpenAI strict mode requires the properties field to be present.
+			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+		},
+		nil,
+		func(ctx context.Context, _ ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+			tokenScopes, ok := ghcontext.GetTokenScopes(ctx)
+			if !ok {
+				return utils.NewToolResultError("the scopes of the current token are not known, so no tools were hidden by scope; tools are only filtered by scope for classic personal access tokens"), nil, nil
+			}
+
+			filter := CreateToolScopeFilter(tokenScopes)
+			tools := knownTools()
+			names := slices.Sorted(maps.Keys(tools))
+
+			result := ScopeFilteredTools{
+				TokenScopes:   tokenScopes,
+				MissingScopes: []string{},
+				Tools:         []ScopeFilteredTool{},
+			}
+			missing := make(map[string]bool)
+			for _, name := range names {
+				tool := tools[name]
+				if allowed, err := filter(ctx, tool); err != nil || allowed {
+					continue
+				}
+				info, _ := scopes.GetToolScopeInfo(name)
+				if info == nil {
+					info = &scopes.ToolScopeInfo{RequiredScopes: tool.RequiredScopes, AcceptedScopes: tool.AcceptedScopes}
+				}
+				toolMissing := info.MissingScopes(tokenScopes...)
+				for _, scope := range toolMissing {
+					missing[scope] = true
+				}
+				result.Tools = append(result.Tools, ScopeFilteredTool{
+					Name:           name,
+					Toolset:        string(tool.Toolset.ID),
+					RequiredScopes: toolMissing,
+					AcceptedScopes: info.AcceptedScopes,
+				})
+			}
+			result.MissingScopes = slices.AppendSeq(result.MissingScopes, maps.Keys(missing))
+			slices.Sort(result.MissingScopes)
+
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
+
+// RateLimitBucket is the state of one GitHub API rate limit bucket.
+type RateLimitBucket struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Used      int       `json:"used"`
+	ResetAt   time.Time `json:"reset_at"`
+}
+
+// RateLimitStatus is the result of get_rate_limit. Buckets that GitHub does
+// not report, such as code_search on older GitHub Enterprise Server versions,
+// are omitted.
+type RateLimitStatus struct {
+	Core       *RateLimitBucket `json:"core,omitempty"`
+	Search     *RateLimitBucket `json:"search,omitempty"`
+	GraphQL    *RateLimitBucket `json:"graphql,omitempty"`
+	CodeSearch *RateLimitBucket `json:"code_search,omitempty"`
+}
+
+func convertToRateLimitBucket(rate *github.Rate) *RateLimitBucket {
+	if rate == nil {
+		return nil
+	}
+	return &RateLimitBucket{
+		Limit:     rate.Limit,
+		Remaining: rate.Remaining,
+		Used:      rate.Used,
+		ResetAt:   rate.Reset.UTC(),
+	}
+}
+
+// GetRateLimit creates a tool that reports the remaining GitHub API rate limit
+// of the authenticated user. Checking it does not count against the limit.
+func GetRateLimit(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "get_rate_limit",
+			Description: t("TOOL_GET_RATE_LIMIT_DESCRIPTION", "Get the current GitHub API rate limits for the authenticated user: the limit, remaining and used requests, and reset time of the core, search, GraphQL and code search buckets. Checking the rate limit does not count against it. Use this to pace long-running work or to decide how long to back off after a rate limit error."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_RATE_LIMIT_USER_TITLE", "Get API rate limits"),
+				ReadOnlyHint: true,
+			},
+			// Use json.RawMessage to ensure "properties" is included even when empty.
+			// OpenAI strict mode requires the properties field to be present.
+			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			limits, res, err := client.RateLimit.Get(ctx)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get rate limit",
+					res,
+					err,
+				), nil, nil
+			}
+
+			return MarshalledTextResult(RateLimitStatus{
+				Core:       convertToRateLimitBucket(limits.Core),
+				Search:     convertToRateLimitBucket(limits.Search),
+				GraphQL:    convertToRateLimitBucket(limits.GraphQL),
+				CodeSearch: convertToRateLimitBucket(limits.CodeSearch),
+			}), nil, nil
+		},
+	)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v89/github"
 	"github.com/shurcooL/githubv4"
@@ -155,10 +156,11 @@ func Test_GetMe_IFC_FeatureFlag(t *testing.T) {
 
 	depsWithIFCFeature := func(enabled bool) *BaseDeps {
 		return NewBaseDeps(
-			mustNewGHClient(t, mockedHTTPClient), nil, nil, nil,
+			mustNewGHClient(t, mockedHTTPClient), nil, nil, nil, nil,
 			translations.NullTranslationHelper,
 			FeatureFlags{},
 			0,
+			0,
 			func(_ context.Context, flagName string) (bool, error) {
 				return flagName == FeatureFlagIFCLabels && enabled, nil
 			},
@@ -582,3 +584,241 @@ func Test_GetTeamMembers(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetToolInfo(t *testing.T) {
+	t.Parallel()
+
+	serverTool := GetToolInfo(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_tool_info", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_tool_info tool should be read-only")
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		expectError    bool
+		expectedInfo   ToolInfo
+		expectedErrMsg string
+	}{
+		{
+			name:        "tool in a non-default toolset",
+			requestArgs: map[string]any{"tool_name": "list_dependabot_alerts"},
+			expectedInfo: ToolInfo{
+				Name:           "list_dependabot_alerts",
+				Toolset:        "dependabot",
+				ReadOnly:       true,
+				RequiredScopes: []string{"security_events"},
+				AcceptedScopes: []string{"repo", "security_events"},
+			},
+		},
+		{
+			name:        "write tool",
+			requestArgs: map[string]any{"tool_name": "delete_file"},
+			expectedInfo: ToolInfo{
+				Name:           "delete_file",
+				Toolset:        "repos",
+				ReadOnly:       false,
+				RequiredScopes: []string{"repo"},
+				AcceptedScopes: []string{"repo"},
+			},
+		},
+		{
+			name:        "tool without scopes",
+			requestArgs: map[string]any{"tool_name": "get_me"},
+			expectedInfo: ToolInfo{
+				Name:           "get_me",
+				Toolset:        "context",
+				ReadOnly:       true,
+				RequiredScopes: []string{},
+			},
+		},
+		{
+			name:        "deprecated name resolves to replacement",
+			requestArgs: map[string]any{"tool_name": "list_workflows"},
+			expectedInfo: ToolInfo{
+				Name:           "actions_list",
+				Toolset:        "actions",
+				ReadOnly:       true,
+				RequiredScopes: []string{"repo"},
+				AcceptedScopes: []string{"repo"},
+			},
+		},
+		{
+			name:           "unknown tool",
+			requestArgs:    map[string]any{"tool_name": "not_a_tool"},
+			expectError:    true,
+			expectedErrMsg: `unknown tool "not_a_tool"`,
+		},
+		{
+			name:           "missing tool name",
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: tool_name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := BaseDeps{}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var info ToolInfo
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &info))
+			assert.Equal(t, tc.expectedInfo, info)
+		})
+	}
+}
+
+func Test_ListScopeFilteredTools(t *testing.T) {
+	t.Parallel()
+
+	serverTool := ListScopeFilteredTools(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_scope_filtered_tools", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "list_scope_filtered_tools tool should be read-only")
+
+	deps := BaseDeps{}
+	handler := serverTool.Handler(deps)
+
+	t.Run("lists tools hidden by missing scopes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := ghcontext.WithTokenScopes(ContextWithDeps(context.Background(), deps), []string{"repo"})
+		request := createMCPRequest(map[string]any{})
+		result, err := handler(ctx, &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var out ScopeFilteredTools
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+		assert.Equal(t, []string{"repo"}, out.TokenScopes)
+		assert.Contains(t, out.MissingScopes, "notifications")
+		assert.NotContains(t, out.MissingScopes, "repo")
+
+		byName := make(map[string]ScopeFilteredTool, len(out.Tools))
+		for _, filtered := range out.Tools {
+			byName[filtered.Name] = filtered
+		}
+		require.Contains(t, byName, "list_notifications")
+		assert.Equal(t, "notifications", byName["list_notifications"].Toolset)
+		assert.Equal(t, []string{"notifications"}, byName["list_notifications"].RequiredScopes)
+		assert.NotContains(t, byName, "delete_file", "tools the token has scopes for are not filtered")
+		assert.NotContains(t, byName, "get_me", "tools without scope requirements are not filtered")
+	})
+
+	t.Run("unknown token scopes", func(t *testing.T) {
+		t.Parallel()
+
+		request := createMCPRequest(map[string]any{})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "scopes of the current token are not known")
+	})
+}
+
+func Test_GetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	serverTool := GetRateLimit(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_rate_limit", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_rate_limit tool should be read-only")
+
+	resetAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	mockRateLimit := map[string]any{
+		"resources": map[string]any{
+			"core":        map[string]any{"limit": 5000, "remaining": 4990, "used": 10, "reset": resetAt.Unix()},
+			"search":      map[string]any{"limit": 30, "remaining": 0, "used": 30, "reset": resetAt.Unix()},
+			"graphql":     map[string]any{"limit": 5000, "remaining": 5000, "used": 0, "reset": resetAt.Unix()},
+			"code_search": map[string]any{"limit": 10, "remaining": 9, "used": 1, "reset": resetAt.Unix()},
+			"scim":        map[string]any{"limit": 15000, "remaining": 15000, "used": 0, "reset": resetAt.Unix()},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		expectToolError    bool
+		expectedToolErrMsg string
+		expected           RateLimitStatus
+	}{
+		{
+			name: "returns core, search, graphql and code search buckets",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /rate_limit": mockResponse(t, http.StatusOK, mockRateLimit),
+			}),
+			expected: RateLimitStatus{
+				Core:       &RateLimitBucket{Limit: 5000, Remaining: 4990, Used: 10, ResetAt: resetAt},
+				Search:     &RateLimitBucket{Limit: 30, Remaining: 0, Used: 30, ResetAt: resetAt},
+				GraphQL:    &RateLimitBucket{Limit: 5000, Remaining: 5000, Used: 0, ResetAt: resetAt},
+				CodeSearch: &RateLimitBucket{Limit: 10, Remaining: 9, Used: 1, ResetAt: resetAt},
+			},
+		},
+		{
+			name: "omits buckets GitHub does not report",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /rate_limit": mockResponse(t, http.StatusOK, map[string]any{
+					"resources": map[string]any{
+						"core": map[string]any{"limit": 60, "remaining": 59, "used": 1, "reset": resetAt.Unix()},
+					},
+				}),
+			}),
+			expected: RateLimitStatus{
+				Core: &RateLimitBucket{Limit: 60, Remaining: 59, Used: 1, ResetAt: resetAt},
+			},
+		},
+		{
+			name: "get rate limit fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /rate_limit": mockResponse(t, http.StatusNotFound, `{"message": "Rate limiting is not enabled."}`),
+			}),
+			expectToolError:    true,
+			expectedToolErrMsg: "failed to get rate limit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: mustNewGHClient(t, tc.mockedClient), Obsv: stubExporters()}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				require.True(t, result.IsError, "expected tool call result to be an error")
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedToolErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var status RateLimitStatus
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &status))
+			assert.Equal(t, tc.expected, status)
+			assert.NotContains(t, textContent.Text, "scim")
+		})
+	}
+}
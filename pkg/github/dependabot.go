@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
@@ -70,7 +71,7 @@ func GetDependabotAlert(t translations.TranslationHelperFunc) inventory.ServerTo
 			alert, resp, err := client.Dependabot.GetRepoAlert(ctx, owner, repo, alertNumber)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					dependabotErrMsg(fmt.Sprintf("failed to get alert with number '%d'", alertNumber), owner, repo, resp),
+					dependabotErrMsg(fmt.Sprintf("failed to get alert with number '%d'", alertNumber), owner, repo, resp, err),
 					resp,
 					err,
 				), nil, nil
@@ -123,6 +124,15 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 				Description: "Filter dependabot alerts by severity",
 				Enum:        []any{"low", "medium", "high", "critical"},
 			},
+			"ecosystem": {
+				Type:        "string",
+				Description: "Filter dependabot alerts by package ecosystem, such as npm, pip, maven, rubygems, go, nuget, composer, rust or pub. Multiple ecosystems may be given as a comma-separated list",
+			},
+			"scope": {
+				Type:        "string",
+				Description: "Filter dependabot alerts by the scope of the vulnerable dependency",
+				Enum:        []any{"development", "runtime"},
+			},
 		},
 		Required: []string{"owner", "repo"},
 	}
@@ -157,6 +167,14 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			ecosystem, err := OptionalParam[string](args, "ecosystem")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			scope, err := OptionalParam[string](args, "scope")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			pagination, err := OptionalCursorPaginationParams(args)
 			if err != nil {
@@ -169,8 +187,10 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 			}
 
 			alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repo, &github.ListAlertsOptions{
-				State:    ToStringPtr(state),
-				Severity: ToStringPtr(severity),
+				State:     ToStringPtr(state),
+				Severity:  ToStringPtr(severity),
+				Ecosystem: ToStringPtr(ecosystem),
+				Scope:     ToStringPtr(scope),
 				ListCursorOptions: github.ListCursorOptions{
 					PerPage: pagination.PerPage,
 					After:   pagination.After,
@@ -178,7 +198,7 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 			})
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					dependabotErrMsg(fmt.Sprintf("failed to list alerts for repository '%s/%s'", owner, repo), owner, repo, resp),
+					dependabotErrMsg(fmt.Sprintf("failed to list alerts for repository '%s/%s'", owner, repo), owner, repo, resp, err),
 					resp,
 					err,
 				), nil, nil
@@ -193,10 +213,10 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list alerts", resp, body), nil, nil
 			}
 
-			response := map[string]any{
+			response := withEmptyListMessage(map[string]any{
 				"alerts":   alerts,
 				"pageInfo": buildPageInfo(resp),
-			}
+			}, "alerts", alerts)
 
 			r, err := json.Marshal(response)
 			if err != nil {
@@ -213,15 +233,21 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 	)
 }
 
-// dependabotErrMsg enhances error messages for dependabot API failures by
-// appending a hint about token permissions when the response indicates
-// the token may lack access to the repository (403 or 404).
-func dependabotErrMsg(base, owner, repo string, resp *github.Response) string {
-	if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) {
-		return fmt.Sprintf("%s. Your token may not have access to Dependabot alerts on %s/%s. "+
-			"To access Dependabot alerts, the token needs the 'security_events' scope or, for fine-grained tokens, "+
-			"Dependabot alerts read permission for this specific repository.",
+// dependabotErrMsg enhances error messages for dependabot API failures. When
+// GitHub reports that Dependabot alerts are disabled for the repository the
+// message says so; otherwise a 403 or 404 gets a hint about token permissions,
+// since the token may lack access to the repository.
+func dependabotErrMsg(base, owner, repo string, resp *github.Response, err error) string {
+	if resp == nil || (resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusNotFound) {
+		return base
+	}
+	if err != nil && strings.Contains(strings.ToLower(err.Error()), "dependabot alerts are disabled") {
+		return fmt.Sprintf("%s. Dependabot alerts are disabled for %s/%s. "+
+			"A repository administrator can enable them under Settings > Code security.",
 			base, owner, repo)
 	}
-	return base
+	return fmt.Sprintf("%s. Your token may not have access to Dependabot alerts on %s/%s. "+
+		"To access Dependabot alerts, the token needs the 'security_events' scope or, for fine-grained tokens, "+
+		"Dependabot alerts read permission for this specific repository.",
+		base, owner, repo)
 }
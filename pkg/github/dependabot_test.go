@@ -160,6 +160,7 @@ func Test_ListDependabotAlerts(t *testing.T) {
 		expectError        bool
 		expectedAlerts     []*github.DependabotAlert
 		expectedNextCursor string
+		expectedMessage    string
 		expectedErrMsg     string
 	}{
 		{
@@ -180,6 +181,19 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			expectError:    false,
 			expectedAlerts: []*github.DependabotAlert{&criticalAlert},
 		},
+		{
+			name: "empty alerts listing includes message",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependabotAlertsByOwnerByRepo: mockResponse(t, http.StatusOK, []*github.DependabotAlert{}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:     false,
+			expectedAlerts:  []*github.DependabotAlert{},
+			expectedMessage: "No alerts matched",
+		},
 		{
 			name: "successful severity filtered listing",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -198,6 +212,26 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			expectError:    false,
 			expectedAlerts: []*github.DependabotAlert{&highSeverityAlert},
 		},
+		{
+			name: "successful ecosystem and scope filtered listing",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependabotAlertsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"ecosystem": "npm",
+					"scope":     "runtime",
+					"per_page":  "30",
+				}).andThen(
+					mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"ecosystem": "npm",
+				"scope":     "runtime",
+			},
+			expectError:    false,
+			expectedAlerts: []*github.DependabotAlert{&criticalAlert},
+		},
 		{
 			name: "successful all alerts listing",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -286,6 +320,21 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "Your token may not have access to Dependabot alerts on owner/repo",
 		},
+		{
+			name: "alerts listing with dependabot disabled explains why",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependabotAlertsByOwnerByRepo: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "Dependabot alerts are disabled for this repository."}`))
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "Dependabot alerts are disabled for owner/repo",
+		},
 	}
 
 	for _, tc := range tests {
@@ -318,10 +367,12 @@ func Test_ListDependabotAlerts(t *testing.T) {
 					HasNextPage bool   `json:"hasNextPage"`
 					NextCursor  string `json:"nextCursor"`
 				} `json:"pageInfo"`
+				Message string `json:"message"`
 			}
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			assert.NoError(t, err)
 			assert.Len(t, returnedResult.Alerts, len(tc.expectedAlerts))
+			assert.Equal(t, tc.expectedMessage, returnedResult.Message)
 			for i, alert := range returnedResult.Alerts {
 				assert.Equal(t, *tc.expectedAlerts[i].Number, *alert.Number)
 				assert.Equal(t, *tc.expectedAlerts[i].HTMLURL, *alert.HTMLURL)
@@ -86,6 +86,10 @@ type ToolDependencies interface {
 	// GetRepoAccessCache returns the lockdown mode repo access cache
 	GetRepoAccessCache(ctx context.Context) (*lockdown.RepoAccessCache, error)
 
+	// GetRawContentCache returns the server's raw file content cache, or nil
+	// when raw content is not cached
+	GetRawContentCache(ctx context.Context) *raw.ContentCache
+
 	// GetT returns the translation helper function
 	GetT() translations.TranslationHelperFunc
 
@@ -95,6 +99,10 @@ type ToolDependencies interface {
 	// GetContentWindowSize returns the content window size for log truncation
 	GetContentWindowSize() int
 
+	// GetMaxWorkflowJobPages returns how many pages of 100 jobs are read
+	// when collecting the jobs of a workflow run
+	GetMaxWorkflowJobPages() int
+
 	// IsFeatureEnabled checks if a feature flag is enabled.
 	IsFeatureEnabled(ctx context.Context, flagName string) bool
 
@@ -117,10 +125,12 @@ type BaseDeps struct {
 	RawClient *raw.Client
 
 	// Static dependencies
-	RepoAccessCache   *lockdown.RepoAccessCache
-	T                 translations.TranslationHelperFunc
-	Flags             FeatureFlags
-	ContentWindowSize int
+	RepoAccessCache     *lockdown.RepoAccessCache
+	RawContentCache     *raw.ContentCache
+	T                   translations.TranslationHelperFunc
+	Flags               FeatureFlags
+	ContentWindowSize   int
+	MaxWorkflowJobPages int
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
@@ -138,22 +148,26 @@ func NewBaseDeps(
 	gqlClient *githubv4.Client,
 	rawClient *raw.Client,
 	repoAccessCache *lockdown.RepoAccessCache,
+	rawContentCache *raw.ContentCache,
 	t translations.TranslationHelperFunc,
 	flags FeatureFlags,
 	contentWindowSize int,
+	maxWorkflowJobPages int,
 	featureChecker inventory.FeatureFlagChecker,
 	obsv observability.Exporters,
 ) *BaseDeps {
 	return &BaseDeps{
-		Client:            client,
-		GQLClient:         gqlClient,
-		RawClient:         rawClient,
-		RepoAccessCache:   repoAccessCache,
-		T:                 t,
-		Flags:             flags,
-		ContentWindowSize: contentWindowSize,
-		featureChecker:    featureChecker,
-		Obsv:              obsv,
+		Client:              client,
+		GQLClient:           gqlClient,
+		RawClient:           rawClient,
+		RepoAccessCache:     repoAccessCache,
+		RawContentCache:     rawContentCache,
+		T:                   t,
+		Flags:               flags,
+		ContentWindowSize:   contentWindowSize,
+		MaxWorkflowJobPages: maxWorkflowJobPages,
+		featureChecker:      featureChecker,
+		Obsv:                obsv,
 	}
 }
 
@@ -177,6 +191,11 @@ func (d BaseDeps) GetRepoAccessCache(_ context.Context) (*lockdown.RepoAccessCac
 	return d.RepoAccessCache, nil
 }
 
+// GetRawContentCache implements ToolDependencies.
+func (d BaseDeps) GetRawContentCache(_ context.Context) *raw.ContentCache {
+	return d.RawContentCache
+}
+
 // GetT implements ToolDependencies.
 func (d BaseDeps) GetT() translations.TranslationHelperFunc { return d.T }
 
@@ -186,6 +205,11 @@ func (d BaseDeps) GetFlags(_ context.Context) FeatureFlags { return d.Flags }
 // GetContentWindowSize implements ToolDependencies.
 func (d BaseDeps) GetContentWindowSize() int { return d.ContentWindowSize }
 
+// GetMaxWorkflowJobPages implements ToolDependencies.
+func (d BaseDeps) GetMaxWorkflowJobPages() int {
+	return maxWorkflowJobPagesOrDefault(d.MaxWorkflowJobPages)
+}
+
 // Logger implements ToolDependencies.
 func (d BaseDeps) Logger(_ context.Context) *slog.Logger {
 	return d.Obsv.Logger()
@@ -227,14 +251,25 @@ func (d BaseDeps) IsFeatureEnabled(ctx context.Context, flagName string) bool {
 // requiredScopes specifies the minimum OAuth scopes needed for this tool.
 // AcceptedScopes are automatically derived using the scope hierarchy (e.g., if
 // public_repo is required, repo is also accepted since repo grants public_repo).
+//
+// When the arguments are a map, common parameter synonyms (e.g. "repository"
+// for "repo") are resolved to their canonical names, and owner and repo are
+// normalized before the handler runs, so pasted URLs and "owner/repo" strings
+// resolve.
 func NewTool[In, Out any](
 	toolset inventory.ToolsetMetadata,
 	tool mcp.Tool,
 	requiredScopes []scopes.Scope,
 	handler func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, Out, error),
 ) inventory.ServerTool {
+	aliases := toolParamAliases(tool)
+	hasRepo := toolHasProperty(tool, "repo")
 	st := inventory.NewServerToolWithContextHandler(tool, toolset, func(ctx context.Context, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, Out, error) {
 		deps := MustDepsFromContext(ctx)
+		if m, ok := any(args).(map[string]any); ok {
+			applyParamAliases(m, aliases)
+			normalizeOwnerRepoArgs(m, hasRepo)
+		}
 		return handler(ctx, deps, req, args)
 	})
 	st.RequiredScopes = scopes.ToStringSlice(requiredScopes...)
@@ -267,12 +302,16 @@ func NewToolFromHandler(
 
 type RequestDeps struct {
 	// Static dependencies
-	apiHosts          utils.APIHostResolver
-	version           string
-	lockdownMode      bool
-	RepoAccessOpts    []lockdown.RepoAccessOption
-	T                 translations.TranslationHelperFunc
-	ContentWindowSize int
+	apiHosts            utils.APIHostResolver
+	version             string
+	userAgent           string
+	transport           http.RoundTripper
+	lockdownMode        bool
+	RepoAccessOpts      []lockdown.RepoAccessOption
+	rawContentCache     *raw.ContentCache
+	T                   translations.TranslationHelperFunc
+	ContentWindowSize   int
+	MaxWorkflowJobPages int
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
@@ -285,25 +324,41 @@ type RequestDeps struct {
 func NewRequestDeps(
 	apiHosts utils.APIHostResolver,
 	version string,
+	userAgent string,
+	baseTransport http.RoundTripper,
 	lockdownMode bool,
 	repoAccessOpts []lockdown.RepoAccessOption,
+	rawContentCache *raw.ContentCache,
 	t translations.TranslationHelperFunc,
 	contentWindowSize int,
+	maxWorkflowJobPages int,
 	featureChecker inventory.FeatureFlagChecker,
 	obsv observability.Exporters,
 ) *RequestDeps {
 	return &RequestDeps{
-		apiHosts:          apiHosts,
-		version:           version,
-		lockdownMode:      lockdownMode,
-		RepoAccessOpts:    repoAccessOpts,
-		T:                 t,
-		ContentWindowSize: contentWindowSize,
-		featureChecker:    featureChecker,
-		obsv:              obsv,
+		apiHosts:            apiHosts,
+		version:             version,
+		userAgent:           userAgent,
+		transport:           baseTransport,
+		lockdownMode:        lockdownMode,
+		RepoAccessOpts:      repoAccessOpts,
+		rawContentCache:     rawContentCache,
+		T:                   t,
+		ContentWindowSize:   contentWindowSize,
+		MaxWorkflowJobPages: maxWorkflowJobPages,
+		featureChecker:      featureChecker,
+		obsv:                obsv,
 	}
 }
 
+// baseTransport returns the configured outbound transport, or http.DefaultTransport.
+func (d *RequestDeps) baseTransport() http.RoundTripper {
+	if d.transport == nil {
+		return http.DefaultTransport
+	}
+	return d.transport
+}
+
 // GetClient implements ToolDependencies.
 func (d *RequestDeps) GetClient(ctx context.Context) (*gogithub.Client, error) {
 	// extract the token from the context
@@ -324,8 +379,9 @@ func (d *RequestDeps) GetClient(ctx context.Context) (*gogithub.Client, error) {
 
 	// Construct REST client
 	restClient, err := gogithub.NewClient(
+		gogithub.WithHTTPClient(&http.Client{Transport: d.baseTransport()}),
 		gogithub.WithAuthToken(token),
-		gogithub.WithUserAgent(fmt.Sprintf("github-mcp-server/%s", d.version)),
+		gogithub.WithUserAgent(UserAgent(d.userAgent, d.version)),
 		gogithub.WithEnterpriseURLs(baseRestURL.String(), uploadURL.String()),
 	)
 	if err != nil {
@@ -350,7 +406,10 @@ func (d *RequestDeps) GetGQLClient(ctx context.Context) (*githubv4.Client, error
 	gqlHTTPClient := &http.Client{
 		Transport: &transport.BearerAuthTransport{
 			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
+				Transport: &transport.UserAgentTransport{
+					Transport: d.baseTransport(),
+					Agent:     UserAgent(d.userAgent, d.version),
+				},
 			},
 			Token: token,
 		},
@@ -406,6 +465,12 @@ func (d *RequestDeps) GetRepoAccessCache(ctx context.Context) (*lockdown.RepoAcc
 	return instance, nil
 }
 
+// GetRawContentCache implements ToolDependencies. The cache is shared by all
+// requests to the server.
+func (d *RequestDeps) GetRawContentCache(_ context.Context) *raw.ContentCache {
+	return d.rawContentCache
+}
+
 // GetT implements ToolDependencies.
 func (d *RequestDeps) GetT() translations.TranslationHelperFunc { return d.T }
 
@@ -419,6 +484,11 @@ func (d *RequestDeps) GetFlags(ctx context.Context) FeatureFlags {
 // GetContentWindowSize implements ToolDependencies.
 func (d *RequestDeps) GetContentWindowSize() int { return d.ContentWindowSize }
 
+// GetMaxWorkflowJobPages implements ToolDependencies.
+func (d *RequestDeps) GetMaxWorkflowJobPages() int {
+	return maxWorkflowJobPagesOrDefault(d.MaxWorkflowJobPages)
+}
+
 // Logger implements ToolDependencies.
 func (d *RequestDeps) Logger(_ context.Context) *slog.Logger {
 	return d.obsv.Logger()
@@ -32,9 +32,11 @@ func TestIsFeatureEnabled_WithEnabledFlag(t *testing.T) {
 		nil, // gqlClient
 		nil, // rawClient
 		nil, // repoAccessCache
+		nil, // rawContentCache
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		0,       // maxWorkflowJobPages
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -57,9 +59,11 @@ func TestIsFeatureEnabled_WithoutChecker(t *testing.T) {
 		nil, // gqlClient
 		nil, // rawClient
 		nil, // repoAccessCache
+		nil, // rawContentCache
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,   // contentWindowSize
+		0,   // maxWorkflowJobPages
 		nil, // featureChecker (nil)
 		testExporters(),
 	)
@@ -82,9 +86,11 @@ func TestIsFeatureEnabled_EmptyFlagName(t *testing.T) {
 		nil, // gqlClient
 		nil, // rawClient
 		nil, // repoAccessCache
+		nil, // rawContentCache
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		0,       // maxWorkflowJobPages
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -107,9 +113,11 @@ func TestIsFeatureEnabled_CheckerError(t *testing.T) {
 		nil, // gqlClient
 		nil, // rawClient
 		nil, // repoAccessCache
+		nil, // rawContentCache
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		0,       // maxWorkflowJobPages
 		checker, // featureChecker
 		testExporters(),
 	)
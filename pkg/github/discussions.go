@@ -62,6 +62,9 @@ type NodeFragment struct {
 	Category struct {
 		Name githubv4.String
 	} `graphql:"category"`
+	Comments struct {
+		TotalCount githubv4.Int
+	}
 	URL githubv4.String `graphql:"url"`
 }
 
@@ -109,6 +112,7 @@ func fragmentToDiscussion(fragment NodeFragment) *github.Discussion {
 		DiscussionCategory: &github.DiscussionCategory{
 			Name: github.Ptr(string(fragment.Category.Name)),
 		},
+		Comments: github.Ptr(int(fragment.Comments.TotalCount)),
 	}
 }
 
@@ -258,7 +262,7 @@ func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool
 			}
 
 			// Create response with pagination info
-			response := map[string]any{
+			response := withEmptyListMessage(map[string]any{
 				"discussions": discussions,
 				"pageInfo": map[string]any{
 					"hasNextPage":     pageInfo.HasNextPage,
@@ -267,7 +271,7 @@ func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool
 					"endCursor":       string(pageInfo.EndCursor),
 				},
 				"totalCount": totalCount,
-			}
+			}, "discussions", discussions)
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -287,7 +291,7 @@ func GetDiscussion(t translations.TranslationHelperFunc) inventory.ServerTool {
 		ToolsetMetadataDiscussions,
 		mcp.Tool{
 			Name:        "get_discussion",
-			Description: t("TOOL_GET_DISCUSSION_DESCRIPTION", "Get a specific discussion by ID"),
+			Description: t("TOOL_GET_DISCUSSION_DESCRIPTION", "Get a specific discussion by ID, including its body, author, comment count and, for answered questions, the chosen answer"),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_GET_DISCUSSION_USER_TITLE", "Get discussion"),
 				ReadOnlyHint: true,
@@ -341,6 +345,20 @@ func GetDiscussion(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Category       struct {
 							Name githubv4.String
 						} `graphql:"category"`
+						Author struct {
+							Login githubv4.String
+						}
+						Comments struct {
+							TotalCount githubv4.Int
+						}
+						Answer *struct {
+							Body      githubv4.String
+							URL       githubv4.String `graphql:"url"`
+							CreatedAt githubv4.DateTime
+							Author    struct {
+								Login githubv4.String
+							}
+						}
 					} `graphql:"discussion(number: $discussionNumber)"`
 				} `graphql:"repository(owner: $owner, name: $repo)"`
 			}
@@ -369,12 +387,26 @@ func GetDiscussion(t translations.TranslationHelperFunc) inventory.ServerTool {
 				"category": map[string]any{
 					"name": string(d.Category.Name),
 				},
+				"author": map[string]any{
+					"login": string(d.Author.Login),
+				},
+				"comments": int(d.Comments.TotalCount),
 			}
 
 			// Add optional timestamp fields if present
 			if d.AnswerChosenAt != nil {
 				response["answerChosenAt"] = d.AnswerChosenAt.Time
 			}
+			if d.Answer != nil {
+				response["answer"] = map[string]any{
+					"body":      string(d.Answer.Body),
+					"url":       string(d.Answer.URL),
+					"createdAt": d.Answer.CreatedAt.Time,
+					"author": map[string]any{
+						"login": string(d.Answer.Author.Login),
+					},
+				}
+			}
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -573,7 +605,7 @@ func GetDiscussionComments(t translations.TranslationHelperFunc) inventory.Serve
 			}
 
 			// Create response with pagination info
-			response := map[string]any{
+			response := withEmptyListMessage(map[string]any{
 				"comments": comments,
 				"pageInfo": map[string]any{
 					"hasNextPage":     pageInfo.HasNextPage,
@@ -582,7 +614,7 @@ func GetDiscussionComments(t translations.TranslationHelperFunc) inventory.Serve
 					"endCursor":       string(pageInfo.EndCursor),
 				},
 				"totalCount": totalCount,
-			}
+			}, "comments", comments)
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -1082,7 +1114,7 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 			}
 
 			// Create response with pagination info
-			response := map[string]any{
+			response := withEmptyListMessage(map[string]any{
 				"categories": categories,
 				"pageInfo": map[string]any{
 					"hasNextPage":     q.Repository.DiscussionCategories.PageInfo.HasNextPage,
@@ -1091,7 +1123,7 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 					"endCursor":       string(q.Repository.DiscussionCategories.PageInfo.EndCursor),
 				},
 				"totalCount": q.Repository.DiscussionCategories.TotalCount,
-			}
+			}, "categories", categories)
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -18,7 +18,7 @@ import (
 
 var (
 	discussionsGeneral = []map[string]any{
-		{"number": 1, "title": "Discussion 1 title", "createdAt": "2023-01-01T00:00:00Z", "updatedAt": "2023-01-01T00:00:00Z", "closed": false, "isAnswered": false, "author": map[string]any{"login": "user1"}, "url": "https://github.com/owner/repo/discussions/1", "category": map[string]any{"name": "General"}},
+		{"number": 1, "title": "Discussion 1 title", "createdAt": "2023-01-01T00:00:00Z", "updatedAt": "2023-01-01T00:00:00Z", "closed": false, "isAnswered": false, "author": map[string]any{"login": "user1"}, "url": "https://github.com/owner/repo/discussions/1", "category": map[string]any{"name": "General"}, "comments": map[string]any{"totalCount": 4}},
 		{"number": 3, "title": "Discussion 3 title", "createdAt": "2023-03-01T00:00:00Z", "updatedAt": "2023-02-01T00:00:00Z", "closed": false, "isAnswered": false, "author": map[string]any{"login": "user1"}, "url": "https://github.com/owner/repo/discussions/3", "category": map[string]any{"name": "General"}},
 	}
 	discussionsAll = []map[string]any{
@@ -407,10 +407,10 @@ func Test_ListDiscussions(t *testing.T) {
 	}
 
 	// Define the actual query strings that match the implementation
-	qBasicNoOrder := "query($after:String$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
-	qWithCategoryNoOrder := "query($after:String$categoryId:ID!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
-	qBasicWithOrder := "query($after:String$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
-	qWithCategoryAndOrder := "query($after:String$categoryId:ID!$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qBasicNoOrder := "query($after:String$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},comments{totalCount},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qWithCategoryNoOrder := "query($after:String$categoryId:ID!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},comments{totalCount},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qBasicWithOrder := "query($after:String$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},comments{totalCount},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qWithCategoryAndOrder := "query($after:String$categoryId:ID!$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},comments{totalCount},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -489,6 +489,9 @@ func Test_ListDiscussions(t *testing.T) {
 					assert.NotEmpty(t, *discussion.DiscussionCategory.Name, "Discussion should have category name")
 				}
 			}
+			if tc.name == "filter by category ID" {
+				assert.Equal(t, 4, response.Discussions[0].GetComments(), "Discussion should report its comment count")
+			}
 		})
 	}
 }
@@ -509,7 +512,7 @@ func Test_GetDiscussion(t *testing.T) {
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "discussionNumber"})
 
 	// Use exact string query that matches implementation output
-	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name}}}}"
+	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name},author{login},comments{totalCount},answer{body,url,createdAt,author{login}}}}}"
 
 	vars := map[string]any{
 		"owner":            "owner",
@@ -547,6 +550,47 @@ func Test_GetDiscussion(t *testing.T) {
 				"isAnswered": false,
 			},
 		},
+		{
+			name: "successful retrieval of answered discussion",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{"discussion": map[string]any{
+					"number":         2,
+					"title":          "How do I configure toolsets?",
+					"body":           "Which flag selects toolsets?",
+					"url":            "https://github.com/owner/repo/discussions/2",
+					"createdAt":      "2025-04-25T12:00:00Z",
+					"closed":         false,
+					"isAnswered":     true,
+					"answerChosenAt": "2025-04-26T12:00:00Z",
+					"category":       map[string]any{"name": "General"},
+					"author":         map[string]any{"login": "asker"},
+					"comments":       map[string]any{"totalCount": 3},
+					"answer": map[string]any{
+						"body":      "Use --toolsets.",
+						"url":       "https://github.com/owner/repo/discussions/2#discussioncomment-1",
+						"createdAt": "2025-04-26T10:00:00Z",
+						"author":    map[string]any{"login": "maintainer"},
+					},
+				}},
+			}),
+			expectError: false,
+			expected: map[string]any{
+				"number":     float64(2),
+				"title":      "How do I configure toolsets?",
+				"body":       "Which flag selects toolsets?",
+				"url":        "https://github.com/owner/repo/discussions/2",
+				"closed":     false,
+				"isAnswered": true,
+				"author":     map[string]any{"login": "asker"},
+				"comments":   float64(3),
+				"answer": map[string]any{
+					"body":      "Use --toolsets.",
+					"url":       "https://github.com/owner/repo/discussions/2#discussioncomment-1",
+					"createdAt": "2025-04-26T10:00:00Z",
+					"author":    map[string]any{"login": "maintainer"},
+				},
+			},
+		},
 		{
 			name:        "discussion not found",
 			response:    githubv4mock.ErrorResponse("discussion not found"),
@@ -582,6 +626,13 @@ func Test_GetDiscussion(t *testing.T) {
 			assert.Equal(t, tc.expected["url"], out["url"])
 			assert.Equal(t, tc.expected["closed"], out["closed"])
 			assert.Equal(t, tc.expected["isAnswered"], out["isAnswered"])
+			if author, ok := tc.expected["author"]; ok {
+				assert.Equal(t, author, out["author"])
+			}
+			if comments, ok := tc.expected["comments"]; ok {
+				assert.Equal(t, comments, out["comments"])
+			}
+			assert.Equal(t, tc.expected["answer"], out["answer"])
 			// Check category is present
 			category, ok := out["category"].(map[string]any)
 			require.True(t, ok)
@@ -594,7 +645,7 @@ func Test_GetDiscussionWithStringNumber(t *testing.T) {
 	// Test that WeakDecode handles string discussionNumber from MCP clients
 	toolDef := GetDiscussion(translations.NullTranslationHelper)
 
-	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name}}}}"
+	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,title,body,createdAt,closed,isAnswered,answerChosenAt,url,category{name},author{login},comments{totalCount},answer{body,url,createdAt,author{login}}}}}"
 
 	vars := map[string]any{
 		"owner":            "owner",
@@ -97,10 +97,11 @@ func TestHelloWorld_ConditionalBehavior_Featureflag(t *testing.T) {
 
 			// Create deps with the checker
 			deps := NewBaseDeps(
-				nil, nil, nil, nil,
+				nil, nil, nil, nil, nil,
 				translations.NullTranslationHelper,
 				FeatureFlags{},
 				0,
+				0,
 				featureCheckerFor(enabledFlags...),
 				stubExporters(),
 			)
@@ -377,3 +377,464 @@ func UpdateGist(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 	)
 }
+
+// ListGistComments creates a tool to list the comments on a gist
+func ListGistComments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "list_gist_comments",
+			Description: t("TOOL_LIST_GIST_COMMENTS_DESCRIPTION", "List comments on a gist"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_GIST_COMMENTS", "List Gist Comments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"gist_id": {
+						Type:        "string",
+						Description: "The ID of the gist",
+					},
+				},
+				Required: []string{"gist_id"},
+			}),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			gistID, err := RequiredParam[string](args, "gist_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			comments, resp, err := client.Gists.ListComments(ctx, gistID, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list gist comments", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list gist comments", resp, body), nil, nil
+			}
+
+			minimalComments := make([]MinimalGistComment, 0, len(comments))
+			for _, comment := range comments {
+				minimalComments = append(minimalComments, convertToMinimalGistComment(comment))
+			}
+
+			r, err := json.Marshal(minimalComments)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelGist())
+			return result, nil, nil
+		},
+	)
+}
+
+// CreateGistComment creates a tool to add a comment to a gist
+func CreateGistComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "create_gist_comment",
+			Description: t("TOOL_CREATE_GIST_COMMENT_DESCRIPTION", "Add a comment to a gist"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_GIST_COMMENT", "Create Gist Comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"gist_id": {
+						Type:        "string",
+						Description: "The ID of the gist",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Comment content",
+					},
+				},
+				Required: []string{"gist_id", "body"},
+			},
+		},
+		[]scopes.Scope{scopes.Gist},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			gistID, err := RequiredParam[string](args, "gist_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			body, err := RequiredParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			comment, resp, err := client.Gists.CreateComment(ctx, gistID, github.CreateGistCommentRequest{Body: body})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create gist comment", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				respBody, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to create gist comment", resp, respBody), nil, nil
+			}
+
+			r, err := json.Marshal(convertToMinimalGistComment(comment))
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// IsGistStarred creates a tool to check whether the authenticated user has starred a gist
+func IsGistStarred(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "is_gist_starred",
+			Description: t("TOOL_IS_GIST_STARRED_DESCRIPTION", "Check whether the authenticated user has starred a gist"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_IS_GIST_STARRED", "Check Gist Star"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: gistIDSchema(),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			gistID, err := RequiredParam[string](args, "gist_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			starred, resp, err := client.Gists.IsStarred(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to check gist star", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return gistStarResult(gistID, starred)
+		},
+	)
+}
+
+// StarGist creates a tool to star a gist
+func StarGist(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "star_gist",
+			Description: t("TOOL_STAR_GIST_DESCRIPTION", "Star a gist"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_STAR_GIST", "Star Gist"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: gistIDSchema(),
+		},
+		[]scopes.Scope{scopes.Gist},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return setGistStar(ctx, deps, args, true)
+		},
+	)
+}
+
+// UnstarGist creates a tool to unstar a gist
+func UnstarGist(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "unstar_gist",
+			Description: t("TOOL_UNSTAR_GIST_DESCRIPTION", "Unstar a gist"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UNSTAR_GIST", "Unstar Gist"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: gistIDSchema(),
+		},
+		[]scopes.Scope{scopes.Gist},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return setGistStar(ctx, deps, args, false)
+		},
+	)
+}
+
+func gistIDSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"gist_id": {
+				Type:        "string",
+				Description: "The ID of the gist",
+			},
+		},
+		Required: []string{"gist_id"},
+	}
+}
+
+// setGistStar stars or unstars a gist and reports the resulting star state.
+func setGistStar(ctx context.Context, deps ToolDependencies, args map[string]any, star bool) (*mcp.CallToolResult, any, error) {
+	gistID, err := RequiredParam[string](args, "gist_id")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+	}
+
+	action, call := "star", client.Gists.Star
+	if !star {
+		action, call = "unstar", client.Gists.Unstar
+	}
+
+	resp, err := call(ctx, gistID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to %s gist", action), resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to %s gist", action), resp, body), nil, nil
+	}
+
+	return gistStarResult(gistID, star)
+}
+
+func gistStarResult(gistID string, starred bool) (*mcp.CallToolResult, any, error) {
+	r, err := json.Marshal(map[string]any{
+		"gist_id": gistID,
+		"starred": starred,
+	})
+	if err != nil {
+		return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+	}
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// ForkGist creates a tool to fork a gist
+func ForkGist(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "fork_gist",
+			Description: t("TOOL_FORK_GIST_DESCRIPTION", "Fork a gist into the authenticated user's account. Forking your own gist is allowed and creates a separate copy."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_FORK_GIST", "Fork Gist"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: gistIDSchema(),
+		},
+		[]scopes.Scope{scopes.Gist},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			gistID, err := RequiredParam[string](args, "gist_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			fork, resp, err := client.Gists.Fork(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to fork gist %s", gistID), resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to fork gist %s", gistID), resp, body), nil, nil
+			}
+
+			minimalResponse := MinimalResponse{
+				ID:  fork.GetID(),
+				URL: fork.GetHTMLURL(),
+			}
+
+			r, err := json.Marshal(minimalResponse)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// ListGistCommits creates a tool to list the revision history of a gist
+func ListGistCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "list_gist_commits",
+			Description: t("TOOL_LIST_GIST_COMMITS_DESCRIPTION", "List the revision history of a gist. Use the returned version with get_gist_revision to view a specific revision."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_GIST_COMMITS", "List Gist Commits"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(gistIDSchema()),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			gistID, err := RequiredParam[string](args, "gist_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			commits, resp, err := client.Gists.ListCommits(ctx, gistID, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list gist commits", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list gist commits", resp, body), nil, nil
+			}
+
+			minimalCommits := make([]MinimalGistCommit, 0, len(commits))
+			for _, commit := range commits {
+				minimalCommits = append(minimalCommits, convertToMinimalGistCommit(commit))
+			}
+
+			r, err := json.Marshal(minimalCommits)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelGist())
+			return result, nil, nil
+		},
+	)
+}
+
+// GetGistRevision creates a tool to get the content of a gist at a specific revision
+func GetGistRevision(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGists,
+		mcp.Tool{
+			Name:        "get_gist_revision",
+			Description: t("TOOL_GET_GIST_REVISION_DESCRIPTION", "Get gist content at a specific revision, by gist ID and version SHA"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_GIST_REVISION", "Get Gist Revision"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"gist_id": {
+						Type:        "string",
+						Description: "The ID of the gist",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "The version SHA of the revision, as returned by list_gist_commits",
+					},
+				},
+				Required: []string{"gist_id", "sha"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			gistID, err := RequiredParam[string](args, "gist_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			gist, resp, err := client.Gists.GetRevision(ctx, gistID, sha)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get gist revision", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get gist revision", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(gist)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelGist())
+			return result, nil, nil
+		},
+	)
+}
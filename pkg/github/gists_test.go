@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
@@ -644,3 +645,395 @@ func Test_UpdateGist_DescriptionOnlySentWhenProvided(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListGistComments(t *testing.T) {
+	serverTool := ListGistComments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_gist_comments", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "list_gist_comments tool should be read-only")
+
+	comments := []*github.GistComment{
+		{
+			ID:        github.Ptr(int64(1)),
+			Body:      github.Ptr("Nice gist"),
+			User:      &github.User{Login: github.Ptr("octocat")},
+			CreatedAt: &github.Timestamp{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+	}
+
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetGistsCommentsByGistID: mockResponse(t, http.StatusOK, comments),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"gist_id": "gist1"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got []MinimalGistComment
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, int64(1), got[0].ID)
+	assert.Equal(t, "Nice gist", got[0].Body)
+	assert.Equal(t, "octocat", got[0].User.Login)
+	assert.Equal(t, "2024-01-02T03:04:05Z", got[0].CreatedAt)
+}
+
+func Test_CreateGistComment(t *testing.T) {
+	serverTool := CreateGistComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_gist_comment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint, "create_gist_comment tool should not be read-only")
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, []string{"gist_id", "body"}, schema.Required)
+
+	createdComment := &github.GistComment{
+		ID:   github.Ptr(int64(42)),
+		Body: github.Ptr("Thanks for sharing"),
+		User: &github.User{Login: github.Ptr("octocat")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create comment successfully",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostGistsCommentsByGistID: expect(t, expectations{
+					path:        "/gists/gist1/comments",
+					requestBody: map[string]any{"body": "Thanks for sharing"},
+				}).andThen(mockResponse(t, http.StatusCreated, createdComment)),
+			}),
+			requestArgs: map[string]any{
+				"gist_id": "gist1",
+				"body":    "Thanks for sharing",
+			},
+		},
+		{
+			name:         "missing required body",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"gist_id": "gist1",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: body",
+		},
+		{
+			name: "gist not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostGistsCommentsByGistID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			}),
+			requestArgs: map[string]any{
+				"gist_id": "missing",
+				"body":    "Hello",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create gist comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got MinimalGistComment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, int64(42), got.ID)
+			assert.Equal(t, "Thanks for sharing", got.Body)
+		})
+	}
+}
+
+func Test_GistStarTools(t *testing.T) {
+	for _, serverTool := range []inventory.ServerTool{
+		IsGistStarred(translations.NullTranslationHelper),
+		StarGist(translations.NullTranslationHelper),
+		UnstarGist(translations.NullTranslationHelper),
+	} {
+		require.NoError(t, toolsnaps.Test(serverTool.Tool.Name, serverTool.Tool))
+	}
+
+	noContent := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	notFound := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	tests := []struct {
+		name            string
+		tool            inventory.ServerTool
+		handlers        map[string]http.HandlerFunc
+		expectError     bool
+		expectedErrMsg  string
+		expectedStarred bool
+	}{
+		{
+			name:            "is_gist_starred returns true",
+			tool:            IsGistStarred(translations.NullTranslationHelper),
+			handlers:        map[string]http.HandlerFunc{GetGistsStarByGistID: noContent},
+			expectedStarred: true,
+		},
+		{
+			name:            "is_gist_starred returns false when not starred",
+			tool:            IsGistStarred(translations.NullTranslationHelper),
+			handlers:        map[string]http.HandlerFunc{GetGistsStarByGistID: notFound},
+			expectedStarred: false,
+		},
+		{
+			name:            "star_gist stars the gist",
+			tool:            StarGist(translations.NullTranslationHelper),
+			handlers:        map[string]http.HandlerFunc{PutGistsStarByGistID: noContent},
+			expectedStarred: true,
+		},
+		{
+			name:           "star_gist reports API errors",
+			tool:           StarGist(translations.NullTranslationHelper),
+			handlers:       map[string]http.HandlerFunc{PutGistsStarByGistID: notFound},
+			expectError:    true,
+			expectedErrMsg: "failed to star gist",
+		},
+		{
+			name:            "unstar_gist unstars the gist",
+			tool:            UnstarGist(translations.NullTranslationHelper),
+			handlers:        map[string]http.HandlerFunc{DeleteGistsStarByGistID: noContent},
+			expectedStarred: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := tc.tool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"gist_id": "gist1"})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got struct {
+				GistID  string `json:"gist_id"`
+				Starred bool   `json:"starred"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, "gist1", got.GistID)
+			assert.Equal(t, tc.expectedStarred, got.Starred)
+		})
+	}
+}
+
+func Test_ForkGist(t *testing.T) {
+	serverTool := ForkGist(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "fork_gist", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint, "fork_gist tool should not be read-only")
+
+	forkedGist := &github.Gist{
+		ID:      github.Ptr("forked-gist-id"),
+		HTMLURL: github.Ptr("https://gist.github.com/user/forked-gist-id"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "fork gist successfully",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostGistsForksByGistID: expectPath(t, "/gists/gist1/forks").andThen(
+					mockResponse(t, http.StatusCreated, forkedGist),
+				),
+			}),
+		},
+		{
+			name: "gist not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostGistsForksByGistID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			}),
+			expectError:    true,
+			expectedErrMsg: "failed to fork gist gist1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"gist_id": "gist1"})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, "forked-gist-id", got.ID)
+			assert.Equal(t, "https://gist.github.com/user/forked-gist-id", got.URL)
+		})
+	}
+}
+
+func Test_ListGistCommits(t *testing.T) {
+	serverTool := ListGistCommits(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_gist_commits", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "list_gist_commits tool should be read-only")
+
+	commits := []*github.GistCommit{
+		{
+			Version:     github.Ptr("abc123"),
+			User:        &github.User{Login: github.Ptr("octocat")},
+			CommittedAt: &github.Timestamp{Time: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)},
+			ChangeStatus: &github.CommitStats{
+				Additions: github.Ptr(3),
+				Deletions: github.Ptr(1),
+				Total:     github.Ptr(4),
+			},
+		},
+	}
+
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetGistsCommitsByGistID: expectQueryParams(t, map[string]string{
+			"page":     "2",
+			"per_page": "10",
+		}).andThen(mockResponse(t, http.StatusOK, commits)),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"gist_id": "gist1", "page": float64(2), "perPage": float64(10)})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got []MinimalGistCommit
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "abc123", got[0].Version)
+	assert.Equal(t, "2024-05-06T07:08:09Z", got[0].CommittedAt)
+	require.NotNil(t, got[0].ChangeStatus)
+	assert.Equal(t, 3, got[0].ChangeStatus.Additions)
+	assert.Equal(t, 1, got[0].ChangeStatus.Deletions)
+	assert.Equal(t, 4, got[0].ChangeStatus.Total)
+}
+
+func Test_GetGistRevision(t *testing.T) {
+	serverTool := GetGistRevision(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_gist_revision", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_gist_revision tool should be read-only")
+
+	revision := &github.Gist{
+		ID: github.Ptr("gist1"),
+		Files: map[github.GistFilename]github.GistFile{
+			"notes.md": {
+				Filename: github.Ptr("notes.md"),
+				Content:  github.Ptr("first draft"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "get revision successfully",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetGistsByGistIDBySHA: expectPath(t, "/gists/gist1/abc123").andThen(
+					mockResponse(t, http.StatusOK, revision),
+				),
+			}),
+			requestArgs: map[string]any{"gist_id": "gist1", "sha": "abc123"},
+		},
+		{
+			name:           "missing required sha",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"gist_id": "gist1"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: sha",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got github.Gist
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			file := got.Files["notes.md"]
+			assert.Equal(t, "first draft", file.GetContent())
+		})
+	}
+}
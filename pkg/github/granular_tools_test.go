@@ -51,6 +51,7 @@ func TestGranularToolSnaps(t *testing.T) {
 		GranularUpdatePullRequestState,
 		GranularUpdatePullRequestDraftState,
 		GranularRequestPullRequestReviewers,
+		GranularRemovePullRequestReviewers,
 		GranularCreatePullRequestReview,
 		GranularSubmitPendingPullRequestReview,
 		GranularDeletePendingPullRequestReview,
@@ -121,6 +122,7 @@ func TestPullRequestsGranularToolset(t *testing.T) {
 			"update_pull_request_state",
 			"update_pull_request_draft_state",
 			"request_pull_request_reviewers",
+			"remove_pull_request_reviewers",
 			"create_pull_request_review",
 			"submit_pending_pull_request_review",
 			"delete_pending_pull_request_review",
@@ -1440,6 +1442,58 @@ func TestGranularRequestPullRequestReviewers(t *testing.T) {
 	assert.False(t, result.IsError)
 }
 
+func TestGranularRequestPullRequestReviewers_AuthorRequested(t *testing.T) {
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber: mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+			"message": "Review cannot be requested from pull request author.",
+		}),
+	}))
+	deps := BaseDeps{Client: client}
+	serverTool := GranularRequestPullRequestReviewers(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(1),
+		"reviewers":  []string{"author"},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getErrorResult(t, result).Text, "the pull request's author cannot review their own pull request")
+}
+
+func TestGranularRemovePullRequestReviewers(t *testing.T) {
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+			"reviewers":      []any{"user1"},
+			"team_reviewers": []any{"team1"},
+		}).andThen(mockResponse(t, http.StatusOK, &gogithub.PullRequest{Number: gogithub.Ptr(1)})),
+	}))
+	deps := BaseDeps{Client: client}
+	serverTool := GranularRemovePullRequestReviewers(translations.NullTranslationHelper)
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(1),
+		"reviewers":  []string{"user1", "owner/team1"},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		RemovedReviewers []string `json:"removed_reviewers"`
+		RemovedTeams     []string `json:"removed_teams"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []string{"user1"}, response.RemovedReviewers)
+	assert.Equal(t, []string{"team1"}, response.RemovedTeams)
+}
+
 func TestGranularCreatePullRequestReview(t *testing.T) {
 	mockedClient := githubv4mock.NewMockedHTTPClient(
 		githubv4mock.NewQueryMatcher(
@@ -1499,11 +1553,15 @@ func TestGranularCreatePullRequestReview(t *testing.T) {
 
 func TestGranularUpdatePullRequestDraftState(t *testing.T) {
 	tests := []struct {
-		name  string
-		draft bool
+		name           string
+		currentlyDraft bool
+		draft          bool
+		expectedErrMsg string
 	}{
-		{name: "convert to draft", draft: true},
-		{name: "mark ready for review", draft: false},
+		{name: "convert to draft", currentlyDraft: false, draft: true},
+		{name: "mark ready for review", currentlyDraft: true, draft: false},
+		{name: "already a draft", currentlyDraft: true, draft: true, expectedErrMsg: "pull request #1 is already a draft"},
+		{name: "already ready for review", currentlyDraft: false, draft: false, expectedErrMsg: "pull request #1 is already ready for review"},
 	}
 
 	for _, tc := range tests {
@@ -1514,7 +1572,8 @@ func TestGranularUpdatePullRequestDraftState(t *testing.T) {
 				struct {
 					Repository struct {
 						PullRequest struct {
-							ID githubv4.ID
+							ID      githubv4.ID
+							IsDraft githubv4.Boolean
 						} `graphql:"pullRequest(number: $number)"`
 					} `graphql:"repository(owner: $owner, name: $name)"`
 				}{},
@@ -1525,12 +1584,15 @@ func TestGranularUpdatePullRequestDraftState(t *testing.T) {
 				},
 				githubv4mock.DataResponse(map[string]any{
 					"repository": map[string]any{
-						"pullRequest": map[string]any{"id": "PR_123"},
+						"pullRequest": map[string]any{"id": "PR_123", "isDraft": tc.currentlyDraft},
 					},
 				}),
 			))
 
-			if tc.draft {
+			switch {
+			case tc.expectedErrMsg != "":
+				// No mutation is sent when the state would not change.
+			case tc.draft:
 				matchers = append(matchers, githubv4mock.NewMutationMatcher(
 					struct {
 						ConvertPullRequestToDraft struct {
@@ -1548,7 +1610,7 @@ func TestGranularUpdatePullRequestDraftState(t *testing.T) {
 						},
 					}),
 				))
-			} else {
+			default:
 				matchers = append(matchers, githubv4mock.NewMutationMatcher(
 					struct {
 						MarkPullRequestReadyForReview struct {
@@ -1581,7 +1643,18 @@ func TestGranularUpdatePullRequestDraftState(t *testing.T) {
 			})
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 			require.NoError(t, err)
-			assert.False(t, result.IsError)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Equal(t, tc.expectedErrMsg, errorContent.Text)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, float64(1), response["pullNumber"])
+			assert.Equal(t, tc.draft, response["draft"])
 		})
 	}
 }
@@ -31,6 +31,7 @@ const (
 
 	// Repository endpoints
 	GetReposByOwnerByRepo                = "GET /repos/{owner}/{repo}"
+	PatchReposByOwnerByRepo              = "PATCH /repos/{owner}/{repo}"
 	GetReposBranchesByOwnerByRepo        = "GET /repos/{owner}/{repo}/branches"
 	GetReposTagsByOwnerByRepo            = "GET /repos/{owner}/{repo}/tags"
 	GetReposCommitsByOwnerByRepo         = "GET /repos/{owner}/{repo}/commits"
@@ -38,10 +39,24 @@ const (
 	GetReposContentsByOwnerByRepoByPath  = "GET /repos/{owner}/{repo}/contents/{path}"
 	PutReposContentsByOwnerByRepoByPath  = "PUT /repos/{owner}/{repo}/contents/{path}"
 	PostReposForksByOwnerByRepo          = "POST /repos/{owner}/{repo}/forks"
+	GetReposForksByOwnerByRepo           = "GET /repos/{owner}/{repo}/forks"
+	GetReposReadmeByOwnerByRepo          = "GET /repos/{owner}/{repo}/readme"
+	GetReposReadmeByOwnerByRepoByDir     = "GET /repos/{owner}/{repo}/readme/{dir:.*}"
+	GetReposTarballByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/tarball/{ref:.*}"
+	GetReposAutolinksByOwnerByRepo       = "GET /repos/{owner}/{repo}/autolinks"
+	PostReposAutolinksByOwnerByRepo      = "POST /repos/{owner}/{repo}/autolinks"
+	GetReposKeysByOwnerByRepo            = "GET /repos/{owner}/{repo}/keys"
+	GetReposPagesByOwnerByRepo           = "GET /repos/{owner}/{repo}/pages"
+	GetReposPagesBuildsByOwnerByRepo     = "GET /repos/{owner}/{repo}/pages/builds"
+	PostReposKeysByOwnerByRepo           = "POST /repos/{owner}/{repo}/keys"
+	DeleteReposKeysByOwnerByRepoByKeyID  = "DELETE /repos/{owner}/{repo}/keys/{key_id}"
+	GetReposZipballByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/zipball/{ref:.*}"
 	GetReposSubscriptionByOwnerByRepo    = "GET /repos/{owner}/{repo}/subscription"
 	PutReposSubscriptionByOwnerByRepo    = "PUT /repos/{owner}/{repo}/subscription"
 	DeleteReposSubscriptionByOwnerByRepo = "DELETE /repos/{owner}/{repo}/subscription"
 	ListCollaborators                    = "GET /repos/{owner}/{repo}/collaborators"
+	GetOrgsReposByOrg                    = "GET /orgs/{org}/repos"
+	GetUserRepos                         = "GET /user/repos"
 
 	// Git endpoints
 	GetReposGitTreesByOwnerByRepoByTree        = "GET /repos/{owner}/{repo}/git/trees/{tree}"
@@ -64,6 +79,8 @@ const (
 	PostReposIssuesCommentsByOwnerByRepoByIssueNumber           = "POST /repos/{owner}/{repo}/issues/{issue_number}/comments"
 	PostReposIssuesReactionsByOwnerByRepoByIssueNumber          = "POST /repos/{owner}/{repo}/issues/{issue_number}/reactions"
 	PatchReposIssuesByOwnerByRepoByIssueNumber                  = "PATCH /repos/{owner}/{repo}/issues/{issue_number}"
+	PutReposIssuesLockByOwnerByRepoByIssueNumber                = "PUT /repos/{owner}/{repo}/issues/{issue_number}/lock"
+	DeleteReposIssuesLockByOwnerByRepoByIssueNumber             = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/lock"
 	GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber           = "GET /repos/{owner}/{repo}/issues/{issue_number}/sub_issues"
 	PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber          = "POST /repos/{owner}/{repo}/issues/{issue_number}/sub_issues"
 	DeleteReposIssuesSubIssueByOwnerByRepoByIssueNumber         = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/sub_issue"
@@ -72,18 +89,19 @@ const (
 	DeleteReposIssuesIssueFieldValueByOwnerByRepoByIssueNumber  = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/issue-field-values/{issue_field_id}"
 
 	// Pull request endpoints
-	GetReposPullsByOwnerByRepo                                = "GET /repos/{owner}/{repo}/pulls"
-	GetReposPullsByOwnerByRepoByPullNumber                    = "GET /repos/{owner}/{repo}/pulls/{pull_number}"
-	GetReposPullsCommitsByOwnerByRepoByPullNumber             = "GET /repos/{owner}/{repo}/pulls/{pull_number}/commits"
-	GetReposPullsFilesByOwnerByRepoByPullNumber               = "GET /repos/{owner}/{repo}/pulls/{pull_number}/files"
-	GetReposPullsReviewsByOwnerByRepoByPullNumber             = "GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews"
-	PostReposPullsByOwnerByRepo                               = "POST /repos/{owner}/{repo}/pulls"
-	PatchReposPullsByOwnerByRepoByPullNumber                  = "PATCH /repos/{owner}/{repo}/pulls/{pull_number}"
-	PutReposPullsMergeByOwnerByRepoByPullNumber               = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge"
-	PutReposPullsUpdateBranchByOwnerByRepoByPullNumber        = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/update-branch"
-	PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber = "POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
-	PostReposPullsCommentsByOwnerByRepoByPullNumber           = "POST /repos/{owner}/{repo}/pulls/{pull_number}/comments"
-	PostReposPullsCommentsReactionsByOwnerByRepoByCommentID   = "POST /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions"
+	GetReposPullsByOwnerByRepo                                  = "GET /repos/{owner}/{repo}/pulls"
+	GetReposPullsByOwnerByRepoByPullNumber                      = "GET /repos/{owner}/{repo}/pulls/{pull_number}"
+	GetReposPullsCommitsByOwnerByRepoByPullNumber               = "GET /repos/{owner}/{repo}/pulls/{pull_number}/commits"
+	GetReposPullsFilesByOwnerByRepoByPullNumber                 = "GET /repos/{owner}/{repo}/pulls/{pull_number}/files"
+	GetReposPullsReviewsByOwnerByRepoByPullNumber               = "GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews"
+	PostReposPullsByOwnerByRepo                                 = "POST /repos/{owner}/{repo}/pulls"
+	PatchReposPullsByOwnerByRepoByPullNumber                    = "PATCH /repos/{owner}/{repo}/pulls/{pull_number}"
+	PutReposPullsMergeByOwnerByRepoByPullNumber                 = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge"
+	PutReposPullsUpdateBranchByOwnerByRepoByPullNumber          = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/update-branch"
+	PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber   = "POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
+	DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber = "DELETE /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
+	PostReposPullsCommentsByOwnerByRepoByPullNumber             = "POST /repos/{owner}/{repo}/pulls/{pull_number}/comments"
+	PostReposPullsCommentsReactionsByOwnerByRepoByCommentID     = "POST /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions"
 
 	// Notifications endpoints
 	GetNotifications                                 = "GET /notifications"
@@ -97,10 +115,18 @@ const (
 	DeleteNotificationsThreadsSubscriptionByThreadID = "DELETE /notifications/threads/{thread_id}/subscription"
 
 	// Gists endpoints
-	GetGists           = "GET /gists"
-	GetGistsByGistID   = "GET /gists/{gist_id}"
-	PostGists          = "POST /gists"
-	PatchGistsByGistID = "PATCH /gists/{gist_id}"
+	GetGists                  = "GET /gists"
+	GetGistsByGistID          = "GET /gists/{gist_id}"
+	PostGists                 = "POST /gists"
+	PatchGistsByGistID        = "PATCH /gists/{gist_id}"
+	GetGistsCommentsByGistID  = "GET /gists/{gist_id}/comments"
+	PostGistsCommentsByGistID = "POST /gists/{gist_id}/comments"
+	GetGistsStarByGistID      = "GET /gists/{gist_id}/star"
+	PutGistsStarByGistID      = "PUT /gists/{gist_id}/star"
+	DeleteGistsStarByGistID   = "DELETE /gists/{gist_id}/star"
+	PostGistsForksByGistID    = "POST /gists/{gist_id}/forks"
+	GetGistsCommitsByGistID   = "GET /gists/{gist_id}/commits"
+	GetGistsByGistIDBySHA     = "GET /gists/{gist_id}/{sha}"
 
 	// Releases endpoints
 	GetReposReleasesByOwnerByRepo          = "GET /repos/{owner}/{repo}/releases"
@@ -111,12 +137,14 @@ const (
 	GetReposCodeQualityFindingsByOwnerByRepoByFindingNumber = "GET /repos/{owner}/{repo}/code-quality/findings/{finding_number}"
 
 	// Code scanning endpoints
-	GetReposCodeScanningAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/code-scanning/alerts"
-	GetReposCodeScanningAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"
+	GetReposCodeScanningAlertsByOwnerByRepo                = "GET /repos/{owner}/{repo}/code-scanning/alerts"
+	GetReposCodeScanningAlertsByOwnerByRepoByAlertNumber   = "GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"
+	PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber = "PATCH /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"
 
 	// Secret scanning endpoints
-	GetReposSecretScanningAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/secret-scanning/alerts"                //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
-	GetReposSecretScanningAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}" //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	GetReposSecretScanningAlertsByOwnerByRepo                = "GET /repos/{owner}/{repo}/secret-scanning/alerts"                  //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	GetReposSecretScanningAlertsByOwnerByRepoByAlertNumber   = "GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}"   //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
+	PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber = "PATCH /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}" //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
 
 	// Dependabot endpoints
 	GetReposDependabotAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/dependabot/alerts"
@@ -129,21 +157,31 @@ const (
 	GetOrgsSecurityAdvisoriesByOrg          = "GET /orgs/{org}/security-advisories"
 
 	// Actions endpoints
-	GetReposActionsWorkflowsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/actions/workflows"
-	GetReposActionsWorkflowsByOwnerByRepoByWorkflowID            = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}"
-	PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID = "POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches"
-	GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowID        = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs"
-	GetReposActionsRunsByOwnerByRepo                             = "GET /repos/{owner}/{repo}/actions/runs"
-	GetReposActionsRunsByOwnerByRepoByRunID                      = "GET /repos/{owner}/{repo}/actions/runs/{run_id}"
-	GetReposActionsRunsLogsByOwnerByRepoByRunID                  = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
-	GetReposActionsRunsJobsByOwnerByRepoByRunID                  = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs"
-	GetReposActionsRunsArtifactsByOwnerByRepoByRunID             = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts"
-	GetReposActionsRunsTimingByOwnerByRepoByRunID                = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/timing"
-	PostReposActionsRunsRerunByOwnerByRepoByRunID                = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun"
-	PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunID      = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs"
-	PostReposActionsRunsCancelByOwnerByRepoByRunID               = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/cancel"
-	GetReposActionsJobsLogsByOwnerByRepoByJobID                  = "GET /repos/{owner}/{repo}/actions/jobs/{job_id}/logs"
-	DeleteReposActionsRunsLogsByOwnerByRepoByRunID               = "DELETE /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
+	GetReposActionsWorkflowsByOwnerByRepo                            = "GET /repos/{owner}/{repo}/actions/workflows"
+	GetReposActionsWorkflowsByOwnerByRepoByWorkflowID                = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}"
+	PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID     = "POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches"
+	GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowID            = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs"
+	GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowID          = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing"
+	GetReposActionsRunsByOwnerByRepo                                 = "GET /repos/{owner}/{repo}/actions/runs"
+	GetReposActionsRunsByOwnerByRepoByRunID                          = "GET /repos/{owner}/{repo}/actions/runs/{run_id}"
+	GetReposActionsRunsLogsByOwnerByRepoByRunID                      = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
+	GetReposActionsRunsJobsByOwnerByRepoByRunID                      = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs"
+	GetReposActionsRunsArtifactsByOwnerByRepoByRunID                 = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts"
+	GetReposActionsArtifactsByOwnerByRepo                            = "GET /repos/{owner}/{repo}/actions/artifacts"
+	GetReposActionsArtifactsByOwnerByRepoByArtifactID                = "GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}"
+	GetReposActionsArtifactsByOwnerByRepoByArtifactIDByArchiveFormat = "GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}"
+	GetReposActionsRunsTimingByOwnerByRepoByRunID                    = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/timing"
+	GetReposActionsRunsAttemptsByOwnerByRepoByRunIDByAttempt         = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}"
+	GetReposActionsRunsAttemptsJobsByOwnerByRepoByRunIDByAttempt     = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs"
+	PostReposActionsRunsRerunByOwnerByRepoByRunID                    = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun"
+	PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunID          = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs"
+	PostReposActionsRunsCancelByOwnerByRepoByRunID                   = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/cancel"
+	GetReposActionsJobsLogsByOwnerByRepoByJobID                      = "GET /repos/{owner}/{repo}/actions/jobs/{job_id}/logs"
+	DeleteReposActionsRunsLogsByOwnerByRepoByRunID                   = "DELETE /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
+	DeleteReposActionsRunsByOwnerByRepoByRunID                       = "DELETE /repos/{owner}/{repo}/actions/runs/{run_id}"
+	GetReposActionsCachesByOwnerByRepo                               = "GET /repos/{owner}/{repo}/actions/caches"
+	DeleteReposActionsCachesByOwnerByRepo                            = "DELETE /repos/{owner}/{repo}/actions/caches"
+	DeleteReposActionsCachesByOwnerByRepoByCacheID                   = "DELETE /repos/{owner}/{repo}/actions/caches/{cache_id}"
 
 	// Search endpoints
 	GetSearchCode         = "GET /search/code"
@@ -153,13 +153,13 @@ func dependencyReadResult(issues []*github.Issue, resp *github.Response) *mcp.Ca
 		}
 		refs = append(refs, issueToDependencyRef(issue))
 	}
-	return MarshalledTextResult(map[string]any{
+	return MarshalledTextResult(withEmptyListMessage(map[string]any{
 		"issues": refs,
 		"pageInfo": map[string]any{
 			"hasNextPage": resp.NextPage != 0,
 			"nextPage":    resp.NextPage,
 		},
-	})
+	}, "issues", refs))
 }
 
 // issueToDependencyRef converts a REST issue into the compact reference used by
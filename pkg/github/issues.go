@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -1054,10 +1056,10 @@ func GetIssueLabels(ctx context.Context, client *githubv4.Client, owner string,
 		}
 	}
 
-	response := map[string]any{
+	response := withEmptyListMessage(map[string]any{
 		"labels":     issueLabels,
 		"totalCount": int(query.Repository.Issue.Labels.TotalCount),
-	}
+	}, "labels", issueLabels)
 
 	out, err := json.Marshal(response)
 	if err != nil {
@@ -1067,6 +1069,49 @@ func GetIssueLabels(ctx context.Context, client *githubv4.Client, owner string,
 	return utils.NewToolResultText(string(out)), nil
 }
 
+// listRepositoryIssueTypes lists the issue types available to a repository,
+// which are those of its owner organization.
+func listRepositoryIssueTypes(ctx context.Context, client *github.Client, owner, repo string) ([]*github.IssueType, *github.Response, error) {
+	req, err := client.NewRequest(ctx, "GET", fmt.Sprintf("repos/%s/%s/issue-types", owner, repo), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	var issueTypes []*github.IssueType
+	resp, err := client.Do(req, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issueTypes, resp, nil
+}
+
+// issueWriteErrorResponse builds the error result for a failed issue create or
+// update. GitHub answers 422 without naming the offending field when the
+// requested issue type does not exist, so in that case the repository's issue
+// types are looked up and, if the type is unknown, the valid names are listed.
+func issueWriteErrorResponse(ctx context.Context, client *github.Client, owner, repo, issueType, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	if issueType == "" || resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+	}
+
+	issueTypes, typesResp, typesErr := listRepositoryIssueTypes(ctx, client, owner, repo)
+	if typesErr != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+	}
+	_ = typesResp.Body.Close()
+
+	names := make([]string, 0, len(issueTypes))
+	for _, it := range issueTypes {
+		if strings.EqualFold(it.GetName(), issueType) {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+		}
+		names = append(names, it.GetName())
+	}
+	if len(names) == 0 {
+		return utils.NewToolResultError(fmt.Sprintf("%s: issue type %q cannot be set because %s/%s has no issue types configured", message, issueType, owner, repo))
+	}
+	return utils.NewToolResultError(fmt.Sprintf("%s: issue type %q does not exist for %s/%s; valid types are: %s", message, issueType, owner, repo, strings.Join(names, ", ")))
+}
+
 // ListIssueTypes creates a tool to list defined issue types for an organization or repository.
 // This can be used to understand supported issue type values for creating or updating issues.
 func ListIssueTypes(t translations.TranslationHelperFunc) inventory.ServerTool {
@@ -1111,13 +1156,7 @@ func ListIssueTypes(t translations.TranslationHelperFunc) inventory.ServerTool {
 			}
 
 			if repo != "" {
-				apiURL := fmt.Sprintf("repos/%s/%s/issue-types", owner, repo)
-				req, err := client.NewRequest(ctx, "GET", apiURL, nil)
-				if err != nil {
-					return utils.NewToolResultErrorFromErr("failed to create request", err), nil, nil
-				}
-				var issueTypes []*github.IssueType
-				resp, err := client.Do(req, &issueTypes)
+				issueTypes, resp, err := listRepositoryIssueTypes(ctx, client, owner, repo)
 				if err != nil {
 					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue types", resp, err), nil, nil
 				}
@@ -1366,13 +1405,147 @@ func issueNumberFromIssueURL(issueURL string) (int, error) {
 	return issueNumber, nil
 }
 
+// issueLockReasons are the reasons GitHub accepts for locking an issue's
+// conversation.
+var issueLockReasons = []any{"off-topic", "too heated", "resolved", "spam"}
+
+func issueLockSchema(withReason bool) *jsonschema.Schema {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"issue_number": {
+				Type:        "number",
+				Description: "Issue or pull request number",
+				Minimum:     jsonschema.Ptr(1.0),
+			},
+		},
+		Required: []string{"owner", "repo", "issue_number"},
+	}
+	if withReason {
+		schema.Properties["lock_reason"] = &jsonschema.Schema{
+			Type:        "string",
+			Description: "Why the conversation is being locked. Shown to contributors on the issue.",
+			Enum:        issueLockReasons,
+		}
+	}
+	return schema
+}
+
+// LockIssue creates a tool to lock the conversation on an issue or pull request.
+func LockIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "lock_issue",
+			Description: t("TOOL_LOCK_ISSUE_DESCRIPTION", "Lock the conversation on an issue or pull request so that only collaborators can comment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_LOCK_ISSUE_USER_TITLE", "Lock issue conversation"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				IdempotentHint:  true,
+			},
+			InputSchema: issueLockSchema(true),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return setIssueLock(ctx, deps, args, true)
+		},
+	)
+}
+
+// UnlockIssue creates a tool to unlock the conversation on an issue or pull request.
+func UnlockIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "unlock_issue",
+			Description: t("TOOL_UNLOCK_ISSUE_DESCRIPTION", "Unlock the conversation on an issue or pull request so that anyone can comment again."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_UNLOCK_ISSUE_USER_TITLE", "Unlock issue conversation"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				IdempotentHint:  true,
+			},
+			InputSchema: issueLockSchema(false),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return setIssueLock(ctx, deps, args, false)
+		},
+	)
+}
+
+// setIssueLock locks or unlocks an issue's conversation and reports the
+// resulting lock state.
+func setIssueLock(ctx context.Context, deps ToolDependencies, args map[string]any, lock bool) (*mcp.CallToolResult, any, error) {
+	owner, err := RequiredParam[string](args, "owner")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	repo, err := RequiredParam[string](args, "repo")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	issueNumber, err := RequiredInt(args, "issue_number")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	lockReason, err := OptionalParam[string](args, "lock_reason")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if lockReason != "" && !slices.Contains(issueLockReasons, any(lockReason)) {
+		return utils.NewToolResultError(fmt.Sprintf("invalid lock_reason %q: must be one of %v", lockReason, issueLockReasons)), nil, nil
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+	}
+
+	var resp *github.Response
+	action := "lock"
+	if lock {
+		var opts *github.LockIssueOptions
+		if lockReason != "" {
+			opts = &github.LockIssueOptions{LockReason: lockReason}
+		}
+		resp, err = client.Issues.Lock(ctx, owner, repo, issueNumber, opts)
+	} else {
+		action = "unlock"
+		resp, err = client.Issues.Unlock(ctx, owner, repo, issueNumber)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to %s issue", action), resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result := map[string]any{
+		"message":      fmt.Sprintf("Conversation on %s/%s#%d has been %sed", owner, repo, issueNumber, action),
+		"issue_number": issueNumber,
+		"locked":       lock,
+	}
+	if lockReason != "" {
+		result["lock_reason"] = lockReason
+	}
+	return MarshalledTextResult(result), nil, nil
+}
+
 // SubIssueWrite creates a tool to add a sub-issue to a parent issue.
 func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 	st := NewTool(
 		ToolsetMetadataIssues,
 		mcp.Tool{
 			Name:        "sub_issue_write",
-			Description: t("TOOL_SUB_ISSUE_WRITE_DESCRIPTION", "Add a sub-issue to a parent issue in a GitHub repository."),
+			Description: t("TOOL_SUB_ISSUE_WRITE_DESCRIPTION", "Add, remove, or reprioritize a sub-issue of a parent issue in a GitHub repository. Returns the updated parent issue, whose sub_issues_summary reports the total and completed sub-issues. Use issue_read with method get_sub_issues to list them."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_SUB_ISSUE_WRITE_USER_TITLE", "Change sub-issue"),
 				ReadOnlyHint: false,
@@ -1628,7 +1801,27 @@ func searchIssuesTool(t translations.TranslationHelperFunc, includeFields bool)
 		Properties: map[string]*jsonschema.Schema{
 			"query": {
 				Type:        "string",
-				Description: "Search query using GitHub issues search syntax",
+				Description: "Search query using GitHub issues search syntax. Optional when state, labels, author or assignee is provided.",
+			},
+			"state": {
+				Type:        "string",
+				Description: "Only return issues in this state. Adds a state: qualifier unless the query already has one.",
+				Enum:        []any{"open", "closed"},
+			},
+			"labels": {
+				Type:        "array",
+				Description: "Only return issues that have all of these labels. Adds a label: qualifier per label.",
+				Items: &jsonschema.Schema{
+					Type: "string",
+				},
+			},
+			"author": {
+				Type:        "string",
+				Description: "Only return issues opened by this user. Adds an author: qualifier unless the query already has one.",
+			},
+			"assignee": {
+				Type:        "string",
+				Description: "Only return issues assigned to this user. Adds an assignee: qualifier unless the query already has one.",
 			},
 			"owner": {
 				Type:        "string",
@@ -1661,7 +1854,6 @@ func searchIssuesTool(t translations.TranslationHelperFunc, includeFields bool)
 				Enum:        []any{"asc", "desc"},
 			},
 		},
-		Required: []string{"query"},
 	}
 	if includeFields {
 		schema.Properties["fields"] = fieldsSchemaProperty(
@@ -1957,12 +2149,73 @@ func fetchIssueReadEnrichment(ctx context.Context, gqlClient *githubv4.Client, n
 	return enrichment, nil
 }
 
+// withIssueSearchQualifiers returns a copy of args whose query has the
+// state, labels, author and assignee parameters appended as qualifiers. A
+// single-valued qualifier already present in the query is left alone. At
+// least one of the query or those parameters must be provided.
+func withIssueSearchQualifiers(args map[string]any) (map[string]any, error) {
+	query, err := OptionalParam[string](args, "query")
+	if err != nil {
+		return nil, err
+	}
+	state, err := OptionalParam[string](args, "state")
+	if err != nil {
+		return nil, err
+	}
+	labels, err := OptionalStringArrayParam(args, "labels")
+	if err != nil {
+		return nil, err
+	}
+	author, err := OptionalParam[string](args, "author")
+	if err != nil {
+		return nil, err
+	}
+	assignee, err := OptionalParam[string](args, "assignee")
+	if err != nil {
+		return nil, err
+	}
+
+	if state != "" && state != "open" && state != "closed" {
+		return nil, fmt.Errorf("invalid state %q: must be \"open\" or \"closed\"", state)
+	}
+
+	qualifiers := []string{strings.TrimSpace(query)}
+	for _, q := range []struct{ name, value string }{
+		{"state", state},
+		{"author", author},
+		{"assignee", assignee},
+	} {
+		if q.value != "" && !hasFilter(query, q.name) {
+			qualifiers = append(qualifiers, q.name+":"+q.value)
+		}
+	}
+	for _, label := range labels {
+		if strings.ContainsAny(label, " \t") {
+			label = strconv.Quote(label)
+		}
+		qualifiers = append(qualifiers, "label:"+label)
+	}
+
+	composed := strings.TrimSpace(strings.Join(qualifiers, " "))
+	if composed == "" {
+		return nil, fmt.Errorf("provide a query or at least one of state, labels, author or assignee")
+	}
+
+	out := maps.Clone(args)
+	out["query"] = composed
+	return out, nil
+}
+
 // searchIssuesHandler runs the REST issues search, enriches each hit with custom field values
 // fetched via a single follow-up GraphQL nodes() query, and applies any post-process options
 // (e.g. IFC labelling).
 func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[string]any, options ...searchOption) (*mcp.CallToolResult, error) {
 	const errorPrefix = "failed to search issues"
 
+	args, err := withIssueSearchQualifiers(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
 	query, opts, err := prepareSearchArgs(args, "issue")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil
@@ -2400,11 +2653,7 @@ func CreateIssue(ctx context.Context, client *github.Client, owner string, repo
 
 	issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx,
-			"failed to create issue",
-			resp,
-			err,
-		), nil
+		return issueWriteErrorResponse(ctx, client, owner, repo, issueType, "failed to create issue", resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -2521,11 +2770,7 @@ func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4
 
 	updatedIssue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, issueRequest)
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx,
-			"failed to update issue",
-			resp,
-			err,
-		), nil
+		return issueWriteErrorResponse(ctx, client, owner, repo, issueType, "failed to update issue", resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -828,7 +828,11 @@ func Test_SearchIssues(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "page")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "fields")
-	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"query"})
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "state")
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "labels")
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "author")
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "assignee")
+	assert.Empty(t, tool.InputSchema.(*jsonschema.Schema).Required)
 
 	// Setup mock search results
 	mockSearchResult := &github.IssuesSearchResult{
@@ -1096,6 +1100,92 @@ func Test_SearchIssues(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockSearchResult,
 		},
+		{
+			name: "structured qualifiers are composed into the query",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: expectQueryParams(
+					t,
+					map[string]string{
+						"q":        `is:issue crash state:open author:octocat assignee:hubot label:bug label:"good first issue"`,
+						"page":     "1",
+						"per_page": "30",
+					},
+				).andThen(
+					mockResponse(t, http.StatusOK, mockSearchResult),
+				),
+			}),
+			requestArgs: map[string]any{
+				"query":    "crash",
+				"state":    "open",
+				"author":   "octocat",
+				"assignee": "hubot",
+				"labels":   []any{"bug", "good first issue"},
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name: "structured qualifiers without a query",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: expectQueryParams(
+					t,
+					map[string]string{
+						"q":        "repo:owner/repo is:issue state:closed",
+						"page":     "1",
+						"per_page": "30",
+					},
+				).andThen(
+					mockResponse(t, http.StatusOK, mockSearchResult),
+				),
+			}),
+			requestArgs: map[string]any{
+				"state": "closed",
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name: "qualifier already in the query is not duplicated",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: expectQueryParams(
+					t,
+					map[string]string{
+						"q":        "is:issue author:monalisa",
+						"page":     "1",
+						"per_page": "30",
+					},
+				).andThen(
+					mockResponse(t, http.StatusOK, mockSearchResult),
+				),
+			}),
+			requestArgs: map[string]any{
+				"query":  "author:monalisa",
+				"author": "octocat",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name:         "no query or qualifier",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "provide a query or at least one of state, labels, author or assignee",
+		},
+		{
+			name:         "invalid state",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"state": "merged",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid state "merged": must be "open" or "closed"`,
+		},
 		{
 			name: "search issues fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -3439,6 +3529,227 @@ func Test_UpdateIssueClearsLabelsAndAssignees(t *testing.T) {
 	assert.Equal(t, updatedIssue.GetHTMLURL(), updateResp.URL)
 }
 
+func Test_IssueWrite_UnknownIssueType(t *testing.T) {
+	serverTool := IssueWrite(translations.NullTranslationHelper)
+
+	issueTypes := []*github.IssueType{
+		{ID: github.Ptr(int64(1)), Name: github.Ptr("Bug")},
+		{ID: github.Ptr(int64(2)), Name: github.Ptr("Feature")},
+		{ID: github.Ptr(int64(3)), Name: github.Ptr("Task")},
+	}
+	unprocessable := mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		handlers       map[string]http.HandlerFunc
+		expectedErrMsg string
+		listsTypes     bool
+	}{
+		{
+			name: "create with unknown type lists valid types",
+			requestArgs: map[string]any{
+				"method": "create",
+				"owner":  "owner",
+				"repo":   "repo",
+				"title":  "Crash on start",
+				"type":   "Defect",
+			},
+			handlers: map[string]http.HandlerFunc{
+				PostReposIssuesByOwnerByRepo:        unprocessable,
+				"GET /repos/owner/repo/issue-types": mockResponse(t, http.StatusOK, issueTypes),
+			},
+			expectedErrMsg: `failed to create issue: issue type "Defect" does not exist for owner/repo; valid types are: Bug, Feature, Task`,
+			listsTypes:     true,
+		},
+		{
+			name: "update with unknown type lists valid types",
+			requestArgs: map[string]any{
+				"method":       "update",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(8),
+				"type":         "Epic",
+			},
+			handlers: map[string]http.HandlerFunc{
+				PatchReposIssuesByOwnerByRepoByIssueNumber: unprocessable,
+				"GET /repos/owner/repo/issue-types":        mockResponse(t, http.StatusOK, issueTypes),
+			},
+			expectedErrMsg: `failed to update issue: issue type "Epic" does not exist for owner/repo; valid types are: Bug, Feature, Task`,
+			listsTypes:     true,
+		},
+		{
+			name: "known type keeps the API error",
+			requestArgs: map[string]any{
+				"method": "create",
+				"owner":  "owner",
+				"repo":   "repo",
+				"title":  "Crash on start",
+				"type":   "bug",
+			},
+			handlers: map[string]http.HandlerFunc{
+				PostReposIssuesByOwnerByRepo:        unprocessable,
+				"GET /repos/owner/repo/issue-types": mockResponse(t, http.StatusOK, issueTypes),
+			},
+			expectedErrMsg: "failed to create issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			text := getErrorResult(t, result).Text
+			assert.Contains(t, text, tc.expectedErrMsg)
+			if !tc.listsTypes {
+				assert.NotContains(t, text, "valid types")
+			}
+		})
+	}
+}
+
+func Test_LockIssue(t *testing.T) {
+	serverTool := LockIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "lock_issue", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "lock_reason")
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number"})
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "lock with reason",
+			handlers: map[string]http.HandlerFunc{
+				PutReposIssuesLockByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"lock_reason": "too heated",
+				}).andThen(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				}),
+			},
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"lock_reason":  "too heated",
+			},
+		},
+		{
+			name: "lock without reason",
+			handlers: map[string]http.HandlerFunc{
+				PutReposIssuesLockByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			},
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+		},
+		{
+			name:     "invalid reason is rejected before the request",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"lock_reason":  "toxic",
+			},
+			expectedErrMsg: `invalid lock_reason "toxic"`,
+		},
+		{
+			name: "lock fails",
+			handlers: map[string]http.HandlerFunc{
+				PutReposIssuesLockByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+			},
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectedErrMsg: "failed to lock issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "Conversation on owner/repo#42 has been locked", response["message"])
+			assert.Equal(t, true, response["locked"])
+			if reason, ok := tc.requestArgs["lock_reason"]; ok {
+				assert.Equal(t, reason, response["lock_reason"])
+			} else {
+				assert.NotContains(t, response, "lock_reason")
+			}
+		})
+	}
+}
+
+func Test_UnlockIssue(t *testing.T) {
+	serverTool := UnlockIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unlock_issue", tool.Name)
+	assert.NotContains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "lock_reason")
+
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			DeleteReposIssuesLockByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(42),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, "Conversation on owner/repo#42 has been unlocked", response["message"])
+	assert.Equal(t, false, response["locked"])
+}
+
 func Test_ParseISOTimestamp(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -3968,6 +4279,11 @@ func Test_AddSubIssue(t *testing.T) {
 		User: &github.User{
 			Login: github.Ptr("testuser"),
 		},
+		SubIssuesSummary: &github.SubIssuesSummary{
+			Total:            github.Ptr(3),
+			Completed:        github.Ptr(1),
+			PercentCompleted: github.Ptr(33),
+		},
 		Labels: []*github.Label{
 			{
 				Name:        github.Ptr("enhancement"),
@@ -4162,6 +4478,7 @@ func Test_AddSubIssue(t *testing.T) {
 			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
 			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
 			assert.Equal(t, *tc.expectedIssue.User.Login, *returnedIssue.User.Login)
+			assert.Equal(t, tc.expectedIssue.SubIssuesSummary, returnedIssue.SubIssuesSummary)
 		})
 	}
 }
@@ -4682,6 +4999,11 @@ func Test_RemoveSubIssue(t *testing.T) {
 		User: &github.User{
 			Login: github.Ptr("testuser"),
 		},
+		SubIssuesSummary: &github.SubIssuesSummary{
+			Total:            github.Ptr(3),
+			Completed:        github.Ptr(1),
+			PercentCompleted: github.Ptr(33),
+		},
 		Labels: []*github.Label{
 			{
 				Name:        github.Ptr("enhancement"),
@@ -4859,6 +5181,7 @@ func Test_RemoveSubIssue(t *testing.T) {
 			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
 			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
 			assert.Equal(t, *tc.expectedIssue.User.Login, *returnedIssue.User.Login)
+			assert.Equal(t, tc.expectedIssue.SubIssuesSummary, returnedIssue.SubIssuesSummary)
 		})
 	}
 }
@@ -5119,6 +5442,7 @@ func Test_ReprioritizeSubIssue(t *testing.T) {
 			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
 			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
 			assert.Equal(t, *tc.expectedIssue.User.Login, *returnedIssue.User.Login)
+			assert.Equal(t, tc.expectedIssue.SubIssuesSummary, returnedIssue.SubIssuesSummary)
 		})
 	}
 }
@@ -199,10 +199,10 @@ func ListLabels(t translations.TranslationHelperFunc) inventory.ServerTool {
 				}
 			}
 
-			response := map[string]any{
+			response := withEmptyListMessage(map[string]any{
 				"labels":     labels,
 				"totalCount": int(query.Repository.Labels.TotalCount),
-			}
+			}, "labels", labels)
 
 			out, err := json.Marshal(response)
 			if err != nil {
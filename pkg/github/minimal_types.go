@@ -174,11 +174,24 @@ type MinimalRepository struct {
 	OpenIssues    int      `json:"open_issues_count"`
 	UpdatedAt     string   `json:"updated_at,omitempty"`
 	CreatedAt     string   `json:"created_at,omitempty"`
+	PushedAt      string   `json:"pushed_at,omitempty"`
 	Topics        []string `json:"topics,omitempty"`
 	Private       bool     `json:"private"`
 	Fork          bool     `json:"fork"`
 	Archived      bool     `json:"archived"`
 	DefaultBranch string   `json:"default_branch,omitempty"`
+	Visibility    string   `json:"visibility,omitempty"`
+
+	Permissions *MinimalRepositoryPermissions `json:"permissions,omitempty"`
+}
+
+// MinimalRepositoryPermissions is the authenticated user's access to a repository.
+type MinimalRepositoryPermissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
 }
 
 // MinimalSearchRepositoriesResult is the trimmed output type for repository search results.
@@ -318,6 +331,26 @@ type MinimalTag struct {
 	SHA  string `json:"sha"`
 }
 
+// MinimalNotification is the trimmed output type for notification threads.
+type MinimalNotification struct {
+	ID                 string                     `json:"id"`
+	Reason             string                     `json:"reason"`
+	Unread             bool                       `json:"unread"`
+	RepositoryFullName string                     `json:"repository_full_name,omitempty"`
+	Subject            MinimalNotificationSubject `json:"subject"`
+	UpdatedAt          string                     `json:"updated_at,omitempty"`
+	LastReadAt         string                     `json:"last_read_at,omitempty"`
+}
+
+// MinimalNotificationSubject is the trimmed output type for the issue, pull
+// request, release or other object a notification thread is about.
+type MinimalNotificationSubject struct {
+	Title            string `json:"title"`
+	Type             string `json:"type"`
+	URL              string `json:"url,omitempty"`
+	LatestCommentURL string `json:"latest_comment_url,omitempty"`
+}
+
 // MinimalResponse represents a minimal response for all CRUD operations.
 // Success is implicit in the HTTP response status, and all other information
 // can be derived from the URL or fetched separately if needed.
@@ -326,6 +359,58 @@ type MinimalResponse struct {
 	URL string `json:"url"`
 }
 
+// MinimalFork is the trimmed output type for repository forks.
+type MinimalFork struct {
+	FullName string `json:"full_name"`
+	Owner    string `json:"owner"`
+	HTMLURL  string `json:"html_url"`
+	Stars    int    `json:"stargazers_count"`
+	Private  bool   `json:"private"`
+	PushedAt string `json:"pushed_at,omitempty"`
+}
+
+// MinimalPages is the trimmed output type for a repository's GitHub Pages site.
+type MinimalPages struct {
+	HTMLURL       string `json:"html_url,omitempty"`
+	Status        string `json:"status,omitempty"`
+	BuildType     string `json:"build_type,omitempty"`
+	SourceBranch  string `json:"source_branch,omitempty"`
+	SourcePath    string `json:"source_path,omitempty"`
+	CustomDomain  string `json:"custom_domain,omitempty"`
+	HTTPSEnforced bool   `json:"https_enforced"`
+	Public        bool   `json:"public"`
+}
+
+// MinimalPagesBuild is the trimmed output type for a GitHub Pages build.
+type MinimalPagesBuild struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+	Pusher     string `json:"pusher,omitempty"`
+	DurationMS int    `json:"duration_ms,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+}
+
+// MinimalDeployKey is the trimmed output type for repository deploy keys.
+type MinimalDeployKey struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	ReadOnly  bool   `json:"read_only"`
+	Verified  bool   `json:"verified"`
+	CreatedAt string `json:"created_at,omitempty"`
+	LastUsed  string `json:"last_used,omitempty"`
+}
+
+// MinimalCreatedRepository is the output type for a newly created repository.
+type MinimalCreatedRepository struct {
+	ID            string `json:"id"`
+	URL           string `json:"url"`
+	FullName      string `json:"full_name"`
+	CloneURL      string `json:"clone_url,omitempty"`
+	SSHURL        string `json:"ssh_url,omitempty"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+}
+
 // MinimalCollaborator is the trimmed output type for repository collaborators.
 type MinimalCollaborator struct {
 	Login    string `json:"login"`
@@ -351,6 +436,48 @@ type MinimalProject struct {
 	OwnerType        string            `json:"owner_type,omitempty"`
 }
 
+// MinimalProjectField is the output type for a project field, with single-select
+// options and iterations flattened into a typed options array.
+type MinimalProjectField struct {
+	ID        int64                       `json:"id"`
+	NodeID    string                      `json:"node_id,omitempty"`
+	Name      string                      `json:"name"`
+	DataType  string                      `json:"data_type"`
+	Options   []MinimalProjectFieldOption `json:"options,omitempty"`
+	CreatedAt string                      `json:"created_at,omitempty"`
+	UpdatedAt string                      `json:"updated_at,omitempty"`
+}
+
+// MinimalProjectFieldOption is a single-select option or an iteration of a project field.
+// The ID is the value to pass when setting the field with update_project_item.
+type MinimalProjectFieldOption struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	Duration    int    `json:"duration,omitempty"`
+}
+
+// ProjectIteration is a single iteration of an iteration-type project field.
+// The ID is the value to pass when setting the field with update_project_item;
+// Duration is in days.
+type ProjectIteration struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	Duration  int    `json:"duration"`
+}
+
+// ProjectIterationConfiguration is the typed configuration of an iteration-type
+// project field, with iterations that have already ended listed separately.
+type ProjectIterationConfiguration struct {
+	StartDay            int                `json:"start_day,omitempty"`
+	Duration            int                `json:"duration,omitempty"`
+	Iterations          []ProjectIteration `json:"iterations"`
+	CompletedIterations []ProjectIteration `json:"completed_iterations"`
+}
+
 type MinimalProjectItem struct {
 	ID          int64                          `json:"id"`
 	NodeID      string                         `json:"node_id,omitempty"`
@@ -368,6 +495,7 @@ type MinimalProjectItemContent struct {
 	NodeID      string   `json:"node_id,omitempty"`
 	Number      int      `json:"number,omitempty"`
 	Title       string   `json:"title,omitempty"`
+	Body        string   `json:"body,omitempty"`
 	State       string   `json:"state,omitempty"`
 	StateReason string   `json:"state_reason,omitempty"`
 	HTMLURL     string   `json:"html_url,omitempty"`
@@ -383,6 +511,9 @@ type MinimalProjectItemContent struct {
 	UpdatedAt   string   `json:"updated_at,omitempty"`
 	ClosedAt    string   `json:"closed_at,omitempty"`
 	MergedAt    string   `json:"merged_at,omitempty"`
+
+	// BodyTruncated reports that Body was cut to the content_body limit.
+	BodyTruncated bool `json:"body_truncated,omitempty"`
 }
 
 type MinimalProjectItemFieldValue struct {
@@ -522,6 +653,22 @@ type MinimalIssueComment struct {
 	UpdatedAt         string            `json:"updated_at,omitempty"`
 }
 
+// MinimalGistComment is the trimmed output type for gist comment objects.
+type MinimalGistComment struct {
+	ID        int64        `json:"id"`
+	Body      string       `json:"body,omitempty"`
+	User      *MinimalUser `json:"user,omitempty"`
+	CreatedAt string       `json:"created_at,omitempty"`
+}
+
+// MinimalGistCommit is the trimmed output type for a gist revision.
+type MinimalGistCommit struct {
+	Version      string              `json:"version"`
+	User         *MinimalUser        `json:"user,omitempty"`
+	CommittedAt  string              `json:"committed_at,omitempty"`
+	ChangeStatus *MinimalCommitStats `json:"change_status,omitempty"`
+}
+
 // MinimalSearchCommitsResult is the trimmed output type for commit search results.
 type MinimalSearchCommitsResult struct {
 	TotalCount        int                       `json:"total_count"`
@@ -604,6 +751,13 @@ type MinimalProjectStatusUpdate struct {
 	Creator    *MinimalUser `json:"creator,omitempty"`
 }
 
+// MinimalProjectView is the trimmed output type for a project view.
+type MinimalProjectView struct {
+	Number int    `json:"number"`
+	Name   string `json:"name"`
+	Layout string `json:"layout"`
+}
+
 // MinimalPullRequestReview is the trimmed output type for pull request review objects to reduce verbosity.
 type MinimalPullRequestReview struct {
 	ID                int64        `json:"id"`
@@ -795,6 +949,118 @@ func convertToMinimalIssuesResponse(fragment IssueQueryFragment) MinimalIssuesRe
 	}
 }
 
+func convertToMinimalRepository(repo *github.Repository) MinimalRepository {
+	m := MinimalRepository{
+		ID:            repo.GetID(),
+		Name:          repo.GetName(),
+		FullName:      repo.GetFullName(),
+		Description:   repo.GetDescription(),
+		HTMLURL:       repo.GetHTMLURL(),
+		Language:      repo.GetLanguage(),
+		Stars:         repo.GetStargazersCount(),
+		Forks:         repo.GetForksCount(),
+		OpenIssues:    repo.GetOpenIssuesCount(),
+		Private:       repo.GetPrivate(),
+		Fork:          repo.GetFork(),
+		Archived:      repo.GetArchived(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		Topics:        repo.Topics,
+		Visibility:    repo.GetVisibility(),
+	}
+	if p := repo.Permissions; p != nil {
+		m.Permissions = &MinimalRepositoryPermissions{
+			Admin:    p.GetAdmin(),
+			Maintain: p.GetMaintain(),
+			Push:     p.GetPush(),
+			Triage:   p.GetTriage(),
+			Pull:     p.GetPull(),
+		}
+	}
+	if repo.UpdatedAt != nil {
+		m.UpdatedAt = repo.UpdatedAt.Format(time.RFC3339)
+	}
+	if repo.CreatedAt != nil {
+		m.CreatedAt = repo.CreatedAt.Format(time.RFC3339)
+	}
+	if repo.PushedAt != nil {
+		m.PushedAt = repo.PushedAt.Format(time.RFC3339)
+	}
+	return m
+}
+
+func convertToMinimalPages(pages *github.Pages) MinimalPages {
+	return MinimalPages{
+		HTMLURL:       pages.GetHTMLURL(),
+		Status:        pages.GetStatus(),
+		BuildType:     pages.GetBuildType(),
+		SourceBranch:  pages.GetSource().GetBranch(),
+		SourcePath:    pages.GetSource().GetPath(),
+		CustomDomain:  pages.GetCNAME(),
+		HTTPSEnforced: pages.GetHTTPSEnforced(),
+		Public:        pages.GetPublic(),
+	}
+}
+
+func convertToMinimalPagesBuild(build *github.PagesBuild) MinimalPagesBuild {
+	m := MinimalPagesBuild{
+		Status:     build.GetStatus(),
+		Error:      build.GetError().GetMessage(),
+		Commit:     build.GetCommit(),
+		Pusher:     build.GetPusher().GetLogin(),
+		DurationMS: build.GetDuration(),
+	}
+	if build.CreatedAt != nil {
+		m.CreatedAt = build.CreatedAt.Format(time.RFC3339)
+	}
+	return m
+}
+
+func convertToMinimalDeployKey(key *github.Key) MinimalDeployKey {
+	m := MinimalDeployKey{
+		ID:       key.GetID(),
+		Title:    key.GetTitle(),
+		ReadOnly: key.GetReadOnly(),
+		Verified: key.GetVerified(),
+	}
+	if key.CreatedAt != nil {
+		m.CreatedAt = key.CreatedAt.Format(time.RFC3339)
+	}
+	if key.LastUsed != nil {
+		m.LastUsed = key.LastUsed.Format(time.RFC3339)
+	}
+	return m
+}
+
+func convertToMinimalGistComment(comment *github.GistComment) MinimalGistComment {
+	m := MinimalGistComment{
+		ID:   comment.GetID(),
+		Body: comment.GetBody(),
+		User: convertToMinimalUser(comment.GetUser()),
+	}
+	if comment.CreatedAt != nil {
+		m.CreatedAt = comment.CreatedAt.Format(time.RFC3339)
+	}
+	return m
+}
+
+func convertToMinimalGistCommit(commit *github.GistCommit) MinimalGistCommit {
+	m := MinimalGistCommit{
+		Version: commit.GetVersion(),
+		User:    convertToMinimalUser(commit.GetUser()),
+	}
+	if commit.CommittedAt != nil {
+		m.CommittedAt = commit.CommittedAt.Format(time.RFC3339)
+	}
+	if s := commit.ChangeStatus; s != nil {
+		m.ChangeStatus = &MinimalCommitStats{
+			Additions: s.GetAdditions(),
+			Deletions: s.GetDeletions(),
+			Total:     s.GetTotal(),
+		}
+	}
+	return m
+}
+
 func convertToMinimalIssueComment(comment *github.IssueComment) MinimalIssueComment {
 	m := MinimalIssueComment{
 		ID:                comment.GetID(),
@@ -952,6 +1218,74 @@ func convertToMinimalPRBranch(branch *github.PullRequestBranch) *MinimalPRBranch
 	return b
 }
 
+func convertToMinimalProjectField(field *github.ProjectV2Field) MinimalProjectField {
+	m := MinimalProjectField{
+		ID:       field.GetID(),
+		NodeID:   field.GetNodeID(),
+		Name:     field.GetName(),
+		DataType: field.GetDataType(),
+	}
+	if field.CreatedAt != nil {
+		m.CreatedAt = field.CreatedAt.Format(time.RFC3339)
+	}
+	if field.UpdatedAt != nil {
+		m.UpdatedAt = field.UpdatedAt.Format(time.RFC3339)
+	}
+
+	for _, option := range field.Options {
+		m.Options = append(m.Options, MinimalProjectFieldOption{
+			ID:          option.GetID(),
+			Name:        projectTextContentString(option.Name),
+			Color:       option.GetColor(),
+			Description: projectTextContentString(option.Description),
+		})
+	}
+	if field.Configuration != nil {
+		for _, iteration := range field.Configuration.Iterations {
+			m.Options = append(m.Options, MinimalProjectFieldOption{
+				ID:        iteration.GetID(),
+				Name:      projectTextContentString(iteration.Title),
+				StartDate: iteration.GetStartDate(),
+				Duration:  iteration.GetDuration(),
+			})
+		}
+	}
+
+	return m
+}
+
+// convertToProjectIterationConfiguration parses an iteration field's
+// configuration, treating iterations whose end date is not after now as
+// completed.
+func convertToProjectIterationConfiguration(config *github.ProjectV2FieldConfiguration, now time.Time) *ProjectIterationConfiguration {
+	if config == nil {
+		return nil
+	}
+
+	c := &ProjectIterationConfiguration{
+		StartDay:            config.GetStartDay(),
+		Duration:            config.GetDuration(),
+		Iterations:          []ProjectIteration{},
+		CompletedIterations: []ProjectIteration{},
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	for _, iteration := range config.Iterations {
+		it := ProjectIteration{
+			ID:        iteration.GetID(),
+			Title:     projectTextContentString(iteration.Title),
+			StartDate: iteration.GetStartDate(),
+			Duration:  iteration.GetDuration(),
+		}
+		if start, err := time.Parse("2006-01-02", it.StartDate); err == nil && !start.AddDate(0, 0, it.Duration).After(today) {
+			c.CompletedIterations = append(c.CompletedIterations, it)
+			continue
+		}
+		c.Iterations = append(c.Iterations, it)
+	}
+
+	return c
+}
+
 func convertToMinimalProject(fullProject *github.ProjectV2) *MinimalProject {
 	if fullProject == nil {
 		return nil
@@ -1027,6 +1361,7 @@ func convertIssueToMinimalProjectItemContent(issue *github.Issue) *MinimalProjec
 		NodeID:      issue.GetNodeID(),
 		Number:      issue.GetNumber(),
 		Title:       issue.GetTitle(),
+		Body:        issue.GetBody(),
 		State:       issue.GetState(),
 		StateReason: issue.GetStateReason(),
 		HTMLURL:     issue.GetHTMLURL(),
@@ -1064,6 +1399,7 @@ func convertPullRequestToMinimalProjectItemContent(pr *github.PullRequest) *Mini
 		NodeID:     pr.GetNodeID(),
 		Number:     pr.GetNumber(),
 		Title:      pr.GetTitle(),
+		Body:       pr.GetBody(),
 		State:      pr.GetState(),
 		HTMLURL:    pr.GetHTMLURL(),
 		Repository: pullRequestRepositoryFullName(pr),
@@ -1101,6 +1437,7 @@ func convertDraftIssueToMinimalProjectItemContent(draftIssue *github.ProjectV2Dr
 		ID:        draftIssue.GetID(),
 		NodeID:    draftIssue.GetNodeID(),
 		Title:     draftIssue.GetTitle(),
+		Body:      draftIssue.GetBody(),
 		CreatedAt: formatProjectTimestamp(draftIssue.CreatedAt),
 		UpdatedAt: formatProjectTimestamp(draftIssue.UpdatedAt),
 	}
@@ -1809,6 +2146,32 @@ func convertToMinimalTag(tag *github.RepositoryTag) MinimalTag {
 	return m
 }
 
+func convertToMinimalNotification(notification *github.Notification) MinimalNotification {
+	m := MinimalNotification{
+		ID:                 notification.GetID(),
+		Reason:             notification.GetReason(),
+		Unread:             notification.GetUnread(),
+		RepositoryFullName: notification.GetRepository().GetFullName(),
+	}
+
+	if subject := notification.GetSubject(); subject != nil {
+		m.Subject = MinimalNotificationSubject{
+			Title:            subject.GetTitle(),
+			Type:             subject.GetType(),
+			URL:              subject.GetURL(),
+			LatestCommentURL: subject.GetLatestCommentURL(),
+		}
+	}
+	if notification.UpdatedAt != nil {
+		m.UpdatedAt = notification.UpdatedAt.Format(time.RFC3339)
+	}
+	if notification.LastReadAt != nil {
+		m.LastReadAt = notification.LastReadAt.Format(time.RFC3339)
+	}
+
+	return m
+}
+
 // MinimalCheckRun is the trimmed output type for check run objects.
 type MinimalCheckRun struct {
 	ID          int64  `json:"id"`
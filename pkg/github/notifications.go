@@ -151,8 +151,12 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get notifications", resp, body), nil, nil
 			}
 
-			// Marshal response to JSON
-			r, err := json.Marshal(notifications)
+			minimalNotifications := make([]MinimalNotification, 0, len(notifications))
+			for _, notification := range notifications {
+				minimalNotifications = append(minimalNotifications, convertToMinimalNotification(notification))
+			}
+
+			r, err := json.Marshal(minimalNotifications)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
 			}
@@ -37,6 +37,13 @@ func Test_ListNotifications(t *testing.T) {
 	mockNotification := &github.Notification{
 		ID:     github.Ptr("123"),
 		Reason: github.Ptr("mention"),
+		Unread: github.Ptr(true),
+		Subject: &github.NotificationSubject{
+			Title: github.Ptr("Fix the flaky test"),
+			Type:  github.Ptr("PullRequest"),
+			URL:   github.Ptr("https://api.github.com/repos/octocat/hello-world/pulls/42"),
+		},
+		Repository: &github.Repository{FullName: github.Ptr("octocat/hello-world")},
 	}
 
 	tests := []struct {
@@ -129,11 +136,17 @@ func Test_ListNotifications(t *testing.T) {
 			require.False(t, result.IsError)
 			textContent := getTextResult(t, result)
 			t.Logf("textContent: %s", textContent.Text)
-			var returned []*github.Notification
+			var returned []MinimalNotification
 			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
 			require.NotEmpty(t, returned)
-			assert.Equal(t, *tc.expectedResult[0].ID, *returned[0].ID)
+			expected := tc.expectedResult[0]
+			assert.Equal(t, expected.GetID(), returned[0].ID)
+			assert.Equal(t, expected.GetReason(), returned[0].Reason)
+			assert.True(t, returned[0].Unread)
+			assert.Equal(t, "octocat/hello-world", returned[0].RepositoryFullName)
+			assert.Equal(t, expected.GetSubject().GetURL(), returned[0].Subject.URL)
+			assert.Equal(t, "PullRequest", returned[0].Subject.Type)
 		})
 	}
 }
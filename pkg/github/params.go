@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // OptionalParamOK is a helper function that can be used to fetch a requested parameter from the request.
@@ -40,6 +42,138 @@ func isAcceptedError(err error) bool {
 	return errors.As(err, &acceptedError)
 }
 
+// defaultParamAliases maps canonical parameter names to synonyms that models
+// commonly send in their place.
+var defaultParamAliases = map[string][]string{
+	"owner": {"org", "organization", "user"},
+	"repo":  {"repository"},
+}
+
+// toolParamAliases returns the aliases from defaultParamAliases that apply to
+// a tool: the canonical name must be one of its input properties, and an
+// alias must not be, so a tool with a real "organization" or "user" parameter
+// keeps it.
+func toolParamAliases(tool mcp.Tool) map[string][]string {
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	if !ok || schema == nil {
+		return nil
+	}
+	var aliases map[string][]string
+	for canonical, synonyms := range defaultParamAliases {
+		if _, ok := schema.Properties[canonical]; !ok {
+			continue
+		}
+		for _, alias := range synonyms {
+			if _, ok := schema.Properties[alias]; ok {
+				continue
+			}
+			if aliases == nil {
+				aliases = make(map[string][]string)
+			}
+			aliases[canonical] = append(aliases[canonical], alias)
+		}
+	}
+	return aliases
+}
+
+// toolHasProperty reports whether the tool's input schema declares name.
+func toolHasProperty(tool mcp.Tool, name string) bool {
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	if !ok || schema == nil {
+		return false
+	}
+	_, ok = schema.Properties[name]
+	return ok
+}
+
+// applyParamAliases resolves aliases in place so the param helpers find the
+// value under its canonical key. The canonical key is authoritative: an alias
+// is only used when the canonical key is absent, and the first alias present
+// wins. Aliases that were consulted are removed from args.
+func applyParamAliases(args map[string]any, aliases map[string][]string) {
+	for canonical, synonyms := range aliases {
+		_, hasCanonical := args[canonical]
+		for _, alias := range synonyms {
+			v, ok := args[alias]
+			if !ok {
+				continue
+			}
+			if !hasCanonical {
+				args[canonical] = v
+				hasCanonical = true
+			}
+			delete(args, alias)
+		}
+	}
+}
+
+// normalizeOwnerRepoArgs cleans up the owner and repo arguments in place so
+// that values pasted from a browser still resolve. It trims whitespace, strips
+// a leading scheme and host (e.g. "https://github.com/"), and drops trailing
+// slashes and a ".git" suffix. An organization or user page path such as
+// "orgs/octo-org/projects" reduces to the owner alone. When owner holds
+// "owner/repo" and repo is empty, the two are split; likewise a full path in
+// repo fills an empty owner. hasRepo reports whether the tool takes a repo
+// parameter; without one, a repo is never added to args.
+func normalizeOwnerRepoArgs(args map[string]any, hasRepo bool) {
+	owner, ownerIsString := args["owner"].(string)
+	repo, repoIsString := args["repo"].(string)
+	if !ownerIsString && !repoIsString {
+		return
+	}
+	owner, repo = normalizeOwnerRepoPath(owner), normalizeOwnerRepoPath(repo)
+
+	if login, ok := ownerPageLogin(owner); ok {
+		owner = login
+	} else if o, r, ok := strings.Cut(owner, "/"); ok {
+		owner = o
+		if repo == "" {
+			repo, _, _ = strings.Cut(r, "/")
+		}
+	}
+	if o, r, ok := strings.Cut(repo, "/"); ok {
+		if owner == "" || strings.EqualFold(owner, o) {
+			owner = o
+			repo, _, _ = strings.Cut(r, "/")
+		}
+	}
+
+	if ownerIsString {
+		args["owner"] = owner
+	}
+	if repoIsString || (hasRepo && repo != "") {
+		args["repo"] = repo
+	}
+}
+
+// ownerPageLogin returns the login of an organization or user page path, such
+// as "orgs/octo-org" or "users/octocat/projects/1".
+func ownerPageLogin(path string) (string, bool) {
+	prefix, rest, ok := strings.Cut(path, "/")
+	if !ok || (!strings.EqualFold(prefix, "orgs") && !strings.EqualFold(prefix, "users")) {
+		return "", false
+	}
+	login, _, _ := strings.Cut(rest, "/")
+	return login, login != ""
+}
+
+// normalizeOwnerRepoPath trims a single owner or repo value down to its path.
+func normalizeOwnerRepoPath(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "://"); i >= 0 {
+		s = s[i+len("://"):]
+		if _, path, ok := strings.Cut(s, "/"); ok {
+			s = path
+		} else {
+			s = ""
+		}
+	} else if rest, ok := strings.CutPrefix(strings.ToLower(s), "github.com/"); ok {
+		s = s[len(s)-len(rest):]
+	}
+	s = strings.Trim(s, "/")
+	return strings.TrimSuffix(s, ".git")
+}
+
 // toInt converts a value to int, handling both float64 and string representations.
 // Some MCP clients send numeric values as strings. It rejects NaN, ±Inf,
 // fractional values, and values outside the int range.
@@ -268,30 +402,25 @@ func OptionalStringArrayParam(args map[string]any, p string) ([]string, error) {
 	}
 }
 
-func convertStringSliceToBigIntSlice(s []string) ([]int64, error) {
+// convertStringSliceToBigIntSlice parses the elements of parameter p as int64
+// values, naming the offending element when one is not a valid integer.
+func convertStringSliceToBigIntSlice(p string, s []string) ([]int64, error) {
 	int64Slice := make([]int64, len(s))
 	for i, str := range s {
-		val, err := convertStringToBigInt(str, 0)
+		val, err := strconv.ParseInt(str, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert element %d (%s) to int64: %w", i, str, err)
+			return []int64{}, fmt.Errorf("parameter %s[%d] %q is not a valid integer", p, i, str)
 		}
 		int64Slice[i] = val
 	}
 	return int64Slice, nil
 }
 
-func convertStringToBigInt(s string, def int64) (int64, error) {
-	v, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
-		return def, fmt.Errorf("failed to convert string %s to int64: %w", s, err)
-	}
-	return v, nil
-}
-
 // OptionalBigIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns an empty slice
 // 2. If it is present, iterates the elements, checks each is a string, and converts them to int64 values
+// An empty array yields an empty, non-nil slice.
 func OptionalBigIntArrayParam(args map[string]any, p string) ([]int64, error) {
 	// Check if the parameter is present in the request
 	if _, ok := args[p]; !ok {
@@ -302,21 +431,17 @@ func OptionalBigIntArrayParam(args map[string]any, p string) ([]int64, error) {
 	case nil:
 		return []int64{}, nil
 	case []string:
-		return convertStringSliceToBigIntSlice(v)
+		return convertStringSliceToBigIntSlice(p, v)
 	case []any:
-		int64Slice := make([]int64, len(v))
-		for i, v := range v {
-			s, ok := v.(string)
+		strSlice := make([]string, len(v))
+		for i, elem := range v {
+			s, ok := elem.(string)
 			if !ok {
-				return []int64{}, fmt.Errorf("parameter %s is not of type string, is %T", p, v)
+				return []int64{}, fmt.Errorf("parameter %s[%d] is not of type string, is %T", p, i, elem)
 			}
-			val, err := convertStringToBigInt(s, 0)
-			if err != nil {
-				return []int64{}, fmt.Errorf("parameter %s: failed to convert element %d (%s) to int64: %w", p, i, s, err)
-			}
-			int64Slice[i] = val
+			strSlice[i] = s
 		}
-		return int64Slice, nil
+		return convertStringSliceToBigIntSlice(p, strSlice)
 	default:
 		return []int64{}, fmt.Errorf("parameter %s could not be coerced to []int64, is %T", p, args[p])
 	}
@@ -440,6 +565,9 @@ type pageInfo struct {
 	HasPreviousPage bool   `json:"hasPreviousPage"`
 	NextCursor      string `json:"nextCursor,omitempty"`
 	PrevCursor      string `json:"prevCursor,omitempty"`
+	// PerPage is the page size sent to GitHub, which may be lower than the
+	// one requested when it exceeds the tool's maximum.
+	PerPage int `json:"perPage,omitempty"`
 }
 
 func buildPageInfo(resp *github.Response) pageInfo {
@@ -451,6 +579,30 @@ func buildPageInfo(resp *github.Response) pageInfo {
 	}
 }
 
+// fetchAllCursorPages calls fetch with successive After cursors, starting
+// from after, until a page reports no next cursor or maxItems results have
+// been collected. It returns the collected results, the last response fetched,
+// and whether maxItems cut the results short. fetch is responsible for closing
+// each response body.
+func fetchAllCursorPages[T any](after string, maxItems int, fetch func(after string) ([]T, *github.Response, error)) ([]T, *github.Response, bool, error) {
+	var all []T
+	for {
+		page, resp, err := fetch(after)
+		if err != nil {
+			return nil, resp, false, err
+		}
+		all = append(all, page...)
+		if len(all) >= maxItems {
+			truncated := len(all) > maxItems || resp.After != ""
+			return all[:maxItems], resp, truncated, nil
+		}
+		if resp.After == "" || resp.After == after {
+			return all, resp, false, nil
+		}
+		after = resp.After
+	}
+}
+
 // ToGraphQLParams converts cursor pagination parameters to GraphQL-specific parameters.
 func (p CursorPaginationParams) ToGraphQLParams() (*GraphQLPaginationParams, error) {
 	if p.PerPage > 100 {
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -97,6 +99,155 @@ func Test_RequiredStringParam(t *testing.T) {
 	}
 }
 
+func Test_ParamAliases(t *testing.T) {
+	tool := mcp.Tool{
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"owner": {Type: "string"},
+				"repo":  {Type: "string"},
+			},
+		},
+	}
+	aliases := toolParamAliases(tool)
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		expectedOwner string
+		expectedRepo  string
+	}{
+		{
+			name:          "repository resolves to repo",
+			args:          map[string]any{"owner": "octo-org", "repository": "octo-repo"},
+			expectedOwner: "octo-org",
+			expectedRepo:  "octo-repo",
+		},
+		{
+			name:          "org resolves to owner",
+			args:          map[string]any{"org": "octo-org", "repo": "octo-repo"},
+			expectedOwner: "octo-org",
+			expectedRepo:  "octo-repo",
+		},
+		{
+			name:          "canonical keys take precedence",
+			args:          map[string]any{"owner": "octo-org", "org": "other-org", "repo": "octo-repo", "repository": "other-repo"},
+			expectedOwner: "octo-org",
+			expectedRepo:  "octo-repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			applyParamAliases(tc.args, aliases)
+
+			owner, err := RequiredParam[string](tc.args, "owner")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOwner, owner)
+
+			repo, err := RequiredParam[string](tc.args, "repo")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRepo, repo)
+		})
+	}
+
+	t.Run("declared parameters are not aliased", func(t *testing.T) {
+		tool := mcp.Tool{
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner":        {Type: "string"},
+					"repo":         {Type: "string"},
+					"organization": {Type: "string"},
+				},
+			},
+		}
+		args := map[string]any{"repo": "octo-repo", "organization": "octo-org"}
+		applyParamAliases(args, toolParamAliases(tool))
+
+		_, err := RequiredParam[string](args, "owner")
+		assert.Error(t, err)
+		organization, err := OptionalParam[string](args, "organization")
+		assert.NoError(t, err)
+		assert.Equal(t, "octo-org", organization)
+	})
+}
+
+func Test_normalizeOwnerRepoArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      map[string]any
+		ownerOnly bool
+		expected  map[string]any
+	}{
+		{
+			name:     "pasted URL owner",
+			args:     map[string]any{"owner": " https://github.com/octo-org/ ", "repo": "octo-repo"},
+			expected: map[string]any{"owner": "octo-org", "repo": "octo-repo"},
+		},
+		{
+			name:     "pasted enterprise URL with repo",
+			args:     map[string]any{"owner": "https://ghe.example.com/octo-org/octo-repo.git"},
+			expected: map[string]any{"owner": "octo-org", "repo": "octo-repo"},
+		},
+		{
+			name:     "combined owner/repo with empty repo",
+			args:     map[string]any{"owner": "octo-org/octo-repo", "repo": ""},
+			expected: map[string]any{"owner": "octo-org", "repo": "octo-repo"},
+		},
+		{
+			name:     "combined owner/repo keeps explicit repo",
+			args:     map[string]any{"owner": "octo-org/octo-repo", "repo": "other-repo"},
+			expected: map[string]any{"owner": "octo-org", "repo": "other-repo"},
+		},
+		{
+			name:     "host without scheme in repo",
+			args:     map[string]any{"owner": "", "repo": "github.com/octo-org/octo-repo"},
+			expected: map[string]any{"owner": "octo-org", "repo": "octo-repo"},
+		},
+		{
+			name:      "owner-only tool",
+			args:      map[string]any{"owner": "https://github.com/octo-org"},
+			ownerOnly: true,
+			expected:  map[string]any{"owner": "octo-org"},
+		},
+		{
+			name:      "owner-only tool never gains repo",
+			args:      map[string]any{"owner": "octo-org/octo-repo"},
+			ownerOnly: true,
+			expected:  map[string]any{"owner": "octo-org"},
+		},
+		{
+			name:      "organization page URL",
+			args:      map[string]any{"owner": "https://github.com/orgs/octo-org"},
+			ownerOnly: true,
+			expected:  map[string]any{"owner": "octo-org"},
+		},
+		{
+			name:     "user projects URL",
+			args:     map[string]any{"owner": "https://github.com/users/octocat/projects/3", "repo": ""},
+			expected: map[string]any{"owner": "octocat", "repo": ""},
+		},
+		{
+			name:     "clean values untouched",
+			args:     map[string]any{"owner": "Octo-Org", "repo": "octo-repo", "path": "a/b"},
+			expected: map[string]any{"owner": "Octo-Org", "repo": "octo-repo", "path": "a/b"},
+		},
+		{
+			name:     "non-string values untouched",
+			args:     map[string]any{"owner": 123},
+			expected: map[string]any{"owner": 123},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			normalizeOwnerRepoArgs(tc.args, !tc.ownerOnly)
+			assert.Equal(t, tc.expected, tc.args)
+		})
+	}
+}
+
 func Test_OptionalStringParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -549,6 +700,70 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func TestOptionalBigIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        map[string]any
+		expected      []int64
+		expectedError string
+	}{
+		{
+			name:     "parameter not in request",
+			params:   map[string]any{},
+			expected: []int64{},
+		},
+		{
+			name:     "empty array",
+			params:   map[string]any{"fields": []any{}},
+			expected: []int64{},
+		},
+		{
+			name:     "valid any array parameter",
+			params:   map[string]any{"fields": []any{"102589", "9007199254740993"}},
+			expected: []int64{102589, 9007199254740993},
+		},
+		{
+			name:     "valid string array parameter",
+			params:   map[string]any{"fields": []string{"1", "2"}},
+			expected: []int64{1, 2},
+		},
+		{
+			name:          "invalid element among valid ones",
+			params:        map[string]any{"fields": []any{"1", "2", "abc"}},
+			expectedError: `parameter fields[2] "abc" is not a valid integer`,
+		},
+		{
+			name:          "invalid element in string array",
+			params:        map[string]any{"fields": []string{"1.5"}},
+			expectedError: `parameter fields[0] "1.5" is not a valid integer`,
+		},
+		{
+			name:          "non-string element",
+			params:        map[string]any{"fields": []any{"1", float64(2)}},
+			expectedError: "parameter fields[1] is not of type string, is float64",
+		},
+		{
+			name:          "wrong type parameter",
+			params:        map[string]any{"fields": "1"},
+			expectedError: "parameter fields could not be coerced to []int64, is string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := OptionalBigIntArrayParam(tc.params, "fields")
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -642,3 +857,71 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_FetchAllCursorPages(t *testing.T) {
+	pages := map[string]struct {
+		items []int
+		next  string
+	}{
+		"":   {items: []int{1, 2}, next: "c1"},
+		"c1": {items: []int{3, 4}, next: "c2"},
+		"c2": {items: []int{5}},
+	}
+	fetch := func(after string) ([]int, *github.Response, error) {
+		page := pages[after]
+		return page.items, &github.Response{After: page.next}, nil
+	}
+
+	tests := []struct {
+		name              string
+		after             string
+		maxItems          int
+		expectedItems     []int
+		expectedTruncated bool
+	}{
+		{
+			name:          "follows cursors to the last page",
+			maxItems:      10,
+			expectedItems: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:          "starts from the given cursor",
+			after:         "c1",
+			maxItems:      10,
+			expectedItems: []int{3, 4, 5},
+		},
+		{
+			name:              "stops at the cap when more pages remain",
+			maxItems:          4,
+			expectedItems:     []int{1, 2, 3, 4},
+			expectedTruncated: true,
+		},
+		{
+			name:              "cuts a page that overshoots the cap",
+			maxItems:          3,
+			expectedItems:     []int{1, 2, 3},
+			expectedTruncated: true,
+		},
+		{
+			name:          "cap reached exactly on the last page",
+			maxItems:      5,
+			expectedItems: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			items, _, truncated, err := fetchAllCursorPages(tc.after, tc.maxItems, fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedItems, items)
+			assert.Equal(t, tc.expectedTruncated, truncated)
+		})
+	}
+
+	t.Run("returns the fetch error", func(t *testing.T) {
+		_, _, _, err := fetchAllCursorPages("", 10, func(string) ([]int, *github.Response, error) {
+			return nil, nil, fmt.Errorf("boom")
+		})
+		assert.EqualError(t, err, "boom")
+	})
+}
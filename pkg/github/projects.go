@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -31,7 +32,9 @@ const (
 	ProjectStatusUpdateGetFailedError    = "failed to get project status update"
 	ProjectStatusUpdateCreateFailedError = "failed to create project status update"
 	ProjectResolveIDFailedError          = "failed to resolve project ID"
+	ProjectViewListFailedError           = "failed to list project views"
 	MaxProjectsPerPage                   = 50
+	MaxProjectItemsFetchAll              = 1000
 )
 
 // Method constants for consolidated project tools
@@ -42,6 +45,7 @@ const (
 	projectsMethodGetProject                = "get_project"
 	projectsMethodGetProjectField           = "get_project_field"
 	projectsMethodGetProjectItem            = "get_project_item"
+	projectsMethodGetProjectNodeID          = "get_project_node_id"
 	projectsMethodAddProjectItem            = "add_project_item"
 	projectsMethodUpdateProjectItem         = "update_project_item"
 	projectsMethodDeleteProjectItem         = "delete_project_item"
@@ -50,8 +54,33 @@ const (
 	projectsMethodCreateProjectStatusUpdate = "create_project_status_update"
 	projectsMethodCreateProject             = "create_project"
 	projectsMethodCreateIterationField      = "create_iteration_field"
+	projectsMethodListProjectViews          = "list_project_views"
+	projectsMethodSetProjectItemStatus      = "set_project_item_status"
+	projectsMethodExportProjectItems        = "export_project_items"
+	projectsMethodLinkProjectToRepository   = "link_project_to_repository"
+	projectsMethodUnlinkProjectFromRepo     = "unlink_project_from_repository"
+	projectsMethodUpdateProject             = "update_project"
 )
 
+// projectStatusFieldName is the name of the built-in single-select Status field.
+const projectStatusFieldName = "Status"
+
+// content_body modes controlling how much of an item's issue, pull request,
+// or draft issue body is returned.
+const (
+	projectContentBodyNone      = "none"
+	projectContentBodyTruncated = "truncated"
+	projectContentBodyFull      = "full"
+)
+
+// projectContentBodyMaxLength is the number of characters of an item body
+// kept in the truncated content_body mode.
+const projectContentBodyMaxLength = 500
+
+// projectsOwnerTypeAuto asks list tools to resolve whether the owner is an
+// organization or a user instead of requiring the caller to know.
+const projectsOwnerTypeAuto = "auto"
+
 // GraphQL types for ProjectV2 status updates
 
 type statusUpdateNode struct {
@@ -106,6 +135,32 @@ type statusUpdateNodeQuery struct {
 	} `graphql:"node(id: $id)"`
 }
 
+type projectViewNode struct {
+	Number githubv4.Int
+	Name   githubv4.String
+	Layout githubv4.String
+}
+
+// projectViewsNodeQuery is the GraphQL query for listing the views of a project by node ID.
+type projectViewsNodeQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Public githubv4.Boolean
+			Views  struct {
+				Nodes    []projectViewNode
+				PageInfo PageInfoFragment
+			} `graphql:"views(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// projectViewLayouts maps GraphQL ProjectV2ViewLayout values to the names shown in the UI.
+var projectViewLayouts = map[string]string{
+	"BOARD_LAYOUT":   "board",
+	"TABLE_LAYOUT":   "table",
+	"ROADMAP_LAYOUT": "roadmap",
+}
+
 // CreateProjectV2StatusUpdateInput is the input for the createProjectV2StatusUpdate mutation.
 // Defined locally because the shurcooL/githubv4 library does not include this type.
 type CreateProjectV2StatusUpdateInput struct {
@@ -175,12 +230,14 @@ Use this tool to list projects for a user or organization, or list project field
 							projectsMethodListProjectFields,
 							projectsMethodListProjectItems,
 							projectsMethodListProjectStatusUpdates,
+							projectsMethodListProjectViews,
+							projectsMethodExportProjectItems,
 						},
 					},
 					"owner_type": {
 						Type:        "string",
-						Description: "Owner type (user or org). If not provided, will automatically try both.",
-						Enum:        []any{"user", "org"},
+						Description: "Owner type (user or org). Use 'auto' when unsure: list_projects tries the org endpoint first, falls back to the user endpoint, and reports which type resolved. If not provided, list_projects combines results from both.",
+						Enum:        []any{"user", "org", projectsOwnerTypeAuto},
 					},
 					"owner": {
 						Type:        "string",
@@ -188,29 +245,45 @@ Use this tool to list projects for a user or organization, or list project field
 					},
 					"project_number": {
 						Type:        "number",
-						Description: "The project's number. Required for 'list_project_fields', 'list_project_items', and 'list_project_status_updates' methods.",
+						Description: "The project's number. Required for 'list_project_fields', 'list_project_items', 'list_project_status_updates', 'list_project_views', and 'export_project_items' methods.",
 					},
 					"query": {
 						Type:        "string",
-						Description: `Filter/query string. For list_projects: filter by title text and state (e.g. "roadmap is:open"). For list_project_items: advanced filtering using GitHub's project filtering syntax.`,
+						Description: `Filter/query string. For list_projects: filter by title text and state (e.g. "roadmap is:open"). For list_project_items and export_project_items: advanced filtering using GitHub's project filtering syntax.`,
+					},
+					"include_archived": {
+						Type:        "boolean",
+						Description: "Include archived items, which carry an archived_at timestamp. Archived items are excluded by default. Used for 'list_project_items' and 'export_project_items' methods.",
+						Default:     json.RawMessage(`false`),
 					},
 					"fields": {
 						Type:        "array",
-						Description: "Field IDs to include when listing project items (e.g. [\"102589\", \"985201\"]). CRITICAL: Always provide to get field values. Without this (and without 'field_names'), only titles returned. Mutually exclusive with 'field_names' — provide one, not both. Only used for 'list_project_items' method.",
+						Description: "Field IDs to include when listing project items (e.g. [\"102589\", \"985201\"]). CRITICAL: Always provide to get field values. Without this (and without 'field_names'), only titles returned. Mutually exclusive with 'field_names' — provide one, not both. Only used for 'list_project_items' and 'export_project_items' methods.",
 						Items: &jsonschema.Schema{
 							Type: "string",
 						},
 					},
 					"field_names": {
 						Type:        "array",
-						Description: "Field names to include when listing project items (e.g. [\"Status\", \"Priority\"]). Resolved server-side to field IDs — pass this instead of 'fields' when you only know the human-readable names. Names that fail to resolve return a structured error. Mutually exclusive with 'fields' — provide one, not both. Only used for 'list_project_items' method.",
+						Description: "Field names to include when listing project items (e.g. [\"Status\", \"Priority\"]). Resolved server-side to field IDs — pass this instead of 'fields' when you only know the human-readable names. Names that fail to resolve return a structured error. Mutually exclusive with 'fields' — provide one, not both. Only used for 'list_project_items' and 'export_project_items' methods.",
 						Items: &jsonschema.Schema{
 							Type: "string",
 						},
 					},
+					"content_body": {
+						Type:        "string",
+						Description: fmt.Sprintf("How much of each item's issue, pull request, or draft body to return: 'none' omits it, 'truncated' caps it at %d characters, 'full' returns it unchanged. Only used for 'list_project_items' method.", projectContentBodyMaxLength),
+						Enum:        []any{projectContentBodyNone, projectContentBodyTruncated, projectContentBodyFull},
+						Default:     json.RawMessage(`"truncated"`),
+					},
 					"per_page": {
 						Type:        "number",
-						Description: fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage),
+						Description: fmt.Sprintf("Results per page (max %d; larger values are capped and the page size used is reported in pageInfo.perPage)", MaxProjectsPerPage),
+					},
+					"fetch_all": {
+						Type:        "boolean",
+						Description: fmt.Sprintf("Follow pagination cursors and return every matching item, up to %d. The response sets 'truncated' when more items remained. Only used for 'list_project_items' method.", MaxProjectItemsFetchAll),
+						Default:     json.RawMessage(`false`),
 					},
 					"after": {
 						Type:        "string",
@@ -251,13 +324,13 @@ Use this tool to list projects for a user or organization, or list project field
 				result, visibilities, payload, err := listProjects(ctx, client, args, owner, ownerType)
 				result = attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelProjectList)
 				return result, payload, err
-			case projectsMethodListProjectFields, projectsMethodListProjectItems, projectsMethodListProjectStatusUpdates:
+			case projectsMethodListProjectFields, projectsMethodListProjectItems, projectsMethodListProjectStatusUpdates, projectsMethodListProjectViews, projectsMethodExportProjectItems:
 				// All other methods require project_number and ownerType detection
 				projectNumber, err := RequiredInt(args, "project_number")
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
-				if ownerType == "" {
+				if ownerType == "" || ownerType == projectsOwnerTypeAuto {
 					ownerType, err = detectOwnerType(ctx, client, owner, projectNumber)
 					if err != nil {
 						return utils.NewToolResultError(err.Error()), nil, nil
@@ -274,12 +347,16 @@ Use this tool to list projects for a user or organization, or list project field
 						}
 					}
 					return result, payload, err
-				case projectsMethodListProjectItems:
+				case projectsMethodListProjectItems, projectsMethodExportProjectItems:
 					gqlClient, gqlErr := deps.GetGQLClient(ctx)
 					if gqlErr != nil {
 						return utils.NewToolResultError(gqlErr.Error()), nil, nil
 					}
-					result, payload, err := listProjectItems(ctx, client, gqlClient, args, owner, ownerType)
+					listItems := listProjectItems
+					if method == projectsMethodExportProjectItems {
+						listItems = exportProjectItems
+					}
+					result, payload, err := listItems(ctx, client, gqlClient, args, owner, ownerType)
 					if shouldAttachIFCLabel(ctx, deps, result) {
 						isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
 						if visibilityErr == nil {
@@ -295,6 +372,14 @@ Use this tool to list projects for a user or organization, or list project field
 					result, isPrivate, payload, err := listProjectStatusUpdates(ctx, gqlClient, args, owner, ownerType)
 					result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProjectContent(isPrivate))
 					return result, payload, err
+				case projectsMethodListProjectViews:
+					gqlClient, err := deps.GetGQLClient(ctx)
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					result, isPrivate, payload, err := listProjectViews(ctx, gqlClient, args, owner, ownerType, projectNumber)
+					result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProject(isPrivate))
+					return result, payload, err
 				default:
 					return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 				}
@@ -314,6 +399,7 @@ func ProjectsGet(t translations.TranslationHelperFunc) inventory.ServerTool {
 			Name: "projects_get",
 			Description: t("TOOL_PROJECTS_GET_DESCRIPTION", `Get details about specific GitHub Projects resources.
 Use this tool to get details about individual projects, project fields, and project items by their unique IDs.
+Use 'get_project_node_id' to resolve a project and its owner to the GraphQL node IDs needed for raw GraphQL mutations.
 `),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_PROJECTS_GET_USER_TITLE", "Get details of GitHub Projects resources"),
@@ -330,6 +416,7 @@ Use this tool to get details about individual projects, project fields, and proj
 							projectsMethodGetProjectField,
 							projectsMethodGetProjectItem,
 							projectsMethodGetProjectStatusUpdate,
+							projectsMethodGetProjectNodeID,
 						},
 					},
 					"owner_type": {
@@ -347,7 +434,11 @@ Use this tool to get details about individual projects, project fields, and proj
 					},
 					"field_id": {
 						Type:        "number",
-						Description: "The field's ID. Required for 'get_project_field' method.",
+						Description: "The field's ID. Used for 'get_project_field' method; provide either 'field_id' or 'field_name'.",
+					},
+					"field_name": {
+						Type:        "string",
+						Description: "The field's name (case-insensitive), e.g. \"Status\". Resolved server-side to the field's ID; a name with no match returns the available field names. Used for 'get_project_field' method; provide either 'field_id' or 'field_name'.",
 					},
 					"item_id": {
 						Type:        "number",
@@ -371,6 +462,16 @@ Use this tool to get details about individual projects, project fields, and proj
 						Type:        "string",
 						Description: "The node ID of the project status update. Required for 'get_project_status_update' method.",
 					},
+					"all_fields": {
+						Type:        "boolean",
+						Description: "When true, includes the value of every field on the project without needing their IDs. Mutually exclusive with 'fields' and 'field_names'. Only used for 'get_project_item' method.",
+					},
+					"content_body": {
+						Type:        "string",
+						Description: fmt.Sprintf("How much of the item's issue, pull request, or draft body to return: 'none' omits it, 'truncated' caps it at %d characters, 'full' returns it unchanged. Only used for 'get_project_item' method.", projectContentBodyMaxLength),
+						Enum:        []any{projectContentBodyNone, projectContentBodyTruncated, projectContentBodyFull},
+						Default:     json.RawMessage(`"truncated"`),
+					},
 				},
 				Required: []string{"method"},
 			},
@@ -430,11 +531,17 @@ Use this tool to get details about individual projects, project fields, and proj
 				result, isPrivate, payload, err := getProject(ctx, client, owner, ownerType, projectNumber)
 				result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelProject(isPrivate))
 				return result, payload, err
-			case projectsMethodGetProjectField:
-				fieldID, err := RequiredBigInt(args, "field_id")
+			case projectsMethodGetProjectNodeID:
+				gqlClient, err := deps.GetGQLClient(ctx)
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
+				return getProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+			case projectsMethodGetProjectField:
+				fieldID, errResult := projectFieldIDFromArgs(ctx, deps, args, owner, ownerType, projectNumber)
+				if errResult != nil {
+					return errResult, nil, nil
+				}
 				result, payload, err := getProjectField(ctx, client, owner, ownerType, projectNumber, fieldID)
 				if shouldAttachIFCLabel(ctx, deps, result) {
 					isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
@@ -459,12 +566,29 @@ Use this tool to get details about individual projects, project fields, and proj
 				if len(fields) > 0 && len(fieldNames) > 0 {
 					return utils.NewToolResultError("provide either 'fields' or 'field_names', not both"), nil, nil
 				}
-				if len(fieldNames) > 0 {
+				allFields, err := OptionalParam[bool](args, "all_fields")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if allFields && (len(fields) > 0 || len(fieldNames) > 0) {
+					return utils.NewToolResultError("'all_fields' cannot be combined with 'fields' or 'field_names'"), nil, nil
+				}
+				contentBody, err := projectContentBodyParam(args)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if len(fieldNames) > 0 || allFields {
 					gqlClient, gqlErr := deps.GetGQLClient(ctx)
 					if gqlErr != nil {
 						return utils.NewToolResultError(gqlErr.Error()), nil, nil
 					}
-					resolvedIDs, resolveErr := resolveFieldNamesToIDs(ctx, gqlClient, owner, ownerType, projectNumber, fieldNames)
+					var resolvedIDs []int64
+					var resolveErr error
+					if allFields {
+						resolvedIDs, resolveErr = listAllProjectFieldIDs(ctx, gqlClient, owner, ownerType, projectNumber)
+					} else {
+						resolvedIDs, resolveErr = resolveFieldNamesToIDs(ctx, gqlClient, owner, ownerType, projectNumber, fieldNames)
+					}
 					if resolveErr != nil {
 						var structured *ghErrors.StructuredResolutionError
 						if errors.As(resolveErr, &structured) {
@@ -474,7 +598,7 @@ Use this tool to get details about individual projects, project fields, and proj
 					}
 					fields = append(fields, resolvedIDs...)
 				}
-				result, payload, err := getProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, fields)
+				result, payload, err := getProjectItem(ctx, client, owner, ownerType, projectNumber, itemID, fields, contentBody)
 				if shouldAttachIFCLabel(ctx, deps, result) {
 					isPrivate, visibilityErr := FetchProjectIsPrivate(ctx, client, owner, ownerType, projectNumber)
 					if visibilityErr == nil {
@@ -496,7 +620,7 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 		ToolsetMetadataProjects,
 		mcp.Tool{
 			Name:        "projects_write",
-			Description: t("TOOL_PROJECTS_WRITE_DESCRIPTION", "Create and manage GitHub Projects: create projects, add/update/delete items, create status updates, and add iteration fields."),
+			Description: t("TOOL_PROJECTS_WRITE_DESCRIPTION", "Create and manage GitHub Projects: create, update, and close projects, add/update/delete items, set item status by name, create status updates, add iteration fields, and link or unlink repositories."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_PROJECTS_WRITE_USER_TITLE", "Manage GitHub Projects"),
 				ReadOnlyHint:    false,
@@ -514,7 +638,11 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 							projectsMethodDeleteProjectItem,
 							projectsMethodCreateProjectStatusUpdate,
 							projectsMethodCreateProject,
+							projectsMethodUpdateProject,
 							projectsMethodCreateIterationField,
+							projectsMethodSetProjectItemStatus,
+							projectsMethodLinkProjectToRepository,
+							projectsMethodUnlinkProjectFromRepo,
 						},
 					},
 					"owner_type": {
@@ -532,11 +660,27 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 					},
 					"title": {
 						Type:        "string",
-						Description: "The project title. Required for 'create_project' method.",
+						Description: "The project title. Required for 'create_project' method. Optional for 'update_project'.",
+					},
+					"short_description": {
+						Type:        "string",
+						Description: "The project's short description. Used for 'create_project' and 'update_project' methods.",
+					},
+					"public": {
+						Type:        "boolean",
+						Description: "Set to true to make the new project public. Projects are private by default. Used for 'create_project' method.",
+					},
+					"readme": {
+						Type:        "string",
+						Description: "The project's readme (markdown). Used for 'update_project' method.",
+					},
+					"closed": {
+						Type:        "boolean",
+						Description: "Set to true to close the project or false to reopen it. Used for 'update_project' method.",
 					},
 					"item_id": {
 						Type:        "number",
-						Description: "The project item ID. Required for 'delete_project_item'. For 'update_project_item', provide either item_id, or (item_owner + item_repo + issue_number) to resolve the item by issue.",
+						Description: "The project item ID. Required for 'delete_project_item'. For 'update_project_item' and 'set_project_item_status', provide either item_id, or (item_owner + item_repo + issue_number) to resolve the item by issue.",
 					},
 					"item_type": {
 						Type:        "string",
@@ -561,7 +705,26 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 					},
 					"updated_field": {
 						Type:        "object",
-						Description: "Object describing the field to update and its new value. Required for 'update_project_item'. Two shapes are accepted: (1) by ID — {\"id\": 123456, \"value\": \"...\"}; (2) by name — {\"name\": \"Status\", \"value\": \"In Progress\"}. For single-select fields, option-name resolution requires the by-name shape; on the by-ID shape, pass the option ID. Set value to null to clear the field.",
+						Description: "Object describing the field to update and its new value. Required for 'update_project_item' unless updated_fields is given. Two shapes are accepted: (1) by ID — {\"id\": 123456, \"value\": \"...\"}; (2) by name — {\"name\": \"Status\", \"value\": \"In Progress\"}. For single-select fields, pass the option ID or name. The value is checked against the field's data type before updating (NUMBER: number, DATE: YYYY-MM-DD, SINGLE_SELECT: known option); on the by-ID shape you may add \"data_type\" to skip looking up the field. Set value to null to clear the field.",
+					},
+					"updated_fields": {
+						Type:        "array",
+						Description: "Array of field updates to apply to the item in a single request, for example Status, Priority, and Iteration at once. Each entry has the same shape as updated_field. Use instead of updated_field for 'update_project_item'.",
+						Items: &jsonschema.Schema{
+							Type: "object",
+						},
+					},
+					"target_owner": {
+						Type:        "string",
+						Description: "The owner of the repository to link or unlink. Required for 'link_project_to_repository' and 'unlink_project_from_repository' methods.",
+					},
+					"target_repo": {
+						Type:        "string",
+						Description: "The name of the repository to link or unlink. Required for 'link_project_to_repository' and 'unlink_project_from_repository' methods.",
+					},
+					"status_name": {
+						Type:        "string",
+						Description: "The name of the Status option to move the item to (e.g. 'In Progress'), matched case-insensitively. Required for 'set_project_item_status' method.",
 					},
 					"body": {
 						Type:        "string",
@@ -693,35 +856,16 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 
 				return addProjectItem(ctx, gqlClient, owner, ownerType, projectNumber, itemOwner, itemRepo, itemNumber, itemType)
 			case projectsMethodUpdateProjectItem:
-				var itemID int64
-				if _, hasItemID := args["item_id"]; hasItemID {
-					id, err := RequiredBigInt(args, "item_id")
-					if err != nil {
-						return utils.NewToolResultError(err.Error()), nil, nil
-					}
-					itemID = id
-				} else {
-					// Resolve the item by (item_owner, item_repo, issue_number).
-					resolvedItemID, resolveErr := resolveItemIDFromIssueArgs(ctx, gqlClient, owner, ownerType, projectNumber, args)
-					if resolveErr != nil {
-						var structured *ghErrors.StructuredResolutionError
-						if errors.As(resolveErr, &structured) {
-							return ghErrors.NewStructuredResolutionErrorResponse(structured), nil, nil
-						}
-						return utils.NewToolResultError(resolveErr.Error()), nil, nil
-					}
-					itemID = resolvedItemID
+				itemID, errResult := projectItemIDFromArgs(ctx, gqlClient, owner, ownerType, projectNumber, args)
+				if errResult != nil {
+					return errResult, nil, nil
 				}
 
-				rawUpdatedField, exists := args["updated_field"]
-				if !exists {
-					return utils.NewToolResultError("missing required parameter: updated_field"), nil, nil
-				}
-				fieldValue, ok := rawUpdatedField.(map[string]any)
-				if !ok || fieldValue == nil {
-					return utils.NewToolResultError("updated_field must be an object"), nil, nil
+				fieldValues, errResult := updatedFieldsFromArgs(args)
+				if errResult != nil {
+					return errResult, nil, nil
 				}
-				return updateProjectItem(ctx, client, gqlClient, owner, ownerType, projectNumber, itemID, fieldValue)
+				return updateProjectItem(ctx, client, gqlClient, owner, ownerType, projectNumber, itemID, fieldValues)
 			case projectsMethodDeleteProjectItem:
 				itemID, err := RequiredBigInt(args, "item_id")
 				if err != nil {
@@ -748,6 +892,28 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return createProjectStatusUpdate(ctx, gqlClient, owner, ownerType, projectNumber, body, status, startDate, targetDate)
 			case projectsMethodCreateIterationField:
 				return createIterationField(ctx, gqlClient, owner, ownerType, projectNumber, args)
+			case projectsMethodUpdateProject:
+				return updateProject(ctx, gqlClient, owner, ownerType, projectNumber, args)
+			case projectsMethodSetProjectItemStatus:
+				statusName, err := RequiredParam[string](args, "status_name")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				itemID, errResult := projectItemIDFromArgs(ctx, gqlClient, owner, ownerType, projectNumber, args)
+				if errResult != nil {
+					return errResult, nil, nil
+				}
+				return setProjectItemStatus(ctx, client, gqlClient, owner, ownerType, projectNumber, itemID, statusName)
+			case projectsMethodLinkProjectToRepository, projectsMethodUnlinkProjectFromRepo:
+				targetOwner, err := RequiredParam[string](args, "target_owner")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				targetRepo, err := RequiredParam[string](args, "target_repo")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				return linkProjectToRepository(ctx, gqlClient, owner, ownerType, projectNumber, targetOwner, targetRepo, method == projectsMethodUnlinkProjectFromRepo)
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
@@ -779,9 +945,28 @@ func listProjects(ctx context.Context, client *github.Client, args map[string]an
 	}
 
 	// If owner_type not provided, fetch from both user and org
+	autoResolved := false
 	switch ownerType {
 	case "":
 		return listProjectsFromBothOwnerTypes(ctx, client, owner, opts)
+	case projectsOwnerTypeAuto:
+		// Try the org endpoint first and fall back to the user endpoint when
+		// the owner is not an organization.
+		autoResolved = true
+		ownerType = "org"
+		projects, resp, err = client.Projects.ListOrganizationProjects(ctx, owner, opts)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			_ = resp.Body.Close()
+			ownerType = "user"
+			projects, resp, err = client.Projects.ListUserProjects(ctx, owner, opts)
+		}
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx,
+				fmt.Sprintf("failed to list projects for owner '%s': not found as organization or user", owner),
+				resp,
+				err,
+			), nil, nil, nil
+		}
 	case "org":
 		projects, resp, err = client.Projects.ListOrganizationProjects(ctx, owner, opts)
 		if err != nil {
@@ -812,9 +997,12 @@ func listProjects(ctx context.Context, client *github.Client, args map[string]an
 			minimalProjects = append(minimalProjects, *mp)
 		}
 
-		response := map[string]any{
+		response := withEmptyListMessage(map[string]any{
 			"projects": minimalProjects,
-			"pageInfo": buildPageInfo(resp),
+			"pageInfo": buildProjectsPageInfo(resp, opts.PerPage),
+		}, "projects", minimalProjects)
+		if autoResolved {
+			response["resolved_owner_type"] = ownerType
 		}
 
 		r, err := json.Marshal(response)
@@ -864,12 +1052,12 @@ func listProjectsFromBothOwnerTypes(ctx context.Context, client *github.Client,
 		return utils.NewToolResultError(fmt.Sprintf("failed to list projects for owner '%s': not found as user or organization", owner)), nil, nil, nil
 	}
 
-	response := map[string]any{
+	response := withEmptyListMessage(map[string]any{
 		"projects": minimalProjects,
 		"note":     "Results include both user and org projects. Each project includes 'owner_type' field. Pagination is limited when owner_type is not specified - specify 'owner_type' for full pagination support.",
-	}
+	}, "projects", minimalProjects)
 	if resp != nil {
-		response["pageInfo"] = buildPageInfo(resp)
+		response["pageInfo"] = buildProjectsPageInfo(resp, opts.PerPage)
 		defer func() { _ = resp.Body.Close() }()
 	}
 
@@ -925,11 +1113,27 @@ func listProjectFields(ctx context.Context, client *github.Client, args map[stri
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	response := map[string]any{
-		"fields":   projectFields,
-		"pageInfo": buildPageInfo(resp),
+	// Iteration fields additionally carry their configuration in typed form so
+	// that clients can pick an iteration ID by date.
+	type projectFieldWithIterations struct {
+		*github.ProjectV2Field
+		Iterations *ProjectIterationConfiguration `json:"iterations,omitempty"`
+	}
+	now := time.Now()
+	fields := make([]projectFieldWithIterations, 0, len(projectFields))
+	for _, field := range projectFields {
+		entry := projectFieldWithIterations{ProjectV2Field: field}
+		if field.GetDataType() == "iteration" {
+			entry.Iterations = convertToProjectIterationConfiguration(field.Configuration, now)
+		}
+		fields = append(fields, entry)
 	}
 
+	response := withEmptyListMessage(map[string]any{
+		"fields":   fields,
+		"pageInfo": buildProjectsPageInfo(resp, pagination.PerPage),
+	}, "fields", fields)
+
 	r, err := json.Marshal(response)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
@@ -939,48 +1143,163 @@ func listProjectFields(ctx context.Context, client *github.Client, args map[stri
 }
 
 func listProjectItems(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, any, error) {
-	projectNumber, err := RequiredInt(args, "project_number")
+	contentBody, err := projectContentBodyParam(args)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
 
-	queryStr, err := OptionalParam[string](args, "query")
+	fetchAll, err := OptionalBoolParamWithDefault(args, "fetch_all", false)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
 
+	projectItems, info, truncated, errResult := fetchProjectItems(ctx, client, gqlClient, args, owner, ownerType, fetchAll)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	minimalItems := make([]MinimalProjectItem, 0, len(projectItems))
+	for _, item := range projectItems {
+		minimalItem := convertToMinimalProjectItem(item)
+		applyProjectContentBody(&minimalItem, contentBody)
+		minimalItems = append(minimalItems, minimalItem)
+	}
+
+	body := map[string]any{
+		"items":    minimalItems,
+		"pageInfo": info,
+	}
+	if fetchAll {
+		body["truncated"] = truncated
+	}
+	response := withEmptyListMessage(body, "items", minimalItems)
+
+	r, err := json.Marshal(response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// exportProjectItems lists project items flattened into rows keyed by column,
+// with one column per requested field in addition to the item basics.
+func exportProjectItems(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, any, error) {
+	projectItems, info, _, errResult := fetchProjectItems(ctx, client, gqlClient, args, owner, ownerType, false)
+	if errResult != nil {
+		return errResult, nil, nil
+	}
+
+	columns := []string{"id", "content_type", "title", "url"}
+	seen := map[string]bool{}
+	for _, column := range columns {
+		seen[column] = true
+	}
+
+	rows := make([]map[string]any, 0, len(projectItems))
+	for _, projectItem := range projectItems {
+		item := convertToMinimalProjectItem(projectItem)
+		row := map[string]any{
+			"id":           item.ID,
+			"content_type": item.ContentType,
+		}
+		if item.Content != nil {
+			row["title"] = item.Content.Title
+			row["url"] = item.Content.HTMLURL
+		}
+		for _, field := range item.Fields {
+			if !seen[field.Name] {
+				seen[field.Name] = true
+				columns = append(columns, field.Name)
+			}
+			row[field.Name] = flattenProjectFieldValue(field.Value)
+		}
+		rows = append(rows, row)
+	}
+
+	response := map[string]any{
+		"columns":  columns,
+		"rows":     rows,
+		"pageInfo": info,
+	}
+
+	r, err := json.Marshal(response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
+// flattenProjectFieldValue reduces a minimal project field value to a single
+// cell: options and iterations become their names and lists are comma-joined.
+func flattenProjectFieldValue(value any) any {
+	switch v := value.(type) {
+	case minimalProjectOptionValue:
+		return v.Name
+	case minimalProjectIterationValue:
+		return v.Title
+	case minimalProjectPullRequestRef:
+		return v.HTMLURL
+	case []minimalProjectPullRequestRef:
+		urls := make([]string, 0, len(v))
+		for _, ref := range v {
+			urls = append(urls, ref.HTMLURL)
+		}
+		return strings.Join(urls, ", ")
+	case []string:
+		return strings.Join(v, ", ")
+	default:
+		return v
+	}
+}
+
+// fetchProjectItems reads the shared list_project_items arguments and fetches
+// one page of items, or with fetchAll every page up to MaxProjectItemsFetchAll
+// items, reporting whether that cap truncated them. The returned page info
+// describes the last page fetched.
+func fetchProjectItems(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string, fetchAll bool) ([]*github.ProjectV2Item, pageInfo, bool, *mcp.CallToolResult) {
+	projectNumber, err := RequiredInt(args, "project_number")
+	if err != nil {
+		return nil, pageInfo{}, false, utils.NewToolResultError(err.Error())
+	}
+
+	queryStr, err := OptionalParam[string](args, "query")
+	if err != nil {
+		return nil, pageInfo{}, false, utils.NewToolResultError(err.Error())
+	}
+
+	includeArchived, err := OptionalBoolParamWithDefault(args, "include_archived", false)
+	if err != nil {
+		return nil, pageInfo{}, false, utils.NewToolResultError(err.Error())
+	}
+	queryStr = projectItemsArchivedQuery(queryStr, includeArchived)
+
 	fields, err := OptionalBigIntArrayParam(args, "fields")
 	if err != nil {
-		return utils.NewToolResultError(err.Error()), nil, nil
+		return nil, pageInfo{}, false, utils.NewToolResultError(err.Error())
 	}
 
 	fieldNames, err := OptionalStringArrayParam(args, "field_names")
 	if err != nil {
-		return utils.NewToolResultError(err.Error()), nil, nil
+		return nil, pageInfo{}, false, utils.NewToolResultError(err.Error())
 	}
 	if len(fields) > 0 && len(fieldNames) > 0 {
-		return utils.NewToolResultError("provide either 'fields' or 'field_names', not both"), nil, nil
+		return nil, pageInfo{}, false, utils.NewToolResultError("provide either 'fields' or 'field_names', not both")
 	}
 	if len(fieldNames) > 0 {
 		resolvedIDs, resolveErr := resolveFieldNamesToIDs(ctx, gqlClient, owner, ownerType, projectNumber, fieldNames)
 		if resolveErr != nil {
-			var structured *ghErrors.StructuredResolutionError
-			if errors.As(resolveErr, &structured) {
-				return ghErrors.NewStructuredResolutionErrorResponse(structured), nil, nil
-			}
-			return utils.NewToolResultError(resolveErr.Error()), nil, nil
+			return nil, pageInfo{}, false, resolutionErrorResult(resolveErr)
 		}
 		fields = append(fields, resolvedIDs...)
 	}
 
 	pagination, err := extractPaginationOptionsFromArgs(args)
 	if err != nil {
-		return utils.NewToolResultError(err.Error()), nil, nil
+		return nil, pageInfo{}, false, utils.NewToolResultError(err.Error())
 	}
 
-	var resp *github.Response
-	var projectItems []*github.ProjectV2Item
-
 	opts := &github.ListProjectItemsOptions{
 		Fields: fields,
 		ListProjectsOptions: github.ListProjectsOptions{
@@ -989,37 +1308,50 @@ func listProjectItems(ctx context.Context, client *github.Client, gqlClient *git
 		},
 	}
 
-	if ownerType == "org" {
-		projectItems, resp, err = client.Projects.ListOrganizationProjectItems(ctx, owner, projectNumber, opts)
-	} else {
-		projectItems, resp, err = client.Projects.ListUserProjectItems(ctx, owner, projectNumber, opts)
+	fetchPage := func(after string) ([]*github.ProjectV2Item, *github.Response, error) {
+		opts.After = after
+		var projectItems []*github.ProjectV2Item
+		var resp *github.Response
+		var err error
+		if ownerType == "org" {
+			projectItems, resp, err = client.Projects.ListOrganizationProjectItems(ctx, owner, projectNumber, opts)
+		} else {
+			projectItems, resp, err = client.Projects.ListUserProjectItems(ctx, owner, projectNumber, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		return projectItems, resp, nil
 	}
 
+	var projectItems []*github.ProjectV2Item
+	var resp *github.Response
+	var truncated bool
+	if fetchAll {
+		projectItems, resp, truncated, err = fetchAllCursorPages(pagination.After, MaxProjectItemsFetchAll, fetchPage)
+	} else {
+		projectItems, resp, err = fetchPage(pagination.After)
+	}
 	if err != nil {
-		return ghErrors.NewGitHubAPIErrorResponse(ctx,
+		return nil, pageInfo{}, false, ghErrors.NewGitHubAPIErrorResponse(ctx,
 			ProjectListFailedError,
 			resp,
 			err,
-		), nil, nil
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	minimalItems := make([]MinimalProjectItem, 0, len(projectItems))
-	for _, item := range projectItems {
-		minimalItems = append(minimalItems, convertToMinimalProjectItem(item))
+		)
 	}
 
-	response := map[string]any{
-		"items":    minimalItems,
-		"pageInfo": buildPageInfo(resp),
-	}
+	return projectItems, buildProjectsPageInfo(resp, pagination.PerPage), truncated, nil
+}
 
-	r, err := json.Marshal(response)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+// projectItemsArchivedQuery adds the qualifier that excludes archived items to
+// an item query unless archived items are wanted or the query already filters
+// on archival itself.
+func projectItemsArchivedQuery(query string, includeArchived bool) string {
+	if includeArchived || strings.Contains(strings.ToLower(query), "is:archived") {
+		return query
 	}
-
-	return utils.NewToolResultText(string(r)), nil, nil
+	return strings.TrimSpace(query + " -is:archived")
 }
 
 func fetchProjectV2(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int) (*github.ProjectV2, *github.Response, error) {
@@ -1099,7 +1431,7 @@ func getProjectField(ctx context.Context, client *github.Client, owner, ownerTyp
 		}
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get project field", resp, body), nil, nil
 	}
-	r, err := json.Marshal(projectField)
+	r, err := json.Marshal(convertToMinimalProjectField(projectField))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1107,7 +1439,7 @@ func getProjectField(ctx context.Context, client *github.Client, owner, ownerTyp
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64, fields []int64) (*mcp.CallToolResult, any, error) {
+func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64, fields []int64, contentBody string) (*mcp.CallToolResult, any, error) {
 	var resp *github.Response
 	var projectItem *github.ProjectV2Item
 	var opts *github.GetProjectItemOptions
@@ -1142,7 +1474,10 @@ func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get project item", resp, body), nil, nil
 	}
 
-	r, err := json.Marshal(convertToMinimalProjectItem(projectItem))
+	item := convertToMinimalProjectItem(projectItem)
+	applyProjectContentBody(&item, contentBody)
+
+	r, err := json.Marshal(item)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1150,8 +1485,73 @@ func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func updateProjectItem(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, itemID int64, fieldValue map[string]any) (*mcp.CallToolResult, any, error) {
-	updatePayload, err := buildUpdateProjectItem(ctx, gqlClient, owner, ownerType, projectNumber, fieldValue)
+// projectFieldIDFromArgs returns the field_id argument, or resolves
+// field_name to the ID of the project field with that name.
+func projectFieldIDFromArgs(ctx context.Context, deps ToolDependencies, args map[string]any, owner, ownerType string, projectNumber int) (int64, *mcp.CallToolResult) {
+	fieldName, err := OptionalParam[string](args, "field_name")
+	if err != nil {
+		return 0, utils.NewToolResultError(err.Error())
+	}
+	if fieldName == "" {
+		fieldID, err := RequiredBigInt(args, "field_id")
+		if err != nil {
+			return 0, utils.NewToolResultError(err.Error())
+		}
+		return fieldID, nil
+	}
+	if _, ok := args["field_id"]; ok {
+		return 0, utils.NewToolResultError("provide either 'field_id' or 'field_name', not both")
+	}
+
+	gqlClient, err := deps.GetGQLClient(ctx)
+	if err != nil {
+		return 0, utils.NewToolResultError(err.Error())
+	}
+	field, err := resolveProjectFieldByName(ctx, gqlClient, owner, ownerType, projectNumber, fieldName, "")
+	if err != nil {
+		return 0, resolutionErrorResult(err)
+	}
+	fieldID, err := parseInt64(field.ID)
+	if err != nil {
+		return 0, utils.NewToolResultError(fmt.Sprintf("field %q has non-numeric ID %q", field.Name, field.ID))
+	}
+	return fieldID, nil
+}
+
+// projectContentBodyParam reads the content_body mode, defaulting to truncated.
+func projectContentBodyParam(args map[string]any) (string, error) {
+	mode, err := OptionalParam[string](args, "content_body")
+	if err != nil {
+		return "", err
+	}
+	switch mode {
+	case "":
+		return projectContentBodyTruncated, nil
+	case projectContentBodyNone, projectContentBodyTruncated, projectContentBodyFull:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid content_body %q: must be one of %s, %s, %s", mode, projectContentBodyNone, projectContentBodyTruncated, projectContentBodyFull)
+	}
+}
+
+// applyProjectContentBody trims an item's content body according to mode.
+func applyProjectContentBody(item *MinimalProjectItem, mode string) {
+	if item.Content == nil {
+		return
+	}
+	switch mode {
+	case projectContentBodyNone:
+		item.Content.Body = ""
+	case projectContentBodyTruncated:
+		if body := []rune(item.Content.Body); len(body) > projectContentBodyMaxLength {
+			item.Content.Body = string(body[:projectContentBodyMaxLength])
+			item.Content.BodyTruncated = true
+		}
+	}
+}
+
+func updateProjectItem(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, itemID int64, fieldValues []map[string]any) (*mcp.CallToolResult, any, error) {
+	updatePayload, err := buildUpdateProjectItem(ctx, gqlClient, owner, ownerType, projectNumber, fieldValues)
 	if err != nil {
 		var structured *ghErrors.StructuredResolutionError
 		if errors.As(err, &structured) {
@@ -1185,7 +1585,10 @@ func updateProjectItem(ctx context.Context, client *github.Client, gqlClient *gi
 		}
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, ProjectUpdateFailedError, resp, body), nil, nil
 	}
-	r, err := json.Marshal(convertToMinimalProjectItem(updatedItem))
+	item := convertToMinimalProjectItem(updatedItem)
+	applyProjectContentBody(&item, projectContentBodyNone)
+
+	r, err := json.Marshal(item)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 	}
@@ -1193,6 +1596,154 @@ func updateProjectItem(ctx context.Context, client *github.Client, gqlClient *gi
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// projectItemIDFromArgs returns the item_id argument, or resolves the item from
+// (item_owner, item_repo, issue_number) when item_id is not given.
+func projectItemIDFromArgs(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, args map[string]any) (int64, *mcp.CallToolResult) {
+	if _, hasItemID := args["item_id"]; hasItemID {
+		itemID, err := RequiredBigInt(args, "item_id")
+		if err != nil {
+			return 0, utils.NewToolResultError(err.Error())
+		}
+		return itemID, nil
+	}
+
+	itemID, err := resolveItemIDFromIssueArgs(ctx, gqlClient, owner, ownerType, projectNumber, args)
+	if err != nil {
+		return 0, resolutionErrorResult(err)
+	}
+	return itemID, nil
+}
+
+// setProjectItemStatus resolves the project's Status field and the option named
+// statusName, then sets it on the item. Unknown names return a structured error
+// listing the valid options.
+func setProjectItemStatus(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, itemID int64, statusName string) (*mcp.CallToolResult, any, error) {
+	field, err := resolveProjectFieldByName(ctx, gqlClient, owner, ownerType, projectNumber, projectStatusFieldName, "SINGLE_SELECT")
+	if err != nil {
+		return resolutionErrorResult(err), nil, nil
+	}
+	optionID, err := resolveSingleSelectOptionByName(field, statusName)
+	if err != nil {
+		return resolutionErrorResult(err), nil, nil
+	}
+	fieldID, err := parseInt64(field.ID)
+	if err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("resolved field %q has non-numeric ID %q", field.Name, field.ID)), nil, nil
+	}
+
+	return updateProjectItem(ctx, client, gqlClient, owner, ownerType, projectNumber, itemID, []map[string]any{{
+		"id":        fieldID,
+		"data_type": field.DataType,
+		"value":     optionID,
+	}})
+}
+
+// linkProjectToRepository links a project to a repository, or unlinks it when
+// unlink is set, so the project shows up in the repository's Projects tab.
+func linkProjectToRepository(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, targetOwner, targetRepo string, unlink bool) (*mcp.CallToolResult, any, error) {
+	projectID, err := resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	repositoryID, err := getRepositoryID(ctx, gqlClient, targetOwner, targetRepo)
+	if err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("failed to resolve repository %s/%s: %v", targetOwner, targetRepo, err)), nil, nil
+	}
+
+	if unlink {
+		var mutation struct {
+			UnlinkProjectV2FromRepository struct {
+				Repository struct {
+					ID githubv4.ID
+				}
+			} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+		}
+		input := githubv4.UnlinkProjectV2FromRepositoryInput{
+			ProjectID:    projectID,
+			RepositoryID: repositoryID,
+		}
+		if err := mutateWithRetry(ctx, gqlClient, &mutation, input, nil); err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("failed to unlink project from repository: %v", err)), nil, nil
+		}
+		return utils.NewToolResultText(fmt.Sprintf("project %s#%d successfully unlinked from %s/%s", owner, projectNumber, targetOwner, targetRepo)), nil, nil
+	}
+
+	var mutation struct {
+		LinkProjectV2ToRepository struct {
+			Repository struct {
+				ID githubv4.ID
+			}
+		} `graphql:"linkProjectV2ToRepository(input: $input)"`
+	}
+	input := githubv4.LinkProjectV2ToRepositoryInput{
+		ProjectID:    projectID,
+		RepositoryID: repositoryID,
+	}
+	if err := mutateWithRetry(ctx, gqlClient, &mutation, input, nil); err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("failed to link project to repository: %v", err)), nil, nil
+	}
+	return utils.NewToolResultText(fmt.Sprintf("project %s#%d successfully linked to %s/%s", owner, projectNumber, targetOwner, targetRepo)), nil, nil
+}
+
+// projectMutationRetryDelay is the pause before retrying a project mutation
+// that failed with a transient error. It is a variable so tests can shorten it.
+var projectMutationRetryDelay = 500 * time.Millisecond
+
+// transientGraphQLErrorMarkers identify GraphQL failures caused by GitHub being
+// briefly unavailable rather than by the request itself. The GraphQL client
+// only surfaces error messages and HTTP statuses, so they are matched as text.
+var transientGraphQLErrorMarkers = []string{
+	"SERVICE_UNAVAILABLE",
+	"temporarily unavailable",
+	"non-200 OK status code: 502",
+	"non-200 OK status code: 503",
+	"non-200 OK status code: 504",
+}
+
+// isTransientGraphQLError reports whether err is worth retrying. Validation,
+// permission, and not-found errors are permanent and never match.
+func isTransientGraphQLError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, marker := range transientGraphQLErrorMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// mutateWithRetry runs a project mutation, retrying it once after a short
+// backoff when it fails with a transient error. Only use it for mutations that
+// are safe to repeat: a create that reached GitHub before the failure was
+// reported would otherwise run twice.
+func mutateWithRetry(ctx context.Context, gqlClient *githubv4.Client, m any, input githubv4.Input, variables map[string]any) error {
+	err := gqlClient.Mutate(ctx, m, input, variables)
+	if !isTransientGraphQLError(err) {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return err
+	case <-time.After(projectMutationRetryDelay):
+	}
+	return gqlClient.Mutate(ctx, m, input, variables)
+}
+
+// resolutionErrorResult returns the structured body for resolution errors and
+// a plain tool error otherwise.
+func resolutionErrorResult(err error) *mcp.CallToolResult {
+	var structured *ghErrors.StructuredResolutionError
+	if errors.As(err, &structured) {
+		return ghErrors.NewStructuredResolutionErrorResponse(structured)
+	}
+	return utils.NewToolResultError(err.Error())
+}
+
 func deleteProjectItem(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int, itemID int64) (*mcp.CallToolResult, any, error) {
 	var resp *github.Response
 	var err error
@@ -1222,6 +1773,61 @@ func deleteProjectItem(ctx context.Context, client *github.Client, owner, ownerT
 	return utils.NewToolResultText("project item successfully deleted"), nil, nil
 }
 
+// projectNodeIDQueryOrg fetches the node IDs of an organization and one of its projects.
+type projectNodeIDQueryOrg struct {
+	Organization struct {
+		ID        githubv4.ID
+		ProjectV2 struct {
+			ID githubv4.ID
+		} `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+// projectNodeIDQueryUser fetches the node IDs of a user and one of their projects.
+type projectNodeIDQueryUser struct {
+	User struct {
+		ID        githubv4.ID
+		ProjectV2 struct {
+			ID githubv4.ID
+		} `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// resolveProjectAndOwnerNodeIDs resolves (owner, ownerType, projectNumber) to
+// the project's and the owner's node IDs in a single GraphQL query.
+func resolveProjectAndOwnerNodeIDs(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) (projectID, ownerID githubv4.ID, err error) {
+	queryVars := map[string]any{
+		"owner":         githubv4.String(owner),
+		"projectNumber": githubv4.Int(int32(projectNumber)), //nolint:gosec // Project numbers are small integers
+	}
+
+	if ownerType == "org" {
+		var q projectNodeIDQueryOrg
+		if err := gqlClient.Query(ctx, &q, queryVars); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", ProjectResolveIDFailedError, err)
+		}
+		return q.Organization.ProjectV2.ID, q.Organization.ID, nil
+	}
+
+	var q projectNodeIDQueryUser
+	if err := gqlClient.Query(ctx, &q, queryVars); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", ProjectResolveIDFailedError, err)
+	}
+	return q.User.ProjectV2.ID, q.User.ID, nil
+}
+
+func getProjectNodeID(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) (*mcp.CallToolResult, any, error) {
+	projectID, ownerID, err := resolveProjectAndOwnerNodeIDs(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	return MarshalledTextResult(map[string]any{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+	}), nil, nil
+}
+
 // resolveProjectNodeID resolves (owner, ownerType, projectNumber) to a project node ID via GraphQL.
 func resolveProjectNodeID(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) (githubv4.ID, error) {
 	var projectIDQueryUser struct {
@@ -1299,7 +1905,9 @@ func addProjectItem(ctx context.Context, gqlClient *githubv4.Client, owner, owne
 		ContentID: nodeID,
 	}
 
-	err = gqlClient.Mutate(ctx, &mutation, input, nil)
+	// Adding content that is already on the project returns the existing item,
+	// so the mutation is safe to retry.
+	err = mutateWithRetry(ctx, gqlClient, &mutation, input, nil)
 	if err != nil {
 		return utils.NewToolResultError(fmt.Sprintf(ProjectAddFailedError+": %v", err)), nil, nil
 	}
@@ -1413,16 +2021,10 @@ func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, a
 		return utils.NewToolResultError(err.Error()), false, nil, nil
 	}
 
-	perPage, err := OptionalIntParamWithDefault(args, "per_page", MaxProjectsPerPage)
+	perPage, err := projectsPerPage(args)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), false, nil, nil
 	}
-	if perPage > MaxProjectsPerPage {
-		perPage = MaxProjectsPerPage
-	}
-	if perPage < 1 {
-		perPage = MaxProjectsPerPage
-	}
 
 	afterCursor, err := OptionalParam[string](args, "after")
 	if err != nil {
@@ -1469,15 +2071,16 @@ func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, a
 		updates = append(updates, convertToMinimalStatusUpdate(n))
 	}
 
-	response := map[string]any{
+	response := withEmptyListMessage(map[string]any{
 		"statusUpdates": updates,
 		"pageInfo": map[string]any{
 			"hasNextPage":     pi.HasNextPage,
 			"hasPreviousPage": pi.HasPreviousPage,
 			"nextCursor":      string(pi.EndCursor),
 			"prevCursor":      string(pi.StartCursor),
+			"perPage":         perPage,
 		},
-	}
+	}, "statusUpdates", updates)
 
 	r, err := json.Marshal(response)
 	if err != nil {
@@ -1486,6 +2089,71 @@ func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, a
 	return utils.NewToolResultText(string(r)), isPrivate, nil, nil
 }
 
+// listProjectViews lists the views of a project, resolving the project node ID first.
+func listProjectViews(ctx context.Context, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string, projectNumber int) (*mcp.CallToolResult, bool, any, error) {
+	perPage, err := projectsPerPage(args)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), false, nil, nil
+	}
+
+	afterCursor, err := OptionalParam[string](args, "after")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), false, nil, nil
+	}
+
+	projectID, err := resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), false, nil, nil
+	}
+
+	vars := map[string]any{
+		"id":    projectID,
+		"first": githubv4.Int(int32(perPage)), //nolint:gosec // perPage is bounded by MaxProjectsPerPage
+	}
+	if afterCursor != "" {
+		vars["after"] = githubv4.String(afterCursor)
+	} else {
+		vars["after"] = (*githubv4.String)(nil)
+	}
+
+	var q projectViewsNodeQuery
+	if err := gqlClient.Query(ctx, &q, vars); err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("%s: %v", ProjectViewListFailedError, err)), false, nil, nil
+	}
+
+	project := q.Node.ProjectV2
+	views := make([]MinimalProjectView, 0, len(project.Views.Nodes))
+	for _, n := range project.Views.Nodes {
+		layout, ok := projectViewLayouts[string(n.Layout)]
+		if !ok {
+			layout = strings.ToLower(string(n.Layout))
+		}
+		views = append(views, MinimalProjectView{
+			Number: int(n.Number),
+			Name:   string(n.Name),
+			Layout: layout,
+		})
+	}
+
+	pi := project.Views.PageInfo
+	response := withEmptyListMessage(map[string]any{
+		"views": views,
+		"pageInfo": map[string]any{
+			"hasNextPage":     pi.HasNextPage,
+			"hasPreviousPage": pi.HasPreviousPage,
+			"nextCursor":      string(pi.EndCursor),
+			"prevCursor":      string(pi.StartCursor),
+			"perPage":         perPage,
+		},
+	}, "views", views)
+
+	r, err := json.Marshal(response)
+	if err != nil {
+		return nil, false, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return utils.NewToolResultText(string(r)), !bool(project.Public), nil, nil
+}
+
 // getProjectStatusUpdate fetches a single status update by its node ID via GraphQL.
 func getProjectStatusUpdate(ctx context.Context, gqlClient *githubv4.Client, statusUpdateID string) (*mcp.CallToolResult, bool, any, error) {
 	var q statusUpdateNodeQuery
@@ -1530,15 +2198,74 @@ func validateAndConvertToInt64(value any) (int64, error) {
 	}
 }
 
-// buildUpdateProjectItem builds UpdateProjectItemOptions, resolving field names and SINGLE_SELECT option names server-side.
-func buildUpdateProjectItem(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, input map[string]any) (*github.UpdateProjectItemOptions, error) {
+// updatedFieldsFromArgs returns the field updates for update_project_item,
+// taken from either updated_field or the updated_fields array.
+func updatedFieldsFromArgs(args map[string]any) ([]map[string]any, *mcp.CallToolResult) {
+	rawUpdatedField, hasField := args["updated_field"]
+	rawUpdatedFields, hasFields := args["updated_fields"]
+	switch {
+	case hasField && hasFields:
+		return nil, utils.NewToolResultError("provide either updated_field or updated_fields, not both")
+	case hasField:
+		fieldValue, ok := rawUpdatedField.(map[string]any)
+		if !ok || fieldValue == nil {
+			return nil, utils.NewToolResultError("updated_field must be an object")
+		}
+		return []map[string]any{fieldValue}, nil
+	case hasFields:
+		entries, ok := rawUpdatedFields.([]any)
+		if !ok || len(entries) == 0 {
+			return nil, utils.NewToolResultError("updated_fields must be a non-empty array of objects")
+		}
+		fieldValues := make([]map[string]any, 0, len(entries))
+		for i, entry := range entries {
+			fieldValue, ok := entry.(map[string]any)
+			if !ok || fieldValue == nil {
+				return nil, utils.NewToolResultError(fmt.Sprintf("updated_fields[%d] must be an object", i))
+			}
+			fieldValues = append(fieldValues, fieldValue)
+		}
+		return fieldValues, nil
+	default:
+		return nil, utils.NewToolResultError("missing required parameter: updated_field")
+	}
+}
+
+// buildUpdateProjectItem builds UpdateProjectItemOptions with one field update
+// per input, so several fields are changed in a single request.
+func buildUpdateProjectItem(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, inputs []map[string]any) (*github.UpdateProjectItemOptions, error) {
+	payload := &github.UpdateProjectItemOptions{}
+	seen := make(map[int64]bool, len(inputs))
+	for i, input := range inputs {
+		label := "updated_field"
+		if len(inputs) > 1 {
+			label = fmt.Sprintf("updated_fields[%d]", i)
+		}
+		field, err := buildUpdateProjectField(ctx, gqlClient, owner, ownerType, projectNumber, input, label)
+		if err != nil {
+			return nil, err
+		}
+		if seen[field.ID] {
+			return nil, fmt.Errorf("%s: field %d is updated more than once", label, field.ID)
+		}
+		seen[field.ID] = true
+		payload.Fields = append(payload.Fields, field)
+	}
+	return payload, nil
+}
+
+// buildUpdateProjectField builds a single field update, resolving field names
+// and SINGLE_SELECT option names server-side. label names the input in errors.
+func buildUpdateProjectField(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, input map[string]any, label string) (*github.UpdateProjectV2Field, error) {
 	if input == nil {
-		return nil, fmt.Errorf("updated_field must be an object")
+		return nil, fmt.Errorf("%s must be an object", label)
 	}
 
+	// An explicit null is a request to clear the field and is sent as
+	// "value": null; only a missing value key is an error.
 	valueField, hasValue := input["value"]
 	if !hasValue {
-		return nil, fmt.Errorf("updated_field.value is required")
+		return nil, fmt.Errorf("%s.value is required; set it to null to clear the field", label)
 	}
 
 	idField, hasID := input["id"]
@@ -1546,29 +2273,45 @@ func buildUpdateProjectItem(ctx context.Context, gqlClient *githubv4.Client, own
 
 	switch {
 	case hasID && hasName:
-		return nil, fmt.Errorf("updated_field must set either id or name, not both")
+		return nil, fmt.Errorf("%s must set either id or name, not both", label)
 	case !hasID && !hasName:
-		return nil, fmt.Errorf("updated_field requires either id or name")
+		return nil, fmt.Errorf("%s requires either id or name", label)
 	}
 
 	var (
-		fieldID  int64
-		resolved *ResolvedField
+		fieldID   int64
+		resolved  *ResolvedField
+		fromCache bool
 	)
 
 	if hasID {
 		var err error
 		fieldID, err = validateAndConvertToInt64(idField)
 		if err != nil {
-			return nil, fmt.Errorf("updated_field.id: %w", err)
+			return nil, fmt.Errorf("%s.id: %w", label, err)
+		}
+		// Validate against the caller's data_type when given, otherwise against
+		// the cached field metadata. Validation is skipped when the metadata is unavailable.
+		if dataType, ok := input["data_type"].(string); ok && dataType != "" {
+			resolved = &ResolvedField{
+				ID:       strconv.FormatInt(fieldID, 10),
+				Name:     strconv.FormatInt(fieldID, 10),
+				DataType: strings.ToUpper(dataType),
+			}
+		} else if gqlClient != nil {
+			resolved, fromCache, err = lookupProjectFieldByID(ctx, gqlClient, owner, ownerType, projectNumber, fieldID)
+			var structured *ghErrors.StructuredResolutionError
+			if errors.As(err, &structured) {
+				return nil, err
+			}
 		}
 	} else {
 		fieldName, ok := nameField.(string)
 		if !ok || fieldName == "" {
-			return nil, fmt.Errorf("updated_field.name must be a non-empty string")
+			return nil, fmt.Errorf("%s.name must be a non-empty string", label)
 		}
 		if gqlClient == nil {
-			return nil, fmt.Errorf("internal error: gqlClient is required to resolve updated_field.name")
+			return nil, fmt.Errorf("internal error: gqlClient is required to resolve %s.name", label)
 		}
 		var err error
 		resolved, err = resolveProjectFieldByName(ctx, gqlClient, owner, ownerType, projectNumber, fieldName, "")
@@ -1577,49 +2320,124 @@ func buildUpdateProjectItem(ctx context.Context, gqlClient *githubv4.Client, own
 		}
 		parsedID, parseErr := parseInt64(resolved.ID)
 		if parseErr != nil {
-			return nil, fmt.Errorf("resolved field %q has non-numeric ID %q; pass updated_field.id directly", resolved.Name, resolved.ID)
+			return nil, fmt.Errorf("resolved field %q has non-numeric ID %q; pass %s.id directly", resolved.Name, resolved.ID, label)
 		}
 		fieldID = parsedID
 	}
 
-	// SINGLE_SELECT: resolve option name to ID; pass through if it's already a known option ID.
-	if resolved != nil && resolved.DataType == "SINGLE_SELECT" {
-		if str, ok := valueField.(string); ok && str != "" {
-			if optID, optErr := resolveSingleSelectOptionByName(resolved, str); optErr == nil {
-				valueField = optID
-			} else {
-				// Fall back: if the string is already a known option ID, accept it.
-				known := false
-				for _, opt := range resolved.Options {
-					if opt.ID == str {
-						known = true
-						break
-					}
-				}
-				if !known {
-					return nil, optErr
-				}
+	if resolved != nil {
+		value, err := validateProjectFieldValue(resolved, valueField)
+		var structured *ghErrors.StructuredResolutionError
+		if fromCache && errors.As(err, &structured) && structured.Kind == "option_not_found" {
+			// The cached options may predate a newly added one; retry with fresh metadata.
+			invalidateProjectFieldCache(ctx, owner, ownerType, projectNumber)
+			if resolved, _, err = lookupProjectFieldByID(ctx, gqlClient, owner, ownerType, projectNumber, fieldID); err == nil {
+				value, err = validateProjectFieldValue(resolved, valueField)
 			}
 		}
+		if err != nil {
+			return nil, err
+		}
+		valueField = value
 	}
 
-	payload := &github.UpdateProjectItemOptions{
-		Fields: []*github.UpdateProjectV2Field{{
-			ID:    fieldID,
-			Value: valueField,
-		}},
+	return &github.UpdateProjectV2Field{
+		ID:    fieldID,
+		Value: valueField,
+	}, nil
+}
+
+// validateProjectFieldValue checks that value has the shape the field's data
+// type expects and returns the value to send. Numeric strings are converted for
+// NUMBER fields and single-select option names are resolved to option IDs.
+// Data types that cannot be validated client-side pass through unchanged.
+func validateProjectFieldValue(field *ResolvedField, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.DataType {
+	case "NUMBER":
+		switch v := value.(type) {
+		case float64, float32, int, int64, int32:
+			return v, nil
+		case string:
+			if n, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return n, nil
+			}
+		}
+		return nil, fmt.Errorf("field %q is a NUMBER field: value must be a number, got %s", field.Name, describeProjectFieldValue(value))
+	case "DATE":
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %q is a DATE field: value must be a YYYY-MM-DD string, got %s", field.Name, describeProjectFieldValue(value))
+		}
+		if err := validateDateFormat(str, fmt.Sprintf("value for DATE field %q", field.Name)); err != nil {
+			return nil, err
+		}
+		return str, nil
+	case "TEXT", "ITERATION":
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("field %q is a %s field: value must be a string, got %s", field.Name, field.DataType, describeProjectFieldValue(value))
+		}
+		return value, nil
+	case "SINGLE_SELECT":
+		str, ok := value.(string)
+		if !ok || str == "" {
+			return nil, fmt.Errorf("field %q is a SINGLE_SELECT field: value must be an option ID or name, got %s", field.Name, describeProjectFieldValue(value))
+		}
+		if len(field.Options) == 0 {
+			return str, nil
+		}
+		for _, opt := range field.Options {
+			if opt.ID == str {
+				return str, nil
+			}
+		}
+		return resolveSingleSelectOptionByName(field, str)
+	default:
+		return value, nil
 	}
+}
 
-	return payload, nil
+func describeProjectFieldValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case bool:
+		return fmt.Sprintf("boolean %t", v)
+	case float64:
+		return fmt.Sprintf("number %v", v)
+	default:
+		return fmt.Sprintf("%T", v)
+	}
 }
 
-func extractPaginationOptionsFromArgs(args map[string]any) (github.ListProjectsPaginationOptions, error) {
+// projectsPerPage reads per_page, defaulting to and capped at
+// MaxProjectsPerPage. Negative values are rejected rather than sent to GitHub.
+func projectsPerPage(args map[string]any) (int, error) {
 	perPage, err := OptionalIntParamWithDefault(args, "per_page", MaxProjectsPerPage)
 	if err != nil {
-		return github.ListProjectsPaginationOptions{}, err
+		return 0, err
 	}
-	if perPage > MaxProjectsPerPage {
-		perPage = MaxProjectsPerPage
+	if perPage < 0 {
+		return 0, fmt.Errorf("invalid per_page %d: must be between 1 and %d", perPage, MaxProjectsPerPage)
+	}
+	return min(perPage, MaxProjectsPerPage), nil
+}
+
+// buildProjectsPageInfo reports the cursors of resp along with the page size
+// that was actually requested, so clients can see when per_page was capped.
+func buildProjectsPageInfo(resp *github.Response, perPage int) pageInfo {
+	info := buildPageInfo(resp)
+	info.PerPage = perPage
+	return info
+}
+
+func extractPaginationOptionsFromArgs(args map[string]any) (github.ListProjectsPaginationOptions, error) {
+	perPage, err := projectsPerPage(args)
+	if err != nil {
+		return github.ListProjectsPaginationOptions{}, err
 	}
 
 	after, err := OptionalParam[string](args, "after")
@@ -1702,6 +2520,27 @@ func createProject(ctx context.Context, gqlClient *githubv4.Client, owner, owner
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
+	if strings.TrimSpace(title) == "" {
+		return utils.NewToolResultError("title must not be empty"), nil, nil
+	}
+
+	// createProjectV2 only accepts a title, so the remaining settings are
+	// applied with a follow-up updateProjectV2 once the project exists.
+	var settings githubv4.UpdateProjectV2Input
+	shortDescription, ok, err := OptionalParamOK[string](args, "short_description")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if ok {
+		settings.ShortDescription = githubv4.NewString(githubv4.String(shortDescription))
+	}
+	public, ok, err := OptionalParamOK[bool](args, "public")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if ok {
+		settings.Public = githubv4.NewBoolean(githubv4.Boolean(public))
+	}
 
 	ownerID, err := getOwnerNodeID(ctx, gqlClient, owner, ownerType)
 	if err != nil {
@@ -1730,10 +2569,12 @@ func createProject(ctx context.Context, gqlClient *githubv4.Client, owner, owner
 	}
 
 	result := struct {
-		ID     string `json:"id"`
-		Number int    `json:"number"`
-		Title  string `json:"title"`
-		URL    string `json:"url"`
+		ID               string `json:"id"`
+		Number           int    `json:"number"`
+		Title            string `json:"title"`
+		ShortDescription string `json:"short_description,omitempty"`
+		Public           *bool  `json:"public,omitempty"`
+		URL              string `json:"url"`
 	}{
 		ID:     mutation.CreateProjectV2.ProjectV2.ID,
 		Number: mutation.CreateProjectV2.ProjectV2.Number,
@@ -1741,6 +2582,94 @@ func createProject(ctx context.Context, gqlClient *githubv4.Client, owner, owner
 		URL:    mutation.CreateProjectV2.ProjectV2.URL,
 	}
 
+	if settings.ShortDescription != nil || settings.Public != nil {
+		settings.ProjectID = githubv4.ID(result.ID)
+		var update struct {
+			UpdateProjectV2 struct {
+				ProjectV2 struct {
+					ShortDescription string
+					Public           bool
+				}
+			} `graphql:"updateProjectV2(input: $input)"`
+		}
+		if err := mutateWithRetry(ctx, gqlClient, &update, settings, nil); err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("project %d was created at %s but its settings could not be applied: %v", result.Number, result.URL, err)), nil, nil
+		}
+		result.ShortDescription = update.UpdateProjectV2.ProjectV2.ShortDescription
+		result.Public = &update.UpdateProjectV2.ProjectV2.Public
+	}
+
+	return MarshalledTextResult(result), nil, nil
+}
+
+// updateProject handles the update_project method for ProjectsWrite. Only the
+// provided settings are changed.
+func updateProject(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, args map[string]any) (*mcp.CallToolResult, any, error) {
+	input := githubv4.UpdateProjectV2Input{}
+	for param, target := range map[string]**githubv4.String{
+		"title":             &input.Title,
+		"short_description": &input.ShortDescription,
+		"readme":            &input.Readme,
+	} {
+		value, ok, err := OptionalParamOK[string](args, param)
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+		if ok {
+			*target = githubv4.NewString(githubv4.String(value))
+		}
+	}
+	closed, ok, err := OptionalParamOK[bool](args, "closed")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	if ok {
+		input.Closed = githubv4.NewBoolean(githubv4.Boolean(closed))
+	}
+	if input.Title == nil && input.ShortDescription == nil && input.Readme == nil && input.Closed == nil {
+		return utils.NewToolResultError("update_project requires at least one of title, short_description, readme, or closed"), nil, nil
+	}
+
+	projectID, err := resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	input.ProjectID = projectID
+
+	var mutation struct {
+		UpdateProjectV2 struct {
+			ProjectV2 struct {
+				ID               string
+				Number           int
+				Title            string
+				ShortDescription string
+				Closed           bool
+				URL              string
+			}
+		} `graphql:"updateProjectV2(input: $input)"`
+	}
+
+	if err := mutateWithRetry(ctx, gqlClient, &mutation, input, nil); err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("failed to update project: %v", err)), nil, nil
+	}
+
+	project := mutation.UpdateProjectV2.ProjectV2
+	result := struct {
+		ID               string `json:"id"`
+		Number           int    `json:"number"`
+		Title            string `json:"title"`
+		ShortDescription string `json:"short_description,omitempty"`
+		Closed           bool   `json:"closed"`
+		URL              string `json:"url"`
+	}{
+		ID:               project.ID,
+		Number:           project.Number,
+		Title:            project.Title,
+		ShortDescription: project.ShortDescription,
+		Closed:           project.Closed,
+		URL:              project.URL,
+	}
+
 	return MarshalledTextResult(result), nil, nil
 }
 
@@ -1870,7 +2799,7 @@ func createIterationField(ctx context.Context, gqlClient *githubv4.Client, owner
 		IterationConfiguration: &configInput,
 	}
 
-	err = gqlClient.Mutate(ctx, &updateMutation, updateInput, nil)
+	err = mutateWithRetry(ctx, gqlClient, &updateMutation, updateInput, nil)
 	if err != nil {
 		return utils.NewToolResultError(fmt.Sprintf("failed to update iteration configuration: %v", err)), nil, nil
 	}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/muesli/cache2go"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -14,6 +16,15 @@ import (
 // projects in a single round-trip.
 const resolverFieldsPageSize = 100
 
+const (
+	projectFieldCacheName = "project-field-cache"
+	projectFieldCacheTTL  = 5 * time.Minute
+)
+
+// projectFieldCache maps a project to its field metadata so that validating
+// repeated item updates on the same project does not list its fields every time.
+var projectFieldCache = cache2go.Cache(projectFieldCacheName)
+
 // ResolvedFieldOption is one option on a SINGLE_SELECT project field.
 type ResolvedFieldOption struct {
 	ID   string
@@ -150,6 +161,67 @@ func listAllProjectFields(ctx context.Context, gqlClient *githubv4.Client, owner
 	return all, nil
 }
 
+// projectFieldCacheKey scopes a project's cache entry to the caller, since
+// the fields of a private project must not be revealed to other users.
+func projectFieldCacheKey(ctx context.Context, owner, ownerType string, projectNumber int) string {
+	return cacheScope(ctx) + " " + strings.ToLower(fmt.Sprintf("%s/%s/%d", ownerType, owner, projectNumber))
+}
+
+// cachedProjectFields returns the project's fields from projectFieldCache,
+// listing and caching them on a miss. fromCache reports whether the fields
+// may be stale.
+func cachedProjectFields(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) (fields []ResolvedField, fromCache bool, err error) {
+	key := projectFieldCacheKey(ctx, owner, ownerType, projectNumber)
+	if item, err := projectFieldCache.Value(key); err == nil {
+		return item.Data().([]ResolvedField), true, nil
+	}
+
+	fields, err = listAllProjectFields(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return nil, false, err
+	}
+	projectFieldCache.Add(key, projectFieldCacheTTL, fields)
+	return fields, false, nil
+}
+
+// invalidateProjectFieldCache drops the caller's cached field metadata for a
+// project.
+func invalidateProjectFieldCache(ctx context.Context, owner, ownerType string, projectNumber int) {
+	_, _ = projectFieldCache.Delete(projectFieldCacheKey(ctx, owner, ownerType, projectNumber))
+}
+
+// lookupProjectFieldByID finds a field by its numeric ID using the cached field
+// metadata, refreshing it once if the ID is missing in case the field was just added.
+func lookupProjectFieldByID(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, fieldID int64) (field *ResolvedField, fromCache bool, err error) {
+	id := strconv.FormatInt(fieldID, 10)
+	for attempt := 0; attempt < 2; attempt++ {
+		var fields []ResolvedField
+		fields, fromCache, err = cachedProjectFields(ctx, gqlClient, owner, ownerType, projectNumber)
+		if err != nil {
+			return nil, false, err
+		}
+		for i := range fields {
+			if fields[i].ID == id {
+				return &fields[i], fromCache, nil
+			}
+		}
+		if !fromCache {
+			candidates := make([]any, 0, len(fields))
+			for _, f := range fields {
+				candidates = append(candidates, map[string]any{"id": f.ID, "name": f.Name, "data_type": f.DataType})
+			}
+			return nil, false, ghErrors.NewStructuredResolutionError(
+				"field_not_found",
+				id,
+				fmt.Sprintf("no project field with id %s on project %s#%d; see candidates for available fields", id, owner, projectNumber),
+				candidates,
+			)
+		}
+		invalidateProjectFieldCache(ctx, owner, ownerType, projectNumber)
+	}
+	return nil, false, fmt.Errorf("failed to look up project field %s", id)
+}
+
 // resolveProjectFieldByName resolves a field by display name. Returns a
 // structured error on not-found, ambiguous, or wrong-data-type (when
 // expectedDataType is set) so the agent can self-correct.
@@ -366,6 +438,25 @@ func parseInt64(s string) (int64, error) {
 	return strconv.ParseInt(s, 10, 64)
 }
 
+// listAllProjectFieldIDs returns the numeric IDs of every field on a project,
+// listing the fields once so a single item request can ask for all of them.
+func listAllProjectFieldIDs(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) ([]int64, error) {
+	all, err := listAllProjectFields(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(all))
+	for _, f := range all {
+		id, err := parseInt64(f.ID)
+		if err != nil {
+			return nil, fmt.Errorf("field %q has non-numeric ID %q: %w", f.Name, f.ID, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 // resolveFieldNamesToIDs resolves field names to numeric IDs in one GraphQL
 // hop. Fails fast with a structured error on any unresolved or ambiguous name.
 func resolveFieldNamesToIDs(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, names []string) ([]int64, error) {
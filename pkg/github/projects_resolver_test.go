@@ -803,3 +803,378 @@ func Test_ProjectsWrite_UpdateProjectItem_NameNotFound_StructuredError(t *testin
 	assert.Equal(t, "field_not_found", msg["error"])
 	assert.Equal(t, "Doesnt Exist", msg["name"])
 }
+
+func Test_ProjectsWrite_SetProjectItemStatus(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	statusOptions := []map[string]any{
+		{"id": "OPT_todo", "name": "Todo"},
+		{"id": "OPT_in_progress", "name": "In Progress"},
+		{"id": "OPT_done", "name": "Done"},
+	}
+	newFieldsClient := func() *githubv4.Client {
+		return githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectFieldsTestQuery{},
+				fieldsQueryVars("octo-org", 1),
+				githubv4mock.DataResponse(fieldsResponse([]map[string]any{
+					genericFieldNode("PVTF_lADOBBcDeFg100", 100, "Title", "TITLE"),
+					statusFieldNode("PVTSSF_lADOBBcDeFg101", 101, "Status", statusOptions),
+				})),
+			),
+		))
+	}
+
+	t.Run("sets status by option name", func(t *testing.T) {
+		restClient := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchOrgsProjectsV2ItemsByProjectByItemID: expect(t, expectations{
+				path: "/orgs/octo-org/projectsV2/1/items/1001",
+				requestBody: map[string]any{
+					"fields": []any{
+						map[string]any{"id": float64(101), "value": "OPT_in_progress"},
+					},
+				},
+			}).andThen(mockResponse(t, http.StatusOK, verbosePullRequestProjectItemFixture())),
+		}))
+
+		deps := BaseDeps{Client: restClient, GQLClient: newFieldsClient()}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "set_project_item_status",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"status_name":    "in progress",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+	})
+
+	t.Run("unknown status name lists valid options", func(t *testing.T) {
+		restClient := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))
+
+		deps := BaseDeps{Client: restClient, GQLClient: newFieldsClient()}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "set_project_item_status",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"status_name":    "Blocked",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		var msg map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &msg))
+		assert.Equal(t, "option_not_found", msg["error"])
+		assert.Equal(t, "Blocked", msg["name"])
+		assert.ElementsMatch(t, []any{
+			map[string]any{"name": "Todo"},
+			map[string]any{"name": "In Progress"},
+			map[string]any{"name": "Done"},
+		}, msg["candidates"])
+	})
+}
+
+func Test_ProjectsWrite_UpdateProjectItem_ValidatesValueType(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	projectFieldCache.Flush()
+	t.Cleanup(projectFieldCache.Flush)
+
+	countingTransport := &requestCountingTransport{inner: githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			projectFieldsTestQuery{},
+			fieldsQueryVars("validate-org", 1),
+			githubv4mock.DataResponse(fieldsResponse([]map[string]any{
+				genericFieldNode("PVTF_text", 201, "Notes", "TEXT"),
+				genericFieldNode("PVTF_number", 202, "Estimate", "NUMBER"),
+				genericFieldNode("PVTF_date", 203, "Due", "DATE"),
+				statusFieldNode("PVTSSF_status", 204, "Status", []map[string]any{
+					{"id": "OPT_todo", "name": "Todo"},
+					{"id": "OPT_done", "name": "Done"},
+				}),
+			})),
+		),
+	).Transport}
+	gqlClient := githubv4.NewClient(&http.Client{Transport: countingTransport})
+
+	tests := []struct {
+		name           string
+		fieldID        float64
+		value          any
+		expectedValue  any
+		expectedErrMsg string
+	}{
+		{name: "valid text", fieldID: 201, value: "needs design review", expectedValue: "needs design review"},
+		{name: "valid number", fieldID: 202, value: float64(3), expectedValue: float64(3)},
+		{name: "numeric string for number", fieldID: 202, value: "5", expectedValue: float64(5)},
+		{name: "valid date", fieldID: 203, value: "2026-05-01", expectedValue: "2026-05-01"},
+		{name: "valid option id", fieldID: 204, value: "OPT_done", expectedValue: "OPT_done"},
+		{name: "option name resolved to id", fieldID: 204, value: "todo", expectedValue: "OPT_todo"},
+		{name: "clear value", fieldID: 202, value: nil, expectedValue: nil},
+		{
+			name:           "string for number field",
+			fieldID:        202,
+			value:          "three",
+			expectedErrMsg: `field "Estimate" is a NUMBER field: value must be a number, got string "three"`,
+		},
+		{
+			name:           "unparseable date",
+			fieldID:        203,
+			value:          "next friday",
+			expectedErrMsg: `invalid value for DATE field "Due" "next friday": must be YYYY-MM-DD format`,
+		},
+		{
+			name:           "number for text field",
+			fieldID:        201,
+			value:          float64(7),
+			expectedErrMsg: `field "Notes" is a TEXT field: value must be a string, got number 7`,
+		},
+		{
+			name:           "unknown option",
+			fieldID:        204,
+			value:          "Blocked",
+			expectedErrMsg: `"error":"option_not_found"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restClient := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchOrgsProjectsV2ItemsByProjectByItemID: expectRequestBody(t, map[string]any{
+					"fields": []any{
+						map[string]any{"id": tc.fieldID, "value": tc.expectedValue},
+					},
+				}).andThen(mockResponse(t, http.StatusOK, verbosePullRequestProjectItemFixture())),
+			}))
+
+			deps := BaseDeps{Client: restClient, GQLClient: gqlClient}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         "update_project_item",
+				"owner":          "validate-org",
+				"owner_type":     "org",
+				"project_number": float64(1),
+				"item_id":        float64(1001),
+				"updated_field": map[string]any{
+					"id":    tc.fieldID,
+					"value": tc.value,
+				},
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+		})
+	}
+
+	// The unknown option refreshes the metadata once; every other update is
+	// served from the cached field list.
+	assert.Equal(t, 2, countingTransport.count)
+}
+
+func Test_ProjectsWrite_UpdateProjectItem_UnknownFieldID(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	projectFieldCache.Flush()
+	t.Cleanup(projectFieldCache.Flush)
+
+	gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			projectFieldsTestQuery{},
+			fieldsQueryVars("validate-org", 2),
+			githubv4mock.DataResponse(fieldsResponse([]map[string]any{
+				genericFieldNode("PVTF_number", 202, "Estimate", "NUMBER"),
+			})),
+		),
+	))
+
+	deps := BaseDeps{
+		Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		GQLClient: gqlClient,
+	}
+	handler := toolDef.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "update_project_item",
+		"owner":          "validate-org",
+		"owner_type":     "org",
+		"project_number": float64(2),
+		"item_id":        float64(1001),
+		"updated_field": map[string]any{
+			"id":    float64(999),
+			"value": "x",
+		},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+
+	var msg map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &msg))
+	assert.Equal(t, "field_not_found", msg["error"])
+	assert.Equal(t, "999", msg["name"])
+}
+
+func Test_ProjectsGet_GetProjectItem_AllFields(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+
+	gqlHTTP := githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			projectFieldsTestQuery{},
+			fieldsQueryVars("octo-org", 1),
+			githubv4mock.DataResponse(fieldsResponse([]map[string]any{
+				genericFieldNode("PVTF_title", 100, "Title", "TITLE"),
+				statusFieldNode("PVTSSF_status", 200, "Status", nil),
+				genericFieldNode("PVTF_estimate", 300, "Estimate", "NUMBER"),
+			})),
+		),
+	)
+
+	item := map[string]any{
+		"id":           1001,
+		"content_type": "Issue",
+		"content":      map[string]any{"number": 7, "title": "Fix login"},
+		"fields": []map[string]any{
+			{"id": 100, "name": "Title", "data_type": "title", "value": map[string]any{"raw": "Fix login"}},
+			{"id": 200, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "opt1", "name": "Todo"}},
+			{"id": 300, "name": "Estimate", "data_type": "number", "value": 3},
+		},
+	}
+	restHTTP := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetOrgsProjectsV2ItemsByProjectByItemID: expectQueryParams(t, map[string]string{
+			"fields": "100,200,300",
+		}).andThen(mockResponse(t, http.StatusOK, item)),
+	})
+
+	deps := BaseDeps{
+		Client:    mustNewGHClient(t, restHTTP),
+		GQLClient: githubv4.NewClient(gqlHTTP),
+	}
+	handler := toolDef.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"method":         "get_project_item",
+		"owner":          "octo-org",
+		"owner_type":     "org",
+		"project_number": float64(1),
+		"item_id":        float64(1001),
+		"all_fields":     true,
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response MinimalProjectItem
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	names := make([]string, 0, len(response.Fields))
+	for _, field := range response.Fields {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"Title", "Status", "Estimate"}, names)
+
+	t.Run("rejects all_fields with fields", func(t *testing.T) {
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"all_fields":     true,
+			"fields":         []any{"100"},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "'all_fields' cannot be combined")
+	})
+}
+
+func Test_ProjectsGet_GetProjectFieldByName(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+
+	newDeps := func(t *testing.T) BaseDeps {
+		gqlHTTP := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				projectFieldsTestQuery{},
+				fieldsQueryVars("octo-org", 1),
+				githubv4mock.DataResponse(fieldsResponse([]map[string]any{
+					statusFieldNode("PVTSSF_status", 100, "Status", nil),
+					genericFieldNode("PVTF_priority", 200, "Priority", "NUMBER"),
+				})),
+			),
+		)
+		restHTTP := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2FieldsByProjectByFieldID: expectPath(t, "/orgs/octo-org/projectsV2/1/fields/200").andThen(
+				mockResponse(t, http.StatusOK, map[string]any{"id": 200, "name": "Priority", "data_type": "number"}),
+			),
+		})
+		return BaseDeps{
+			Client:    mustNewGHClient(t, restHTTP),
+			GQLClient: githubv4.NewClient(gqlHTTP),
+		}
+	}
+
+	t.Run("resolves the name to the field", func(t *testing.T) {
+		deps := newDeps(t)
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_field",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"field_name":     "priority",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalProjectField
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, int64(200), response.ID)
+		assert.Equal(t, "Priority", response.Name)
+		assert.Equal(t, "number", response.DataType)
+	})
+
+	t.Run("unknown name lists available fields", func(t *testing.T) {
+		deps := newDeps(t)
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_field",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"field_name":     "Estimate",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		var msg struct {
+			Error      string           `json:"error"`
+			Name       string           `json:"name"`
+			Candidates []map[string]any `json:"candidates"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &msg))
+		assert.Equal(t, "field_not_found", msg.Error)
+		assert.Equal(t, "Estimate", msg.Name)
+		names := make([]any, 0, len(msg.Candidates))
+		for _, candidate := range msg.Candidates {
+			names = append(names, candidate["name"])
+		}
+		assert.Equal(t, []any{"Status", "Priority"}, names)
+	})
+}
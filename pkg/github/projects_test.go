@@ -3,7 +3,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"maps"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
@@ -41,12 +44,14 @@ func Test_ProjectsList_ListProjects(t *testing.T) {
 	userProjects := []map[string]any{{"id": 2, "node_id": "NODE2", "title": "User Project"}}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]any
-		expectError    bool
-		expectedErrMsg string
-		expectedLength int
+		name                  string
+		mockedClient          *http.Client
+		requestArgs           map[string]any
+		expectError           bool
+		expectedErrMsg        string
+		expectedLength        int
+		expectedResolvedOwner string
+		expectedMessage       string
 	}{
 		{
 			name: "success organization",
@@ -74,6 +79,49 @@ func Test_ProjectsList_ListProjects(t *testing.T) {
 			expectError:    false,
 			expectedLength: 1,
 		},
+		{
+			name: "auto resolves to org",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsProjectsV2: mockResponse(t, http.StatusOK, orgProjects),
+			}),
+			requestArgs: map[string]any{
+				"method":     "list_projects",
+				"owner":      "octo-org",
+				"owner_type": "auto",
+			},
+			expectError:           false,
+			expectedLength:        1,
+			expectedResolvedOwner: "org",
+		},
+		{
+			name: "auto falls back to user",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsProjectsV2:            mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				GetUsersProjectsV2ByUsername: mockResponse(t, http.StatusOK, userProjects),
+			}),
+			requestArgs: map[string]any{
+				"method":     "list_projects",
+				"owner":      "octocat",
+				"owner_type": "auto",
+			},
+			expectError:           false,
+			expectedLength:        1,
+			expectedResolvedOwner: "user",
+		},
+		{
+			name: "empty list includes message",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsProjectsV2: mockResponse(t, http.StatusOK, []map[string]any{}),
+			}),
+			requestArgs: map[string]any{
+				"method":     "list_projects",
+				"owner":      "octo-org",
+				"owner_type": "org",
+			},
+			expectError:     false,
+			expectedLength:  0,
+			expectedMessage: "No projects matched",
+		},
 		{
 			name:         "missing required parameter method",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
@@ -125,6 +173,19 @@ func Test_ProjectsList_ListProjects(t *testing.T) {
 			projects, ok := response["projects"].([]any)
 			require.True(t, ok)
 			assert.Equal(t, tc.expectedLength, len(projects))
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, response["message"])
+			} else {
+				assert.NotContains(t, response, "message")
+			}
+			if tc.expectedResolvedOwner != "" {
+				assert.Equal(t, tc.expectedResolvedOwner, response["resolved_owner_type"])
+				project, ok := projects[0].(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, tc.expectedResolvedOwner, project["owner_type"])
+			} else {
+				assert.NotContains(t, response, "resolved_owner_type")
+			}
 		})
 	}
 }
@@ -164,6 +225,60 @@ func Test_ProjectsList_ListProjectFields(t *testing.T) {
 		assert.Equal(t, 1, len(fieldsList))
 	})
 
+	t.Run("iteration field configuration", func(t *testing.T) {
+		iterationFields := []map[string]any{
+			fields[0],
+			{
+				"id":        102,
+				"name":      "Sprint",
+				"data_type": "iteration",
+				"configuration": map[string]any{
+					"start_day": 1,
+					"duration":  14,
+					"iterations": []map[string]any{
+						{"id": "it_old", "title": map[string]any{"raw": "Sprint 1"}, "start_date": "2020-01-06", "duration": 14},
+						{"id": "it_next", "title": map[string]any{"raw": "Sprint 99"}, "start_date": "2999-01-06", "duration": 14},
+					},
+				},
+			},
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2FieldsByProject: mockResponse(t, http.StatusOK, iterationFields),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_fields",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Fields []struct {
+				Name          string                         `json:"name"`
+				Configuration map[string]any                 `json:"configuration"`
+				Iterations    *ProjectIterationConfiguration `json:"iterations"`
+			} `json:"fields"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Fields, 2)
+		assert.Nil(t, response.Fields[0].Iterations)
+
+		sprint := response.Fields[1]
+		assert.NotEmpty(t, sprint.Configuration, "raw configuration should be kept")
+		require.NotNil(t, sprint.Iterations)
+		assert.Equal(t, 14, sprint.Iterations.Duration)
+		assert.Equal(t, []ProjectIteration{{ID: "it_next", Title: "Sprint 99", StartDate: "2999-01-06", Duration: 14}}, sprint.Iterations.Iterations)
+		assert.Equal(t, []ProjectIteration{{ID: "it_old", Title: "Sprint 1", StartDate: "2020-01-06", Duration: 14}}, sprint.Iterations.CompletedIterations)
+	})
+
 	t.Run("missing project_number", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -202,7 +317,7 @@ func verbosePullRequestProjectItemFixture() map[string]any {
 			"node_id":    "PR_1",
 			"number":     42,
 			"title":      "Reduce project item output",
-			"body":       "Long pull request body that should not be returned from project item tools.",
+			"body":       "Long pull request body.",
 			"state":      "closed",
 			"html_url":   "https://github.com/cli/cli/pull/42",
 			"url":        "https://api.github.com/repos/cli/cli/pulls/42",
@@ -315,7 +430,6 @@ func assertMinimalPullRequestProjectItem(t *testing.T, rawJSON string, item map[
 	assert.Equal(t, "Done", value["name"])
 	assert.Equal(t, "GREEN", value["color"])
 
-	assert.NotContains(t, rawJSON, `"body"`)
 	assert.NotContains(t, rawJSON, `"archive_url"`)
 	assert.NotContains(t, rawJSON, `"followers_url"`)
 	assert.NotContains(t, rawJSON, `"events_url"`)
@@ -332,6 +446,65 @@ func Test_ProjectsList_ListProjectItems(t *testing.T) {
 
 	items := []map[string]any{verbosePullRequestProjectItemFixture()}
 
+	t.Run("archived items", func(t *testing.T) {
+		archivedItem := verbosePullRequestProjectItemFixture()
+		archivedItem["archived_at"] = "2026-05-01T12:00:00Z"
+
+		tests := []struct {
+			name            string
+			args            map[string]any
+			expectedQuery   string
+			expectedArchive bool
+		}{
+			{
+				name:          "excluded by default",
+				args:          map[string]any{"query": "is:issue"},
+				expectedQuery: "is:issue -is:archived",
+			},
+			{
+				name:            "included when requested",
+				args:            map[string]any{"query": "is:issue", "include_archived": true},
+				expectedQuery:   "is:issue",
+				expectedArchive: true,
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				response := []map[string]any{verbosePullRequestProjectItemFixture()}
+				if tc.expectedArchive {
+					response = []map[string]any{archivedItem}
+				}
+				mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetOrgsProjectsV2ItemsByProject: expectQueryParams(t, map[string]string{
+						"q":        tc.expectedQuery,
+						"per_page": "50",
+					}).andThen(mockResponse(t, http.StatusOK, response)),
+				})
+
+				deps := BaseDeps{
+					Client: mustNewGHClient(t, mockedClient),
+				}
+				handler := toolDef.Handler(deps)
+				args := map[string]any{
+					"method":         "list_project_items",
+					"owner":          "octo-org",
+					"owner_type":     "org",
+					"project_number": float64(1),
+				}
+				maps.Copy(args, tc.args)
+				request := createMCPRequest(args)
+				result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+				require.NoError(t, err)
+				require.False(t, result.IsError, getTextResult(t, result).Text)
+				if tc.expectedArchive {
+					assert.Contains(t, getTextResult(t, result).Text, `"archived_at":"2026-05-01T12:00:00Z"`)
+				}
+			})
+		}
+	})
+
 	t.Run("success organization", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
 			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, items),
@@ -363,6 +536,176 @@ func Test_ProjectsList_ListProjectItems(t *testing.T) {
 		item, ok := itemsList[0].(map[string]any)
 		require.True(t, ok)
 		assertMinimalPullRequestProjectItem(t, textContent.Text, item)
+		content := item["content"].(map[string]any)
+		assert.Equal(t, "Long pull request body.", content["body"])
+		assert.NotContains(t, content, "body_truncated")
+	})
+
+	t.Run("content_body truncates long bodies", func(t *testing.T) {
+		longItem := verbosePullRequestProjectItemFixture()
+		longItem["content"].(map[string]any)["body"] = strings.Repeat("é", projectContentBodyMaxLength+100)
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, []map[string]any{longItem}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"content_body":   "truncated",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			Items []MinimalProjectItem `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 1)
+		content := response.Items[0].Content
+		assert.Equal(t, strings.Repeat("é", projectContentBodyMaxLength), content.Body)
+		assert.True(t, content.BodyTruncated)
+	})
+
+	t.Run("content_body none omits the body", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, items),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"content_body":   "none",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.NotContains(t, getTextResult(t, result).Text, `"body"`)
+	})
+
+	t.Run("rejects invalid content_body", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"content_body":   "summary",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `invalid content_body "summary"`)
+	})
+
+	t.Run("per_page above the maximum is capped and reported", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: expectQueryParams(t, map[string]string{
+				"q":        "-is:archived",
+				"per_page": "50",
+			}).andThen(mockResponse(t, http.StatusOK, items)),
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"per_page":       float64(100),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response struct {
+			PageInfo pageInfo `json:"pageInfo"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, MaxProjectsPerPage, response.PageInfo.PerPage)
+	})
+
+	t.Run("rejects negative per_page", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"per_page":       float64(-5),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "invalid per_page -5: must be between 1 and 50")
+	})
+
+	t.Run("fetch_all follows cursors", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProject: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("after") == "" {
+					w.Header().Set("Link", `<https://api.github.com/orgs/octo-org/projectsV2/1/items?after=page2&per_page=50>; rel="next"`)
+				}
+				mockResponse(t, http.StatusOK, items)(w, r)
+			},
+		})
+
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "list_project_items",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"fetch_all":      true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response struct {
+			Items     []MinimalProjectItem `json:"items"`
+			PageInfo  pageInfo             `json:"pageInfo"`
+			Truncated bool                 `json:"truncated"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Len(t, response.Items, 2)
+		assert.False(t, response.PageInfo.HasNextPage)
+		assert.False(t, response.Truncated)
 	})
 
 	t.Run("rejects fields and field_names together", func(t *testing.T) {
@@ -389,6 +732,87 @@ func Test_ProjectsList_ListProjectItems(t *testing.T) {
 	})
 }
 
+func Test_ProjectsList_ExportProjectItems(t *testing.T) {
+	toolDef := ProjectsList(translations.NullTranslationHelper)
+
+	items := []map[string]any{
+		{
+			"id":           1001,
+			"content_type": "Issue",
+			"content": map[string]any{
+				"number":   1,
+				"title":    "First issue",
+				"html_url": "https://github.com/octo-org/repo/issues/1",
+			},
+			"fields": []map[string]any{
+				{"id": 101, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "OPT_todo", "name": map[string]any{"raw": "Todo"}, "color": "GRAY"}},
+				{"id": 102, "name": "Estimate", "data_type": "number", "value": 3},
+			},
+		},
+		{
+			"id":           1002,
+			"content_type": "Issue",
+			"content": map[string]any{
+				"number":   2,
+				"title":    "Second issue",
+				"html_url": "https://github.com/octo-org/repo/issues/2",
+			},
+			"fields": []map[string]any{
+				{"id": 101, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "OPT_done", "name": map[string]any{"raw": "Done"}, "color": "GREEN"}},
+				{"id": 102, "name": "Estimate", "data_type": "number", "value": 5},
+			},
+		},
+	}
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetOrgsProjectsV2ItemsByProject: expectQueryParams(t, map[string]string{
+			"fields":   "101,102",
+			"per_page": "50",
+			"q":        "-is:archived",
+		}).andThen(mockResponse(t, http.StatusOK, items)),
+	})
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, mockedClient),
+	}
+	handler := toolDef.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "export_project_items",
+		"owner":          "octo-org",
+		"owner_type":     "org",
+		"project_number": float64(1),
+		"fields":         []any{"101", "102"},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		Columns []string         `json:"columns"`
+		Rows    []map[string]any `json:"rows"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []string{"id", "content_type", "title", "url", "Status", "Estimate"}, response.Columns)
+	assert.Equal(t, []map[string]any{
+		{
+			"id":           float64(1001),
+			"content_type": "Issue",
+			"title":        "First issue",
+			"url":          "https://github.com/octo-org/repo/issues/1",
+			"Status":       "Todo",
+			"Estimate":     float64(3),
+		},
+		{
+			"id":           float64(1002),
+			"content_type": "Issue",
+			"title":        "Second issue",
+			"url":          "https://github.com/octo-org/repo/issues/2",
+			"Status":       "Done",
+			"Estimate":     float64(5),
+		},
+	}, response.Rows)
+}
+
 func Test_detectOwnerType(t *testing.T) {
 	t.Run("uses organization account type", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -651,6 +1075,64 @@ func Test_ProjectsGet_GetProject(t *testing.T) {
 	})
 }
 
+func Test_ProjectsGet_GetProjectNodeID(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+	vars := map[string]any{
+		"owner":         githubv4.String("octo-org"),
+		"projectNumber": githubv4.Int(1),
+	}
+
+	tests := []struct {
+		name      string
+		ownerType string
+		matcher   githubv4mock.Matcher
+	}{
+		{
+			name:      "organization",
+			ownerType: "org",
+			matcher: githubv4mock.NewQueryMatcher(projectNodeIDQueryOrg{}, vars, githubv4mock.DataResponse(map[string]any{
+				"organization": map[string]any{
+					"id":        "O_owner",
+					"projectV2": map[string]any{"id": "PVT_project"},
+				},
+			})),
+		},
+		{
+			name:      "user",
+			ownerType: "user",
+			matcher: githubv4mock.NewQueryMatcher(projectNodeIDQueryUser{}, vars, githubv4mock.DataResponse(map[string]any{
+				"user": map[string]any{
+					"id":        "O_owner",
+					"projectV2": map[string]any{"id": "PVT_project"},
+				},
+			})),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(tc.matcher)),
+			}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         "get_project_node_id",
+				"owner":          "octo-org",
+				"owner_type":     tc.ownerType,
+				"project_number": float64(1),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, map[string]any{"project_id": "PVT_project", "owner_id": "O_owner"}, response)
+		})
+	}
+}
+
 func Test_ProjectsGet_IFC_InsidersMode(t *testing.T) {
 	toolDef := ProjectsGet(translations.NullTranslationHelper)
 
@@ -758,6 +1240,44 @@ func Test_ProjectsGet_GetProjectField(t *testing.T) {
 		assert.NotNil(t, response["id"])
 	})
 
+	t.Run("single select options are returned with ids", func(t *testing.T) {
+		singleSelectField := map[string]any{
+			"id":        102,
+			"name":      "Status",
+			"data_type": "single_select",
+			"options": []map[string]any{
+				{"id": "f75ad846", "name": map[string]any{"raw": "Todo", "html": "Todo"}, "color": "GRAY"},
+				{"id": "47fc9ee4", "name": map[string]any{"raw": "In Progress", "html": "In Progress"}, "color": "YELLOW", "description": map[string]any{"raw": "Being worked on"}},
+			},
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2FieldsByProjectByFieldID: mockResponse(t, http.StatusOK, singleSelectField),
+		})
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_field",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"field_id":       float64(102),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response MinimalProjectField
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "single_select", response.DataType)
+		assert.Equal(t, []MinimalProjectFieldOption{
+			{ID: "f75ad846", Name: "Todo", Color: "GRAY"},
+			{ID: "47fc9ee4", Name: "In Progress", Color: "YELLOW", Description: "Being worked on"},
+		}, response.Options)
+	})
+
 	t.Run("missing field_id", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -812,10 +1332,36 @@ func Test_ProjectsGet_GetProjectItem(t *testing.T) {
 		err = json.Unmarshal([]byte(textContent.Text), &response)
 		require.NoError(t, err)
 		assertMinimalPullRequestProjectItem(t, textContent.Text, response)
+		assert.Contains(t, textContent.Text, `"body":"Long pull request body.`)
 	})
 
-	t.Run("missing item_id", func(t *testing.T) {
-		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
+	t.Run("content_body none omits the body", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, item),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "get_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"content_body":   "none",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.NotContains(t, getTextResult(t, result).Text, `"body"`)
+	})
+
+	t.Run("missing item_id", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
 		deps := BaseDeps{
 			Client: client,
@@ -1194,6 +1740,103 @@ func Test_ProjectsWrite_UpdateProjectItem(t *testing.T) {
 		require.NoError(t, err)
 		require.False(t, result.IsError)
 
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assertMinimalPullRequestProjectItem(t, textContent.Text, response)
+		assert.NotContains(t, textContent.Text, `"body"`)
+	})
+
+	t.Run("null value clears the field", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchOrgsProjectsV2ItemsByProjectByItemID: expectRequestBody(t, map[string]any{
+				"fields": []any{
+					map[string]any{"id": float64(101), "value": nil},
+				},
+			}).andThen(mockResponse(t, http.StatusOK, updatedItem)),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"updated_field": map[string]any{
+				"id":    float64(101),
+				"value": nil,
+			},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+	})
+
+	t.Run("missing value key", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"updated_field": map[string]any{
+				"id": float64(101),
+			},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		textContent := getTextResult(t, result)
+		assert.Contains(t, textContent.Text, "updated_field.value is required")
+	})
+
+	t.Run("updated_fields applies several fields in one request", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchOrgsProjectsV2ItemsByProjectByItemID: expectRequestBody(t, map[string]any{
+				"fields": []any{
+					map[string]any{"id": float64(101), "value": "OPT_in_progress"},
+					map[string]any{"id": float64(102), "value": float64(2)},
+					map[string]any{"id": float64(103), "value": "ITER_1"},
+				},
+			}).andThen(mockResponse(t, http.StatusOK, updatedItem)),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project_item",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(1),
+			"item_id":        float64(1001),
+			"updated_fields": []any{
+				map[string]any{"id": float64(101), "value": "OPT_in_progress"},
+				map[string]any{"id": float64(102), "value": float64(2)},
+				map[string]any{"id": float64(103), "value": "ITER_1"},
+			},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
 		textContent := getTextResult(t, result)
 		var response map[string]any
 		err = json.Unmarshal([]byte(textContent.Text), &response)
@@ -1201,6 +1844,76 @@ func Test_ProjectsWrite_UpdateProjectItem(t *testing.T) {
 		assertMinimalPullRequestProjectItem(t, textContent.Text, response)
 	})
 
+	t.Run("updated_fields validation", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			args           map[string]any
+			expectedErrMsg string
+		}{
+			{
+				name: "entry without value",
+				args: map[string]any{"updated_fields": []any{
+					map[string]any{"id": float64(101), "value": "OPT_done"},
+					map[string]any{"id": float64(102)},
+				}},
+				expectedErrMsg: "updated_fields[1].value is required",
+			},
+			{
+				name: "entry without id or name",
+				args: map[string]any{"updated_fields": []any{
+					map[string]any{"value": "OPT_done"},
+					map[string]any{"id": float64(102), "value": float64(2)},
+				}},
+				expectedErrMsg: "updated_fields[0] requires either id or name",
+			},
+			{
+				name: "same field twice",
+				args: map[string]any{"updated_fields": []any{
+					map[string]any{"id": float64(101), "value": "OPT_todo"},
+					map[string]any{"id": float64(101), "value": "OPT_done"},
+				}},
+				expectedErrMsg: "field 101 is updated more than once",
+			},
+			{
+				name:           "empty array",
+				args:           map[string]any{"updated_fields": []any{}},
+				expectedErrMsg: "updated_fields must be a non-empty array of objects",
+			},
+			{
+				name: "both updated_field and updated_fields",
+				args: map[string]any{
+					"updated_field":  map[string]any{"id": float64(101), "value": "OPT_done"},
+					"updated_fields": []any{map[string]any{"id": float64(102), "value": float64(2)}},
+				},
+				expectedErrMsg: "provide either updated_field or updated_fields, not both",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))
+				deps := BaseDeps{
+					Client: client,
+				}
+				handler := toolDef.Handler(deps)
+				args := map[string]any{
+					"method":         "update_project_item",
+					"owner":          "octo-org",
+					"owner_type":     "org",
+					"project_number": float64(1),
+					"item_id":        float64(1001),
+				}
+				maps.Copy(args, tc.args)
+				request := createMCPRequest(args)
+				result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+			})
+		}
+	})
+
 	t.Run("missing updated_field", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})
 		client := mustNewGHClient(t, mockedClient)
@@ -1585,4 +2298,477 @@ func Test_ProjectsWrite_CreateProjectStatusUpdate(t *testing.T) {
 		assert.Equal(t, "Consolidated test", response["body"])
 		assert.Equal(t, "AT_RISK", response["status"])
 	})
+
+	t.Run("org project with dates", func(t *testing.T) {
+		statusStr := githubv4.String("ON_TRACK")
+		startDate := githubv4.String("2026-03-01")
+		targetDate := githubv4.String("2026-04-15")
+
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Organization struct {
+						ProjectV2 struct {
+							ID githubv4.ID
+						} `graphql:"projectV2(number: $projectNumber)"`
+					} `graphql:"organization(login: $owner)"`
+				}{},
+				map[string]any{
+					"owner":         githubv4.String("octo-org"),
+					"projectNumber": githubv4.Int(7),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"organization": map[string]any{
+						"projectV2": map[string]any{
+							"id": "PVT_project7",
+						},
+					},
+				}),
+			),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					CreateProjectV2StatusUpdate struct {
+						StatusUpdate statusUpdateNode
+					} `graphql:"createProjectV2StatusUpdate(input: $input)"`
+				}{},
+				CreateProjectV2StatusUpdateInput{
+					ProjectID:  githubv4.ID("PVT_project7"),
+					Status:     &statusStr,
+					StartDate:  &startDate,
+					TargetDate: &targetDate,
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"createProjectV2StatusUpdate": map[string]any{
+						"statusUpdate": map[string]any{
+							"id":         "PVTSU_su007",
+							"status":     "ON_TRACK",
+							"createdAt":  "2026-03-01T09:00:00Z",
+							"startDate":  "2026-03-01",
+							"targetDate": "2026-04-15",
+							"creator":    map[string]any{"login": "octocat"},
+						},
+					},
+				}),
+			),
+		)
+
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(gqlMockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "create_project_status_update",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(7),
+			"status":         "ON_TRACK",
+			"start_date":     "2026-03-01",
+			"target_date":    "2026-04-15",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalProjectStatusUpdate
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "PVTSU_su007", response.ID)
+		assert.Equal(t, "2026-03-01", response.StartDate)
+		assert.Equal(t, "2026-04-15", response.TargetDate)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "create_project_status_update",
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(7),
+			"status":         "DONE",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `invalid status "DONE"`)
+	})
+}
+
+func Test_ProjectsList_ListProjectViews(t *testing.T) {
+	toolDef := ProjectsList(translations.NullTranslationHelper)
+
+	var resolveQuery struct {
+		Organization struct {
+			ProjectV2 struct {
+				ID githubv4.ID
+			} `graphql:"projectV2(number: $projectNumber)"`
+		} `graphql:"organization(login: $owner)"`
+	}
+
+	gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			resolveQuery,
+			map[string]any{
+				"owner":         githubv4.String("octo-org"),
+				"projectNumber": githubv4.Int(3),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"organization": map[string]any{
+					"projectV2": map[string]any{"id": "PVT_project3"},
+				},
+			}),
+		),
+		githubv4mock.NewQueryMatcher(
+			projectViewsNodeQuery{},
+			map[string]any{
+				"id":    githubv4.ID("PVT_project3"),
+				"first": githubv4.Int(50),
+				"after": (*githubv4.String)(nil),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"node": map[string]any{
+					"public": true,
+					"views": map[string]any{
+						"nodes": []map[string]any{
+							{"number": 1, "name": "Backlog", "layout": "TABLE_LAYOUT"},
+							{"number": 2, "name": "Sprint board", "layout": "BOARD_LAYOUT"},
+						},
+						"pageInfo": map[string]any{
+							"hasNextPage":     false,
+							"hasPreviousPage": false,
+							"startCursor":     "",
+							"endCursor":       "",
+						},
+					},
+				},
+			}),
+		),
+	)
+
+	deps := BaseDeps{
+		Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		GQLClient: githubv4.NewClient(gqlMockedClient),
+	}
+	handler := toolDef.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "list_project_views",
+		"owner":          "octo-org",
+		"owner_type":     "org",
+		"project_number": float64(3),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		Views []MinimalProjectView `json:"views"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []MinimalProjectView{
+		{Number: 1, Name: "Backlog", Layout: "table"},
+		{Number: 2, Name: "Sprint board", Layout: "board"},
+	}, response.Views)
+}
+
+func Test_ProjectsWrite_LinkProjectToRepository(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	newMatchers := func(mutation githubv4mock.Matcher) []githubv4mock.Matcher {
+		return []githubv4mock.Matcher{
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Organization struct {
+						ProjectV2 struct {
+							ID githubv4.ID
+						} `graphql:"projectV2(number: $projectNumber)"`
+					} `graphql:"organization(login: $owner)"`
+				}{},
+				map[string]any{
+					"owner":         githubv4.String("octo-org"),
+					"projectNumber": githubv4.Int(4),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"organization": map[string]any{
+						"projectV2": map[string]any{"id": "PVT_project4"},
+					},
+				}),
+			),
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Repository struct {
+						ID githubv4.ID
+					} `graphql:"repository(owner: $owner, name: $repo)"`
+				}{},
+				map[string]any{
+					"owner": githubv4.String("octo-org"),
+					"repo":  githubv4.String("octo-repo"),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{"id": "R_repo1"},
+				}),
+			),
+			mutation,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		method          string
+		mutation        githubv4mock.Matcher
+		expectedMessage string
+	}{
+		{
+			name:   "link",
+			method: "link_project_to_repository",
+			mutation: githubv4mock.NewMutationMatcher(
+				struct {
+					LinkProjectV2ToRepository struct {
+						Repository struct {
+							ID githubv4.ID
+						}
+					} `graphql:"linkProjectV2ToRepository(input: $input)"`
+				}{},
+				githubv4.LinkProjectV2ToRepositoryInput{
+					ProjectID:    githubv4.ID("PVT_project4"),
+					RepositoryID: githubv4.ID("R_repo1"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"linkProjectV2ToRepository": map[string]any{
+						"repository": map[string]any{"id": "R_repo1"},
+					},
+				}),
+			),
+			expectedMessage: "project octo-org#4 successfully linked to octo-org/octo-repo",
+		},
+		{
+			name:   "unlink",
+			method: "unlink_project_from_repository",
+			mutation: githubv4mock.NewMutationMatcher(
+				struct {
+					UnlinkProjectV2FromRepository struct {
+						Repository struct {
+							ID githubv4.ID
+						}
+					} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+				}{},
+				githubv4.UnlinkProjectV2FromRepositoryInput{
+					ProjectID:    githubv4.ID("PVT_project4"),
+					RepositoryID: githubv4.ID("R_repo1"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"unlinkProjectV2FromRepository": map[string]any{
+						"repository": map[string]any{"id": "R_repo1"},
+					},
+				}),
+			),
+			expectedMessage: "project octo-org#4 successfully unlinked from octo-org/octo-repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(newMatchers(tc.mutation)...)),
+			}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         tc.method,
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(4),
+				"target_owner":   "octo-org",
+				"target_repo":    "octo-repo",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, tc.expectedMessage, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ProjectsWrite_UpdateProject(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	t.Run("closes project and updates title", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				struct {
+					User struct {
+						ProjectV2 struct {
+							ID githubv4.ID
+						} `graphql:"projectV2(number: $projectNumber)"`
+					} `graphql:"user(login: $owner)"`
+				}{},
+				map[string]any{
+					"owner":         githubv4.String("octocat"),
+					"projectNumber": githubv4.Int(2),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"user": map[string]any{
+						"projectV2": map[string]any{"id": "PVT_project2"},
+					},
+				}),
+			),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					UpdateProjectV2 struct {
+						ProjectV2 struct {
+							ID               string
+							Number           int
+							Title            string
+							ShortDescription string
+							Closed           bool
+							URL              string
+						}
+					} `graphql:"updateProjectV2(input: $input)"`
+				}{},
+				githubv4.UpdateProjectV2Input{
+					ProjectID: githubv4.ID("PVT_project2"),
+					Title:     githubv4.NewString("Q1 Roadmap (archived)"),
+					Closed:    githubv4.NewBoolean(true),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"updateProjectV2": map[string]any{
+						"projectV2": map[string]any{
+							"id":               "PVT_project2",
+							"number":           2,
+							"title":            "Q1 Roadmap (archived)",
+							"shortDescription": "",
+							"closed":           true,
+							"url":              "https://github.com/users/octocat/projects/2",
+						},
+					},
+				}),
+			),
+		)
+
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(gqlMockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project",
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(2),
+			"title":          "Q1 Roadmap (archived)",
+			"closed":         true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "PVT_project2", response["id"])
+		assert.Equal(t, "Q1 Roadmap (archived)", response["title"])
+		assert.Equal(t, true, response["closed"])
+	})
+
+	t.Run("requires a setting to update", func(t *testing.T) {
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "update_project",
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(2),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "update_project requires at least one of")
+	})
+}
+
+// flakyTransport fails the first failures requests with 503 Service Unavailable
+// before handing requests to next.
+type flakyTransport struct {
+	next     http.RoundTripper
+	failures int
+	calls    int
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Status:     "503 Service Unavailable",
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+	return f.next.RoundTrip(req)
+}
+
+func Test_mutateWithRetry(t *testing.T) {
+	restoreDelay := projectMutationRetryDelay
+	projectMutationRetryDelay = 0
+	t.Cleanup(func() { projectMutationRetryDelay = restoreDelay })
+
+	type addItemMutation struct {
+		AddProjectV2ItemByID struct {
+			Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addProjectV2ItemById(input: $input)"`
+	}
+	input := githubv4.AddProjectV2ItemByIdInput{
+		ProjectID: githubv4.ID("PVT_project1"),
+		ContentID: githubv4.ID("I_issue123"),
+	}
+	newClient := func(response githubv4mock.GQLResponse, failures int) (*githubv4.Client, *flakyTransport) {
+		mocked := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(addItemMutation{}, input, nil, response),
+		)
+		transport := &flakyTransport{next: mocked.Transport, failures: failures}
+		mocked.Transport = transport
+		return githubv4.NewClient(mocked), transport
+	}
+	success := githubv4mock.DataResponse(map[string]any{
+		"addProjectV2ItemById": map[string]any{
+			"item": map[string]any{"id": "PVTI_item1"},
+		},
+	})
+
+	t.Run("transient error retries and succeeds", func(t *testing.T) {
+		client, transport := newClient(success, 1)
+		var mutation addItemMutation
+		require.NoError(t, mutateWithRetry(context.Background(), client, &mutation, input, nil))
+		assert.Equal(t, 2, transport.calls)
+		assert.Equal(t, githubv4.ID("PVTI_item1"), mutation.AddProjectV2ItemByID.Item.ID)
+	})
+
+	t.Run("retries only once", func(t *testing.T) {
+		client, transport := newClient(githubv4mock.ErrorResponse("SERVICE_UNAVAILABLE: try again later"), 0)
+		var mutation addItemMutation
+		err := mutateWithRetry(context.Background(), client, &mutation, input, nil)
+		require.Error(t, err)
+		assert.Equal(t, 2, transport.calls)
+	})
+
+	t.Run("validation error does not retry", func(t *testing.T) {
+		client, transport := newClient(githubv4mock.ErrorResponse("Could not resolve to a node with the global id of 'I_issue123'"), 0)
+		var mutation addItemMutation
+		err := mutateWithRetry(context.Background(), client, &mutation, input, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Could not resolve to a node")
+		assert.Equal(t, 1, transport.calls)
+	})
 }
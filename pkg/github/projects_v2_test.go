@@ -93,6 +93,130 @@ func Test_ProjectsWrite_CreateProject(t *testing.T) {
 		assert.Equal(t, "https://github.com/users/octocat/projects/1", response["url"])
 	})
 
+	t.Run("applies short description and visibility", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				struct {
+					Organization struct {
+						ID string
+					} `graphql:"organization(login: $login)"`
+				}{},
+				map[string]any{
+					"login": githubv4.String("octo-org"),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"organization": map[string]any{
+						"id": "O_octoorg",
+					},
+				}),
+			),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					CreateProjectV2 struct {
+						ProjectV2 struct {
+							ID     string
+							Number int
+							Title  string
+							URL    string
+						}
+					} `graphql:"createProjectV2(input: $input)"`
+				}{},
+				githubv4.CreateProjectV2Input{
+					OwnerID: githubv4.ID("O_octoorg"),
+					Title:   githubv4.String("Roadmap"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"createProjectV2": map[string]any{
+						"projectV2": map[string]any{
+							"id":     "PVT_roadmap",
+							"number": 7,
+							"title":  "Roadmap",
+							"url":    "https://github.com/orgs/octo-org/projects/7",
+						},
+					},
+				}),
+			),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					UpdateProjectV2 struct {
+						ProjectV2 struct {
+							ShortDescription string
+							Public           bool
+						}
+					} `graphql:"updateProjectV2(input: $input)"`
+				}{},
+				githubv4.UpdateProjectV2Input{
+					ProjectID:        githubv4.ID("PVT_roadmap"),
+					ShortDescription: githubv4.NewString("Quarterly plan"),
+					Public:           githubv4.NewBoolean(true),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"updateProjectV2": map[string]any{
+						"projectV2": map[string]any{
+							"shortDescription": "Quarterly plan",
+							"public":           true,
+						},
+					},
+				}),
+			),
+		)
+
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(mockedClient),
+			Obsv:      stubExporters(),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":            "create_project",
+			"owner":             "octo-org",
+			"owner_type":        "org",
+			"title":             "Roadmap",
+			"short_description": "Quarterly plan",
+			"public":            true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		err = json.Unmarshal([]byte(textContent.Text), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "PVT_roadmap", response["id"])
+		assert.Equal(t, float64(7), response["number"])
+		assert.Equal(t, "https://github.com/orgs/octo-org/projects/7", response["url"])
+		assert.Equal(t, "Quarterly plan", response["short_description"])
+		assert.Equal(t, true, response["public"])
+	})
+
+	t.Run("blank title returns error", func(t *testing.T) {
+		t.Parallel()
+
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+			Obsv:      stubExporters(),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":     "create_project",
+			"owner":      "octocat",
+			"owner_type": "user",
+			"title":      "   ",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		assert.Contains(t, textContent.Text, "title must not be empty")
+	})
+
 	t.Run("missing owner_type returns error", func(t *testing.T) {
 		t.Parallel()
 
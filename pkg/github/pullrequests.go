@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v89/github"
@@ -822,7 +824,7 @@ func CreatePullRequest(t translations.TranslationHelperFunc) inventory.ServerToo
 				_, reviewerResp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pr.GetNumber(), reviewersRequest)
 				if err != nil {
 					return ghErrors.NewGitHubAPIErrorResponse(ctx,
-						"failed to request reviewers",
+						requestReviewersErrMsg(reviewerResp, err),
 						reviewerResp,
 						err,
 					), nil, nil
@@ -1046,62 +1048,8 @@ func UpdatePullRequest(t translations.TranslationHelperFunc) inventory.ServerToo
 					return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
 				}
 
-				var prQuery struct {
-					Repository struct {
-						PullRequest struct {
-							ID      githubv4.ID
-							IsDraft githubv4.Boolean
-						} `graphql:"pullRequest(number: $prNum)"`
-					} `graphql:"repository(owner: $owner, name: $repo)"`
-				}
-
-				err = gqlClient.Query(ctx, &prQuery, map[string]any{
-					"owner": githubv4.String(owner),
-					"repo":  githubv4.String(repo),
-					"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
-				})
-				if err != nil {
-					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find pull request", err), nil, nil
-				}
-
-				currentIsDraft := bool(prQuery.Repository.PullRequest.IsDraft)
-
-				if currentIsDraft != draftValue {
-					if draftValue {
-						// Convert to draft
-						var mutation struct {
-							ConvertPullRequestToDraft struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"convertPullRequestToDraft(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to convert pull request to draft", err), nil, nil
-						}
-					} else {
-						// Mark as ready for review
-						var mutation struct {
-							MarkPullRequestReadyForReview struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"markPullRequestReadyForReview(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to mark pull request ready for review", err), nil, nil
-						}
-					}
+				if _, _, errResult := setPullRequestDraftState(ctx, gqlClient, owner, repo, pullNumber, draftValue); errResult != nil {
+					return errResult, nil, nil
 				}
 			}
 
@@ -1121,7 +1069,7 @@ func UpdatePullRequest(t translations.TranslationHelperFunc) inventory.ServerToo
 				_, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, reviewersRequest)
 				if err != nil {
 					return ghErrors.NewGitHubAPIErrorResponse(ctx,
-						"failed to request reviewers",
+						requestReviewersErrMsg(resp, err),
 						resp,
 						err,
 					), nil, nil
@@ -1837,6 +1785,225 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 		})
 }
 
+// requestReviewersErrMsg explains the 422 GitHub returns when the pull
+// request's author is among the requested reviewers, whose API message does
+// not say which reviewer was rejected or what to do about it.
+func requestReviewersErrMsg(resp *github.Response, err error) string {
+	var errResp *github.ErrorResponse
+	if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity &&
+		errors.As(err, &errResp) && strings.Contains(strings.ToLower(errResp.Message), "pull request author") {
+		return "failed to request reviewers: the pull request's author cannot review their own pull request; remove them from reviewers and try again"
+	}
+	return "failed to request reviewers"
+}
+
+// autoMergeMethods maps the merge_method values accepted by
+// pull_request_auto_merge_write to their GraphQL enum values.
+var autoMergeMethods = map[string]githubv4.PullRequestMergeMethod{
+	"merge":  githubv4.PullRequestMergeMethodMerge,
+	"squash": githubv4.PullRequestMergeMethodSquash,
+	"rebase": githubv4.PullRequestMergeMethodRebase,
+}
+
+// autoMergeRequestFragment is the auto-merge state returned by the enable and
+// disable mutations. It is nil when auto-merge is not enabled.
+type autoMergeRequestFragment struct {
+	EnabledAt      githubv4.DateTime
+	MergeMethod    githubv4.PullRequestMergeMethod
+	CommitHeadline githubv4.String
+	CommitBody     githubv4.String
+	EnabledBy      struct {
+		Login githubv4.String
+	}
+}
+
+// PullRequestAutoMergeWrite creates a tool to enable or disable auto-merge on
+// a pull request.
+func PullRequestAutoMergeWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"method": {
+				Type: "string",
+				Description: `The action to perform on the pull request.
+Options are:
+- 'enable_auto_merge' - merge the pull request automatically once all requirements are met.
+- 'disable_auto_merge' - stop the pull request from being merged automatically.
+`,
+				Enum: []any{"enable_auto_merge", "disable_auto_merge"},
+			},
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+			"merge_method": {
+				Type:        "string",
+				Description: "Merge method to use once the pull request is mergeable (enable_auto_merge only). Defaults to merge.",
+				Enum:        []any{"merge", "squash", "rebase"},
+			},
+			"commit_title": {
+				Type:        "string",
+				Description: "Title for the merge commit (enable_auto_merge only)",
+			},
+			"commit_message": {
+				Type:        "string",
+				Description: "Extra detail for the merge commit (enable_auto_merge only)",
+			},
+		},
+		Required: []string{"method", "owner", "repo", "pullNumber"},
+	}
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "pull_request_auto_merge_write",
+			Description: t("TOOL_PULL_REQUEST_AUTO_MERGE_WRITE_DESCRIPTION", "Enable or disable auto-merge on a pull request. Returns the resulting auto-merge state."),
+			Icons:       octicons.Icons("git-merge"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_PULL_REQUEST_AUTO_MERGE_WRITE_USER_TITLE", "Enable or disable pull request auto-merge"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			method, err := RequiredParam[string](args, "method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			mergeMethod, err := OptionalParam[string](args, "merge_method")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commitTitle, err := OptionalParam[string](args, "commit_title")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commitMessage, err := OptionalParam[string](args, "commit_message")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			if method != "enable_auto_merge" && method != "disable_auto_merge" {
+				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
+			}
+			if mergeMethod == "" {
+				mergeMethod = "merge"
+			}
+			gqlMergeMethod, ok := autoMergeMethods[mergeMethod]
+			if !ok {
+				return utils.NewToolResultError(fmt.Sprintf("invalid merge_method %q: must be one of merge, squash, rebase", mergeMethod)), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
+			}
+
+			var prQuery struct {
+				Repository struct {
+					PullRequest struct {
+						ID githubv4.ID
+					} `graphql:"pullRequest(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}
+			if err := gqlClient.Query(ctx, &prQuery, map[string]any{
+				"owner":  githubv4.String(owner),
+				"name":   githubv4.String(repo),
+				"number": githubv4.Int(pullNumber), // #nosec G115 - PR numbers are always small positive integers
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err), nil, nil
+			}
+			prID := prQuery.Repository.PullRequest.ID
+
+			var autoMerge *autoMergeRequestFragment
+			if method == "enable_auto_merge" {
+				input := githubv4.EnablePullRequestAutoMergeInput{
+					PullRequestID: prID,
+					MergeMethod:   &gqlMergeMethod,
+				}
+				if commitTitle != "" {
+					input.CommitHeadline = githubv4.NewString(githubv4.String(commitTitle))
+				}
+				if commitMessage != "" {
+					input.CommitBody = githubv4.NewString(githubv4.String(commitMessage))
+				}
+				var mutation struct {
+					EnablePullRequestAutoMerge struct {
+						PullRequest struct {
+							AutoMergeRequest *autoMergeRequestFragment
+						}
+					} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+				}
+				if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, enableAutoMergeErrMsg(owner, repo, err), err), nil, nil
+				}
+				autoMerge = mutation.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest
+			} else {
+				var mutation struct {
+					DisablePullRequestAutoMerge struct {
+						PullRequest struct {
+							AutoMergeRequest *autoMergeRequestFragment
+						}
+					} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+				}
+				if err := gqlClient.Mutate(ctx, &mutation, githubv4.DisablePullRequestAutoMergeInput{
+					PullRequestID: prID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to disable auto-merge", err), nil, nil
+				}
+				autoMerge = mutation.DisablePullRequestAutoMerge.PullRequest.AutoMergeRequest
+			}
+
+			result := map[string]any{
+				"pullNumber":         pullNumber,
+				"auto_merge_enabled": autoMerge != nil,
+			}
+			if autoMerge != nil {
+				result["merge_method"] = strings.ToLower(string(autoMerge.MergeMethod))
+				result["enabled_at"] = autoMerge.EnabledAt.Time
+				result["enabled_by"] = string(autoMerge.EnabledBy.Login)
+				if autoMerge.CommitHeadline != "" {
+					result["commit_title"] = string(autoMerge.CommitHeadline)
+				}
+				if autoMerge.CommitBody != "" {
+					result["commit_message"] = string(autoMerge.CommitBody)
+				}
+			}
+			return MarshalledTextResult(result), nil, nil
+		})
+}
+
+// enableAutoMergeErrMsg explains the error GitHub returns when auto-merge has
+// not been allowed in the repository's settings, which otherwise reads as if
+// the pull request itself were at fault.
+func enableAutoMergeErrMsg(owner, repo string, err error) string {
+	if strings.Contains(strings.ToLower(err.Error()), "auto merge is not allowed") {
+		return fmt.Sprintf("failed to enable auto-merge: auto-merge is not allowed in %s/%s; a repository admin must enable \"Allow auto-merge\" in the repository settings", owner, repo)
+	}
+	return "failed to enable auto-merge"
+}
+
 type PullRequestReviewWriteParams struct {
 	Method     string
 	Owner      string
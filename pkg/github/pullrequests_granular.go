@@ -177,7 +177,7 @@ func GranularUpdatePullRequestDraftState(t translations.TranslationHelperFunc) i
 		ToolsetMetadataPullRequests,
 		mcp.Tool{
 			Name:        "update_pull_request_draft_state",
-			Description: t("TOOL_UPDATE_PULL_REQUEST_DRAFT_STATE_DESCRIPTION", "Mark a pull request as draft or ready for review."),
+			Description: t("TOOL_UPDATE_PULL_REQUEST_DRAFT_STATE_DESCRIPTION", "Mark a pull request as draft or ready for review. Returns the resulting draft state, or an error if the pull request is already in the requested state."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_UPDATE_PULL_REQUEST_DRAFT_STATE_USER_TITLE", "Update Pull Request Draft State"),
 				ReadOnlyHint:    false,
@@ -223,59 +223,87 @@ func GranularUpdatePullRequestDraftState(t translations.TranslationHelperFunc) i
 				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
 			}
 
-			// Get PR node ID
-			var prQuery struct {
-				Repository struct {
-					PullRequest struct {
-						ID githubv4.ID
-					} `graphql:"pullRequest(number: $number)"`
-				} `graphql:"repository(owner: $owner, name: $name)"`
-			}
-			if err := gqlClient.Query(ctx, &prQuery, map[string]any{
-				"owner":  githubv4.String(owner),
-				"name":   githubv4.String(repo),
-				"number": githubv4.Int(pullNumber), // #nosec G115 - PR numbers are always small positive integers
-			}); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err), nil, nil
-			}
-
-			if draft {
-				var mutation struct {
-					ConvertPullRequestToDraft struct {
-						PullRequest struct {
-							ID      githubv4.ID
-							IsDraft githubv4.Boolean
-						}
-					} `graphql:"convertPullRequestToDraft(input: $input)"`
-				}
-				if err := gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
-					PullRequestID: prQuery.Repository.PullRequest.ID,
-				}, nil); err != nil {
-					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to convert to draft", err), nil, nil
+			isDraft, changed, errResult := setPullRequestDraftState(ctx, gqlClient, owner, repo, pullNumber, draft)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			// Asking for the state the pull request is already in is an
+			// error, since neither mutation would change anything.
+			if !changed {
+				if draft {
+					return utils.NewToolResultError(fmt.Sprintf("pull request #%d is already a draft", pullNumber)), nil, nil
 				}
-				return utils.NewToolResultText("pull request converted to draft"), nil, nil
+				return utils.NewToolResultError(fmt.Sprintf("pull request #%d is already ready for review", pullNumber)), nil, nil
 			}
 
-			var mutation struct {
-				MarkPullRequestReadyForReview struct {
-					PullRequest struct {
-						ID      githubv4.ID
-						IsDraft githubv4.Boolean
-					}
-				} `graphql:"markPullRequestReadyForReview(input: $input)"`
-			}
-			if err := gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
-				PullRequestID: prQuery.Repository.PullRequest.ID,
-			}, nil); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to mark ready for review", err), nil, nil
-			}
-			return utils.NewToolResultText("pull request marked as ready for review"), nil, nil
+			return MarshalledTextResult(map[string]any{
+				"pullNumber": pullNumber,
+				"draft":      isDraft,
+			}), nil, nil
 		},
 	)
 	st.FeatureFlagEnable = FeatureFlagPullRequestsGranular
 	return st
 }
 
+// setPullRequestDraftState converts a pull request to a draft or marks it
+// ready for review. changed is false, and no mutation is sent, when the pull
+// request is already in the requested state.
+func setPullRequestDraftState(ctx context.Context, gqlClient *githubv4.Client, owner, repo string, pullNumber int, draft bool) (isDraft, changed bool, errResult *mcp.CallToolResult) {
+	var prQuery struct {
+		Repository struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	if err := gqlClient.Query(ctx, &prQuery, map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repo),
+		"number": githubv4.Int(pullNumber), // #nosec G115 - PR numbers are always small positive integers
+	}); err != nil {
+		return false, false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err)
+	}
+
+	pr := prQuery.Repository.PullRequest
+	if bool(pr.IsDraft) == draft {
+		return draft, false, nil
+	}
+
+	if draft {
+		var mutation struct {
+			ConvertPullRequestToDraft struct {
+				PullRequest struct {
+					ID      githubv4.ID
+					IsDraft githubv4.Boolean
+				}
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+		if err := gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
+			PullRequestID: pr.ID,
+		}, nil); err != nil {
+			return false, false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to convert to draft", err)
+		}
+		return bool(mutation.ConvertPullRequestToDraft.PullRequest.IsDraft), true, nil
+	}
+
+	var mutation struct {
+		MarkPullRequestReadyForReview struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			}
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}
+	if err := gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
+		PullRequestID: pr.ID,
+	}, nil); err != nil {
+		return false, false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to mark ready for review", err)
+	}
+	return bool(mutation.MarkPullRequestReadyForReview.PullRequest.IsDraft), true, nil
+}
+
 // GranularRequestPullRequestReviewers creates a tool to request reviewers.
 func GranularRequestPullRequestReviewers(t translations.TranslationHelperFunc) inventory.ServerTool {
 	st := NewTool(
@@ -337,7 +365,7 @@ func GranularRequestPullRequestReviewers(t translations.TranslationHelperFunc) i
 				TeamReviewers: teamReviewers,
 			})
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to request reviewers", resp, err), nil, nil
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, requestReviewersErrMsg(resp, err), resp, err), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -355,6 +383,83 @@ func GranularRequestPullRequestReviewers(t translations.TranslationHelperFunc) i
 	return st
 }
 
+// GranularRemovePullRequestReviewers creates a tool to withdraw pending
+// review requests.
+func GranularRemovePullRequestReviewers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	st := NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "remove_pull_request_reviewers",
+			Description: t("TOOL_REMOVE_PULL_REQUEST_REVIEWERS_DESCRIPTION", "Remove pending review requests from a pull request."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_REMOVE_PULL_REQUEST_REVIEWERS_USER_TITLE", "Remove Pull Request Reviewers"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner":      {Type: "string", Description: "Repository owner (username or organization)"},
+					"repo":       {Type: "string", Description: "Repository name"},
+					"pullNumber": {Type: "number", Description: "The pull request number", Minimum: jsonschema.Ptr(1.0)},
+					"reviewers": {
+						Type:        "array",
+						Description: "GitHub usernames or ORG/team-slug team reviewers whose review requests to remove",
+						Items:       &jsonschema.Schema{Type: "string"},
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber", "reviewers"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			reviewers, err := OptionalStringArrayParam(args, "reviewers")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(reviewers) == 0 {
+				return utils.NewToolResultError("missing required parameter: reviewers"), nil, nil
+			}
+			userReviewers, teamReviewers := splitPullRequestReviewers(reviewers)
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			resp, err := client.PullRequests.RemoveReviewers(ctx, owner, repo, pullNumber, gogithub.ReviewersRequest{
+				Reviewers:     userReviewers,
+				TeamReviewers: teamReviewers,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove requested reviewers", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(map[string]any{
+				"pullNumber":        pullNumber,
+				"removed_reviewers": userReviewers,
+				"removed_teams":     teamReviewers,
+			}), nil, nil
+		},
+	)
+	st.FeatureFlagEnable = FeatureFlagPullRequestsGranular
+	return st
+}
+
 func splitPullRequestReviewers(reviewers []string) ([]string, []string) {
 	userReviewers := make([]string, 0, len(reviewers))
 	teamReviewers := make([]string, 0)
@@ -459,13 +459,13 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 							PullRequest struct {
 								ID      githubv4.ID
 								IsDraft githubv4.Boolean
-							} `graphql:"pullRequest(number: $prNum)"`
-						} `graphql:"repository(owner: $owner, name: $repo)"`
+							} `graphql:"pullRequest(number: $number)"`
+						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
 					map[string]any{
-						"owner": githubv4.String("owner"),
-						"repo":  githubv4.String("repo"),
-						"prNum": githubv4.Int(42),
+						"owner":  githubv4.String("owner"),
+						"name":   githubv4.String("repo"),
+						"number": githubv4.Int(42),
 					},
 					githubv4mock.DataResponse(map[string]any{
 						"repository": map[string]any{
@@ -517,13 +517,13 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 							PullRequest struct {
 								ID      githubv4.ID
 								IsDraft githubv4.Boolean
-							} `graphql:"pullRequest(number: $prNum)"`
-						} `graphql:"repository(owner: $owner, name: $repo)"`
+							} `graphql:"pullRequest(number: $number)"`
+						} `graphql:"repository(owner: $owner, name: $name)"`
 					}{},
 					map[string]any{
-						"owner": githubv4.String("owner"),
-						"repo":  githubv4.String("repo"),
-						"prNum": githubv4.Int(42),
+						"owner":  githubv4.String("owner"),
+						"name":   githubv4.String("repo"),
+						"number": githubv4.Int(42),
 					},
 					githubv4mock.DataResponse(map[string]any{
 						"repository": map[string]any{
@@ -566,6 +566,42 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 			expectError: false,
 			expectedPR:  mockUpdatedPR,
 		},
+		{
+			name: "draft already in requested state is left unchanged",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+							} `graphql:"pullRequest(number: $number)"`
+						} `graphql:"repository(owner: $owner, name: $name)"`
+					}{},
+					map[string]any{
+						"owner":  githubv4.String("owner"),
+						"name":   githubv4.String("repo"),
+						"number": githubv4.Int(42),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"id":      "PR_kwDOA0xdyM50BPaO",
+								"isDraft": true,
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"draft":      true,
+			},
+			expectError: false,
+			expectedPR:  mockUpdatedPR,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1989,6 +2025,190 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 	}
 }
 
+func Test_PullRequestAutoMergeWrite(t *testing.T) {
+	serverTool := PullRequestAutoMergeWrite(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "pull_request_auto_merge_write", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "merge_method")
+	assert.Contains(t, schema.Properties, "commit_title")
+	assert.Contains(t, schema.Properties, "commit_message")
+	assert.ElementsMatch(t, schema.Required, []string{"method", "owner", "repo", "pullNumber"})
+
+	prQueryMatcher := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				PullRequest struct {
+					ID githubv4.ID
+				} `graphql:"pullRequest(number: $number)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}{},
+		map[string]any{
+			"owner":  githubv4.String("owner"),
+			"name":   githubv4.String("repo"),
+			"number": githubv4.Int(42),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"pullRequest": map[string]any{"id": "PR_42"},
+			},
+		}),
+	)
+	enableMutation := struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				AutoMergeRequest *autoMergeRequestFragment
+			}
+		} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+	}{}
+	squash := githubv4.PullRequestMergeMethodSquash
+
+	tests := []struct {
+		name             string
+		matchers         []githubv4mock.Matcher
+		requestArgs      map[string]any
+		expectedErrMsg   string
+		expectedResponse map[string]any
+	}{
+		{
+			name: "enable with squash and commit title",
+			matchers: []githubv4mock.Matcher{
+				prQueryMatcher,
+				githubv4mock.NewMutationMatcher(
+					enableMutation,
+					githubv4.EnablePullRequestAutoMergeInput{
+						PullRequestID:  githubv4.ID("PR_42"),
+						MergeMethod:    &squash,
+						CommitHeadline: githubv4.NewString("Ship it (#42)"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"enablePullRequestAutoMerge": map[string]any{
+							"pullRequest": map[string]any{
+								"autoMergeRequest": map[string]any{
+									"enabledAt":      "2024-05-01T12:00:00Z",
+									"mergeMethod":    "SQUASH",
+									"commitHeadline": "Ship it (#42)",
+									"commitBody":     "",
+									"enabledBy":      map[string]any{"login": "octocat"},
+								},
+							},
+						},
+					}),
+				),
+			},
+			requestArgs: map[string]any{
+				"method":       "enable_auto_merge",
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"merge_method": "squash",
+				"commit_title": "Ship it (#42)",
+			},
+			expectedResponse: map[string]any{
+				"pullNumber":         float64(42),
+				"auto_merge_enabled": true,
+				"merge_method":       "squash",
+				"enabled_at":         "2024-05-01T12:00:00Z",
+				"enabled_by":         "octocat",
+				"commit_title":       "Ship it (#42)",
+			},
+		},
+		{
+			name: "disable",
+			matchers: []githubv4mock.Matcher{
+				prQueryMatcher,
+				githubv4mock.NewMutationMatcher(
+					struct {
+						DisablePullRequestAutoMerge struct {
+							PullRequest struct {
+								AutoMergeRequest *autoMergeRequestFragment
+							}
+						} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+					}{},
+					githubv4.DisablePullRequestAutoMergeInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"disablePullRequestAutoMerge": map[string]any{
+							"pullRequest": map[string]any{"autoMergeRequest": nil},
+						},
+					}),
+				),
+			},
+			requestArgs: map[string]any{
+				"method":     "disable_auto_merge",
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResponse: map[string]any{
+				"pullNumber":         float64(42),
+				"auto_merge_enabled": false,
+			},
+		},
+		{
+			name: "auto-merge not allowed in repository",
+			matchers: []githubv4mock.Matcher{
+				prQueryMatcher,
+				githubv4mock.NewMutationMatcher(
+					enableMutation,
+					githubv4.EnablePullRequestAutoMergeInput{
+						PullRequestID: githubv4.ID("PR_42"),
+						MergeMethod:   githubv4mock.Ptr(githubv4.PullRequestMergeMethodMerge),
+					},
+					nil,
+					githubv4mock.ErrorResponse("Pull request Auto merge is not allowed for this repository"),
+				),
+			},
+			requestArgs: map[string]any{
+				"method":     "enable_auto_merge",
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: `auto-merge is not allowed in owner/repo; a repository admin must enable "Allow auto-merge"`,
+		},
+		{
+			name: "invalid merge method",
+			requestArgs: map[string]any{
+				"method":       "enable_auto_merge",
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"merge_method": "fast-forward",
+			},
+			expectedErrMsg: `invalid merge_method "fast-forward": must be one of merge, squash, rebase`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(tc.matchers...)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResponse, response)
+		})
+	}
+}
+
 func Test_GetPullRequestComments(t *testing.T) {
 	// Verify tool definition once
 	serverTool := PullRequestRead(translations.NullTranslationHelper)
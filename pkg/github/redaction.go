@@ -0,0 +1,184 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RedactedValue replaces the value of a sensitive field in tool output.
+const RedactedValue = "***"
+
+// CredentialRedactFields are common credential field names, suitable for
+// passing to --redact-fields. Redaction is opt-in, so none are redacted unless
+// configured. Names are matched exactly, so fields such as key_prefix or
+// secret_type that merely mention a credential word are left alone.
+var CredentialRedactFields = []string{
+	"token",
+	"access_token",
+	"refresh_token",
+	"password",
+	"secret",
+	"client_secret",
+	"private_key",
+	"api_key",
+}
+
+// redactionExemptTools lists tools whose output intentionally contains values
+// that CredentialRedactFields would otherwise redact.
+var redactionExemptTools = map[string]bool{
+	// Secret scanning alerts exist to report the leaked secret.
+	"get_secret_scanning_alert":    true,
+	"list_secret_scanning_alerts":  true,
+	"update_secret_scanning_alert": true,
+}
+
+// RedactionMiddleware returns tool-handler middleware that replaces the string
+// value of every JSON field named one of fields with RedactedValue. Names are
+// compared after normalizing camelCase and kebab-case to snake_case, so
+// "access_token" also matches "accessToken" and "access-token". Tools named
+// in exempt are left untouched.
+func RedactionMiddleware(fields []string, exempt map[string]bool) inventory.ToolHandlerMiddleware {
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if f = normalizeFieldName(strings.TrimSpace(f)); f != "" {
+			names[f] = true
+		}
+	}
+	return func(next mcp.ToolHandler) mcp.ToolHandler {
+		if len(names) == 0 {
+			return next
+		}
+		return func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, req)
+			if err != nil || result == nil || exempt[req.Params.Name] {
+				return result, err
+			}
+			redactToolResult(result, names)
+			return result, nil
+		}
+	}
+}
+
+// redactToolResult redacts JSON text content and structured content in place.
+// Text that is not a JSON object or array is left as is.
+func redactToolResult(result *mcp.CallToolResult, names map[string]bool) {
+	for _, content := range result.Content {
+		text, ok := content.(*mcp.TextContent)
+		if !ok {
+			continue
+		}
+		trimmed := strings.TrimSpace(text.Text)
+		if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+			continue
+		}
+		if b, changed := redactJSON([]byte(text.Text), names); changed {
+			text.Text = string(b)
+		}
+	}
+
+	if result.StructuredContent != nil {
+		b, err := json.Marshal(result.StructuredContent)
+		if err != nil {
+			return
+		}
+		if b, changed := redactJSON(b, names); changed {
+			result.StructuredContent = json.RawMessage(b)
+		}
+	}
+}
+
+// redactJSON replaces the string value of every object field named in names
+// with RedactedValue and reports whether anything was replaced. Only the
+// matched values are rewritten; field order, number formatting and whitespace
+// are kept as they were. Invalid JSON is returned unchanged.
+func redactJSON(data []byte, names map[string]bool) ([]byte, bool) {
+	type span struct{ start, end int64 }
+	var spans []span
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	// inObject records, for each open container, whether it is an object.
+	var inObject []bool
+	expectKey := false
+	var key string
+	for {
+		offset := dec.InputOffset()
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return data, false
+		}
+
+		switch t := tok.(type) {
+		case json.Delim:
+			switch t {
+			case '{', '[':
+				inObject = append(inObject, t == '{')
+				expectKey = t == '{'
+				continue
+			default:
+				inObject = inObject[:len(inObject)-1]
+			}
+		case string:
+			if expectKey {
+				key = t
+				expectKey = false
+				continue
+			}
+			if t != "" && len(inObject) > 0 && inObject[len(inObject)-1] && names[normalizeFieldName(key)] {
+				// Between the previous token and the value there is only
+				// whitespace and the colon, so the first quote opens it.
+				end := dec.InputOffset()
+				start := offset + int64(bytes.IndexByte(data[offset:end], '"'))
+				spans = append(spans, span{start, end})
+			}
+		}
+		// A value was completed; inside an object a key comes next.
+		expectKey = len(inObject) > 0 && inObject[len(inObject)-1]
+	}
+	if len(spans) == 0 {
+		return data, false
+	}
+
+	redacted := strconv.Quote(RedactedValue)
+	var out bytes.Buffer
+	out.Grow(len(data))
+	var prev int64
+	for _, s := range spans {
+		out.Write(data[prev:s.start])
+		out.WriteString(redacted)
+		prev = s.end
+	}
+	out.Write(data[prev:])
+	return out.Bytes(), true
+}
+
+// normalizeFieldName lowercases a snake_case, kebab-case or camelCase field
+// name into snake_case.
+func normalizeFieldName(name string) string {
+	var b strings.Builder
+	prevLower := false
+	for _, r := range name {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			b.WriteByte('_')
+			prevLower = false
+			continue
+		case unicode.IsUpper(r) && prevLower:
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+		prevLower = unicode.IsLower(r) || unicode.IsDigit(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RedactionMiddleware(t *testing.T) {
+	exempt := map[string]bool{"create_runner_registration_token": true}
+	middleware := RedactionMiddleware(CredentialRedactFields, exempt)
+
+	call := func(t *testing.T, toolName, output string) string {
+		t.Helper()
+		handler := middleware(func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: output}}}, nil
+		})
+		result, err := handler(context.Background(), &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: toolName}})
+		require.NoError(t, err)
+		return getTextResult(t, result).Text
+	}
+
+	tests := []struct {
+		name     string
+		tool     string
+		output   string
+		expected string
+	}{
+		{
+			name:     "webhook secret is redacted",
+			tool:     "get_webhook",
+			output:   `{"id":12345678901234567,"config":{"url":"https://example.com/hook","secret":"s3cr3t","insecure_ssl":"0"}}`,
+			expected: `{"id":12345678901234567,"config":{"url":"https://example.com/hook","secret":"***","insecure_ssl":"0"}}`,
+		},
+		{
+			name:     "opted-out tool keeps its token",
+			tool:     "create_runner_registration_token",
+			output:   `{"token":"AABF3JGZDX3P5PMEXLND6TS6FCWO6","expires_at":"2026-01-01T00:00:00Z"}`,
+			expected: `{"token":"AABF3JGZDX3P5PMEXLND6TS6FCWO6","expires_at":"2026-01-01T00:00:00Z"}`,
+		},
+		{
+			name:     "camelCase names and arrays are redacted",
+			tool:     "list_things",
+			output:   `[{"apiKey":"abc","keyboard":"qwerty","accessToken":"","client-secret":"x","passwordHash":"x"}]`,
+			expected: `[{"apiKey":"***","keyboard":"qwerty","accessToken":"","client-secret":"***","passwordHash":"x"}]`,
+		},
+		{
+			name:     "autolink key prefix is unchanged",
+			tool:     "list_autolinks",
+			output:   `[{"id":1,"key_prefix":"TICKET-","url_template":"https://example.com/TICKET?query=<num>"}]`,
+			expected: `[{"id":1,"key_prefix":"TICKET-","url_template":"https://example.com/TICKET?query=<num>"}]`,
+		},
+		{
+			name:     "repository license key is unchanged",
+			tool:     "get_repository",
+			output:   `{"full_name":"octo/repo","license":{"key":"mit","name":"MIT License"}}`,
+			expected: `{"full_name":"octo/repo","license":{"key":"mit","name":"MIT License"}}`,
+		},
+		{
+			name:     "secret type is unchanged",
+			tool:     "list_dependabot_alerts",
+			output:   `{"secret_type":"github_personal_access_token","secret_type_display_name":"GitHub Personal Access Token"}`,
+			expected: `{"secret_type":"github_personal_access_token","secret_type_display_name":"GitHub Personal Access Token"}`,
+		},
+		{
+			name:     "only matched values are rewritten",
+			tool:     "get_webhook",
+			output:   "{\n  \"secret\" : \"a \\\"quoted\\\" value\",\n  \"size\": 1.50,\n  \"nested\": [{\"token\": \"t\"}, \"token\"]\n}",
+			expected: "{\n  \"secret\" : \"***\",\n  \"size\": 1.50,\n  \"nested\": [{\"token\": \"***\"}, \"token\"]\n}",
+		},
+		{
+			name:     "non-string values are unchanged",
+			tool:     "get_thing",
+			output:   `{"secret":{"token":"x"},"password":null,"api_key":42}`,
+			expected: `{"secret":{"token":"***"},"password":null,"api_key":42}`,
+		},
+		{
+			name:     "output without sensitive fields is unchanged",
+			tool:     "get_me",
+			output:   `{"login":"octocat", "id": 1}`,
+			expected: `{"login":"octocat", "id": 1}`,
+		},
+		{
+			name:     "plain text is unchanged",
+			tool:     "get_file_contents",
+			output:   "secret: s3cr3t",
+			expected: "secret: s3cr3t",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, call(t, tc.tool, tc.output))
+		})
+	}
+}
+
+func Test_RedactionMiddleware_NoPatterns(t *testing.T) {
+	handler := RedactionMiddleware(nil, nil)(func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: `{"secret":"s3cr3t"}`}}}, nil
+	})
+	result, err := handler(context.Background(), &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_webhook"}})
+	require.NoError(t, err)
+	assert.Equal(t, `{"secret":"s3cr3t"}`, getTextResult(t, result).Text)
+}
+
+func Test_RedactionMiddleware_StructuredContent(t *testing.T) {
+	handler := RedactionMiddleware(CredentialRedactFields, nil)(func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "done"}},
+			StructuredContent: struct {
+				URL    string `json:"url"`
+				Secret string `json:"secret"`
+				ID     int64  `json:"id"`
+			}{"https://example.com/hook", "s3cr3t", 12345678901234567},
+		}, nil
+	})
+	result, err := handler(context.Background(), &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_webhook"}})
+	require.NoError(t, err)
+
+	b, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.Equal(t, `{"url":"https://example.com/hook","secret":"***","id":12345678901234567}`, string(b))
+}
@@ -7,14 +7,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/octicons"
+	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -440,12 +443,12 @@ func CreateOrUpdateFile(t translations.TranslationHelperFunc) inventory.ServerTo
 		mcp.Tool{
 			Name: "create_or_update_file",
 			Description: t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", `Create or update a single file in a GitHub repository. 
-If updating, you should provide the SHA of the file you want to update. Use this tool to create or update a file in a GitHub repository remotely; do not use it for local file operations.
+Use this tool to create or update a file in a GitHub repository remotely; do not use it for local file operations.
 
-In order to obtain the SHA of original file version before updating, use the following git command:
+If the file already exists and no SHA is provided, the file's current SHA on the branch is used, overwriting whatever it contains. Provide the SHA of the version you based your changes on to have the update rejected if the file has changed since. To obtain it, use the following git command:
 git rev-parse <branch>:<path to file>
 
-SHA MUST be provided for existing file updates.
+Returns the resulting commit SHA and the new blob SHA of the file.
 `),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_CREATE_OR_UPDATE_FILE_USER_TITLE", "Create or update file"),
@@ -480,7 +483,7 @@ SHA MUST be provided for existing file updates.
 					},
 					"sha": {
 						Type:        "string",
-						Description: "The blob SHA of the file being replaced. Required if the file already exists.",
+						Description: "The blob SHA of the file being replaced. If omitted and the file already exists, its current SHA is looked up.",
 					},
 				},
 				Required: []string{"owner", "repo", "path", "content", "message", "branch"},
@@ -595,11 +598,9 @@ SHA MUST be provided for existing file updates.
 						"Path %s is a directory, not a file. This tool only works with files.",
 						path)), nil, nil
 				case existingFile != nil:
-					// File exists but no SHA was provided - reject to prevent blind overwrites
-					return utils.NewToolResultError(fmt.Sprintf(
-						"File already exists at %s. You must provide the current file's SHA when updating. "+
-							"Use git rev-parse %s:%s to get the blob SHA, then retry with the sha parameter.",
-						path, branch, path)), nil, nil
+					// File exists but no SHA was provided - update the current
+					// version rather than failing with a 409
+					opts.SHA = github.Ptr(existingFile.GetSHA())
 				}
 				// If file not found, no previous SHA needed (new file creation)
 			}
@@ -664,6 +665,18 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 						Type:        "boolean",
 						Description: "Initialize with README",
 					},
+					"gitignore_template": {
+						Type:        "string",
+						Description: "Name of a .gitignore template to apply (e.g. 'Go', 'Node'). Creates an initial commit.",
+					},
+					"license_template": {
+						Type:        "string",
+						Description: "License keyword to apply (e.g. 'mit', 'apache-2.0'). Creates an initial commit.",
+					},
+					"default_branch": {
+						Type:        "string",
+						Description: "Name for the initial branch. Requires autoInit, gitignore_template, or license_template so the repository has an initial commit.",
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -690,6 +703,24 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			gitignoreTemplate, err := OptionalParam[string](args, "gitignore_template")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			licenseTemplate, err := OptionalParam[string](args, "license_template")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			defaultBranch, err := OptionalParam[string](args, "default_branch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			// An empty repository has no branch to name, so default_branch
+			// only applies when creation produces an initial commit.
+			if defaultBranch != "" && !autoInit && gitignoreTemplate == "" && licenseTemplate == "" {
+				return utils.NewToolResultError("default_branch requires autoInit, gitignore_template, or license_template so the repository has an initial branch"), nil, nil
+			}
 
 			repo := &github.Repository{
 				Name:        github.Ptr(name),
@@ -697,6 +728,12 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 				Private:     github.Ptr(private),
 				AutoInit:    github.Ptr(autoInit),
 			}
+			if gitignoreTemplate != "" {
+				repo.GitignoreTemplate = github.Ptr(gitignoreTemplate)
+			}
+			if licenseTemplate != "" {
+				repo.LicenseTemplate = github.Ptr(licenseTemplate)
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -720,10 +757,29 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to create repository", resp, body), nil, nil
 			}
 
+			// GitHub names the initial branch from the owner's default, so
+			// rename it when the caller asked for something else.
+			if defaultBranch != "" && defaultBranch != createdRepo.GetDefaultBranch() {
+				_, renameResp, err := client.Repositories.RenameBranch(ctx, createdRepo.GetOwner().GetLogin(), createdRepo.GetName(), createdRepo.GetDefaultBranch(), defaultBranch)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("repository %s was created but renaming its default branch to %s failed", createdRepo.GetFullName(), defaultBranch),
+						renameResp,
+						err,
+					), nil, nil
+				}
+				_ = renameResp.Body.Close()
+				createdRepo.DefaultBranch = github.Ptr(defaultBranch)
+			}
+
 			// Return minimal response with just essential information
-			minimalResponse := MinimalResponse{
-				ID:  fmt.Sprintf("%d", createdRepo.GetID()),
-				URL: createdRepo.GetHTMLURL(),
+			minimalResponse := MinimalCreatedRepository{
+				ID:            fmt.Sprintf("%d", createdRepo.GetID()),
+				URL:           createdRepo.GetHTMLURL(),
+				FullName:      createdRepo.GetFullName(),
+				CloneURL:      createdRepo.GetCloneURL(),
+				SSHURL:        createdRepo.GetSSHURL(),
+				DefaultBranch: createdRepo.GetDefaultBranch(),
 			}
 
 			r, err := json.Marshal(minimalResponse)
@@ -736,6 +792,154 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 	)
 }
 
+// repositorySettingsToggles are the boolean settings update_repository_settings
+// can change, keyed by parameter name.
+var repositorySettingsToggles = map[string]func(*github.Repository, bool){
+	"has_issues":             func(r *github.Repository, v bool) { r.HasIssues = github.Ptr(v) },
+	"has_projects":           func(r *github.Repository, v bool) { r.HasProjects = github.Ptr(v) },
+	"has_wiki":               func(r *github.Repository, v bool) { r.HasWiki = github.Ptr(v) },
+	"allow_squash_merge":     func(r *github.Repository, v bool) { r.AllowSquashMerge = github.Ptr(v) },
+	"allow_merge_commit":     func(r *github.Repository, v bool) { r.AllowMergeCommit = github.Ptr(v) },
+	"allow_rebase_merge":     func(r *github.Repository, v bool) { r.AllowRebaseMerge = github.Ptr(v) },
+	"delete_branch_on_merge": func(r *github.Repository, v bool) { r.DeleteBranchOnMerge = github.Ptr(v) },
+}
+
+// UpdateRepositorySettings creates a tool to change repository feature and merge settings.
+func UpdateRepositorySettings(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "update_repository_settings",
+			Description: t("TOOL_UPDATE_REPOSITORY_SETTINGS_DESCRIPTION", "Update repository feature and merge settings. Only the settings you provide are changed."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_REPOSITORY_SETTINGS_USER_TITLE", "Update repository settings"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"has_issues": {
+						Type:        "boolean",
+						Description: "Enable or disable issues",
+					},
+					"has_projects": {
+						Type:        "boolean",
+						Description: "Enable or disable projects",
+					},
+					"has_wiki": {
+						Type:        "boolean",
+						Description: "Enable or disable the wiki",
+					},
+					"allow_squash_merge": {
+						Type:        "boolean",
+						Description: "Allow squash-merging pull requests",
+					},
+					"allow_merge_commit": {
+						Type:        "boolean",
+						Description: "Allow merging pull requests with a merge commit",
+					},
+					"allow_rebase_merge": {
+						Type:        "boolean",
+						Description: "Allow rebase-merging pull requests",
+					},
+					"delete_branch_on_merge": {
+						Type:        "boolean",
+						Description: "Automatically delete head branches after pull requests are merged",
+					},
+					"default_branch": {
+						Type:        "string",
+						Description: "Name of an existing branch to make the default branch",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			update := &github.Repository{}
+			changed := false
+			for name, set := range repositorySettingsToggles {
+				value, ok, err := OptionalParamOK[bool](args, name)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if ok {
+					set(update, value)
+					changed = true
+				}
+			}
+			defaultBranch, ok, err := OptionalParamOK[string](args, "default_branch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if ok && defaultBranch != "" {
+				update.DefaultBranch = github.Ptr(defaultBranch)
+				changed = true
+			}
+			if !changed {
+				return utils.NewToolResultError("no settings provided to update"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			updated, resp, err := client.Repositories.Edit(ctx, owner, repo, update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update settings for repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update repository settings", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"full_name":              updated.GetFullName(),
+				"default_branch":         updated.GetDefaultBranch(),
+				"has_issues":             updated.GetHasIssues(),
+				"has_projects":           updated.GetHasProjects(),
+				"has_wiki":               updated.GetHasWiki(),
+				"allow_squash_merge":     updated.GetAllowSquashMerge(),
+				"allow_merge_commit":     updated.GetAllowMergeCommit(),
+				"allow_rebase_merge":     updated.GetAllowRebaseMerge(),
+				"delete_branch_on_merge": updated.GetDeleteBranchOnMerge(),
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
 // FetchRepoIsPrivate returns whether a repository is private. It is a thin
 // wrapper around the GitHub Repositories.Get endpoint provided as a shared
 // helper for IFC label computation across tools.
@@ -958,13 +1162,7 @@ func getFileContentsTool(t translations.TranslationHelperFunc, includeFields boo
 				contentType := http.DetectContentType(contentBytes)
 
 				// Determine if content is text or binary based on detected content type
-				isTextContent := strings.HasPrefix(contentType, "text/") ||
-					contentType == "application/json" ||
-					contentType == "application/xml" ||
-					strings.HasSuffix(contentType, "+json") ||
-					strings.HasSuffix(contentType, "+xml")
-
-				if isTextContent {
+				if isTextContentType(contentType) {
 					result := &mcp.ResourceContents{
 						URI:      resourceURI,
 						Text:     content,
@@ -1008,6 +1206,173 @@ func getFileContentsTool(t translations.TranslationHelperFunc, includeFields boo
 	)
 }
 
+const (
+	// getFilesMaxPaths bounds how many files a single get_files call may fetch.
+	getFilesMaxPaths = 20
+	// getFilesConcurrency bounds how many raw content requests are in flight.
+	getFilesConcurrency = 5
+)
+
+// getFilesEntry is the result for one path of a get_files call. Exactly one
+// of Content or Error is set.
+type getFilesEntry struct {
+	Content   *string `json:"content,omitempty"`
+	Truncated bool    `json:"truncated,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// GetFiles creates a tool to get the contents of several files from a GitHub
+// repository in one call.
+func GetFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_files",
+			Description: t("TOOL_GET_FILES_DESCRIPTION", "Get the text contents of up to 20 files from a GitHub repository in one call. Returns a map of path to content, or to an error for paths that could not be fetched. Use get_file_contents for directories and binary files."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_FILES_USER_TITLE", "Get multiple file contents"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"paths": {
+						Type:        "array",
+						Description: "Paths of the files to fetch",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+						MinItems: jsonschema.Ptr(1),
+						MaxItems: jsonschema.Ptr(getFilesMaxPaths),
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Accepts optional git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or a commit SHA. Defaults to the repository's default branch",
+					},
+				},
+				Required: []string{"owner", "repo", "paths"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			paths, err := OptionalStringArrayParam(args, "paths")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(paths) == 0 {
+				return utils.NewToolResultError("missing required parameter: paths"), nil, nil
+			}
+			if len(paths) > getFilesMaxPaths {
+				return utils.NewToolResultError(fmt.Sprintf("too many paths: %d (maximum %d)", len(paths), getFilesMaxPaths)), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			rawClient, err := deps.GetRawClient(ctx)
+			if err != nil {
+				return utils.NewToolResultError("failed to get GitHub raw content client"), nil, nil
+			}
+
+			// Share the content window across the files so that asking for
+			// more files does not grow the response beyond it.
+			maxLines := max(deps.GetContentWindowSize()/len(paths), 1)
+			files := fetchFiles(ctx, rawClient, owner, repo, &raw.ContentOpts{Ref: ref}, paths, maxLines)
+
+			r, err := json.Marshal(map[string]any{"files": files})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, utils.NewToolResultText(string(r)), ifc.LabelGetFileContents), nil, nil
+		},
+	)
+}
+
+// fetchFiles fetches each path through the raw content API with at most
+// getFilesConcurrency requests in flight. A failure for one path is recorded
+// in its entry and does not affect the others.
+func fetchFiles(ctx context.Context, client *raw.Client, owner, repo string, opts *raw.ContentOpts, paths []string, maxLines int) map[string]getFilesEntry {
+	entries := make([]getFilesEntry, len(paths))
+	sem := make(chan struct{}, getFilesConcurrency)
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			entries[i] = fetchFile(ctx, client, owner, repo, opts, strings.TrimPrefix(path, "/"), maxLines)
+		}()
+	}
+	wg.Wait()
+
+	files := make(map[string]getFilesEntry, len(paths))
+	for i, path := range paths {
+		files[path] = entries[i]
+	}
+	return files
+}
+
+func fetchFile(ctx context.Context, client *raw.Client, owner, repo string, opts *raw.ContentOpts, path string, maxLines int) getFilesEntry {
+	resp, err := client.GetRawContent(ctx, owner, repo, path, opts)
+	if err != nil {
+		return getFilesEntry{Error: fmt.Sprintf("failed to get file contents: %v", err)}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return getFilesEntry{Error: "file not found"}
+	default:
+		return getFilesEntry{Error: fmt.Sprintf("failed to get file contents: unexpected status %d", resp.StatusCode)}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return getFilesEntry{Error: fmt.Sprintf("failed to read file contents: %v", err)}
+	}
+	if !isTextContentType(http.DetectContentType(body)) {
+		return getFilesEntry{Error: "binary file; use get_file_contents to fetch it"}
+	}
+
+	content := string(body)
+	truncated := false
+	if lines := strings.SplitAfter(strings.TrimSuffix(content, "\n"), "\n"); len(lines) > maxLines {
+		content = strings.Join(lines[:maxLines], "")
+		truncated = true
+	}
+	return getFilesEntry{Content: &content, Truncated: truncated}
+}
+
+// isTextContentType reports whether a detected content type is text that can
+// be returned as-is rather than base64 encoded.
+func isTextContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/") ||
+		contentType == "application/json" ||
+		contentType == "application/xml" ||
+		strings.HasSuffix(contentType, "+json") ||
+		strings.HasSuffix(contentType, "+xml")
+}
+
 // recordDirContentsFieldsUsage emits fields telemetry for a get_file_contents
 // directory listing. sentBytes is the size of the payload actually returned.
 func recordDirContentsFieldsUsage(ctx context.Context, deps ToolDependencies, full []*github.RepositoryContent, filtered bool, sentBytes int) {
@@ -1119,7 +1484,7 @@ func DeleteFile(t translations.TranslationHelperFunc) inventory.ServerTool {
 		ToolsetMetadataRepos,
 		mcp.Tool{
 			Name:        "delete_file",
-			Description: t("TOOL_DELETE_FILE_DESCRIPTION", "Delete a file from a GitHub repository"),
+			Description: t("TOOL_DELETE_FILE_DESCRIPTION", "Delete a file from a GitHub repository. Returns the SHA of the commit that deleted it."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_DELETE_FILE_USER_TITLE", "Delete file"),
 				ReadOnlyHint:    false,
@@ -1148,6 +1513,10 @@ func DeleteFile(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "Branch to delete the file from",
 					},
+					"sha": {
+						Type:        "string",
+						Description: "The blob SHA of the file being deleted. If omitted, the file's current SHA on the branch is used.",
+					},
 				},
 				Required: []string{"owner", "repo", "path", "message", "branch"},
 			},
@@ -1174,128 +1543,74 @@ func DeleteFile(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-
-			client, err := deps.GetClient(ctx)
+			sha, err := OptionalParam[string](args, "sha")
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			// Get the reference for the branch
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+			client, err := deps.GetClient(ctx)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get branch reference: %w", err)
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			defer func() { _ = resp.Body.Close() }()
 
-			// Get the commit object that the branch points to
-			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get base commit",
-					resp,
-					err,
-				), nil, nil
-			}
-			defer func() { _ = resp.Body.Close() }()
+			path = strings.TrimPrefix(path, "/")
+			notFoundMsg := fmt.Sprintf("file %s does not exist on branch %s", path, branch)
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+			if sha == "" {
+				existingFile, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: branch})
+				if resp != nil {
+					_ = resp.Body.Close()
 				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get commit", resp, body), nil, nil
-			}
-
-			// Create a tree entry for the file deletion by setting SHA to nil
-			treeEntries := []*github.TreeEntry{
-				{
-					Path: github.Ptr(path),
-					Mode: github.Ptr("100644"), // Regular file mode
-					Type: github.Ptr("blob"),
-					SHA:  nil, // Setting SHA to nil deletes the file
-				},
+				switch {
+				case err != nil:
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return utils.NewToolResultError(notFoundMsg), nil, nil
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get file SHA",
+						resp,
+						err,
+					), nil, nil
+				case dirContent != nil:
+					return utils.NewToolResultError(fmt.Sprintf(
+						"Path %s is a directory, not a file. This tool only works with files.",
+						path)), nil, nil
+				}
+				sha = existingFile.GetSHA()
 			}
 
-			// Create a new tree with the deletion
-			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, treeEntries)
+			result, resp, err := client.Repositories.DeleteFile(ctx, owner, repo, path, &github.RepositoryContentFileOptions{
+				Message: github.Ptr(message),
+				SHA:     github.Ptr(sha),
+				Branch:  github.Ptr(branch),
+			})
 			if err != nil {
+				// GitHub also answers 404 when the token cannot write to the
+				// repository, so check that the file is really missing.
+				if resp != nil && resp.StatusCode == http.StatusNotFound && contentsPathMissing(ctx, client, owner, repo, path, branch) {
+					return utils.NewToolResultError(notFoundMsg), nil, nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to create tree",
+					"failed to delete file",
 					resp,
 					err,
 				), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusCreated {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to create tree", resp, body), nil, nil
-			}
+			return MarshalledTextResult(convertToMinimalFileContentResponse(result)), nil, nil
+		},
+	)
+}
 
-			// Create a new commit with the new tree
-			commit := github.Commit{
-				Message: github.Ptr(message),
-				Tree:    newTree,
-				Parents: []*github.Commit{{SHA: baseCommit.SHA}},
-			}
-			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to create commit",
-					resp,
-					err,
-				), nil, nil
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusCreated {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to create commit", resp, body), nil, nil
-			}
-
-			// Update the branch reference to point to the new commit
-			ref.Object.SHA = newCommit.SHA
-			_, resp, err = client.Git.UpdateRef(ctx, owner, repo, *ref.Ref, github.UpdateRef{
-				SHA:   *newCommit.SHA,
-				Force: github.Ptr(false),
-			})
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to update reference",
-					resp,
-					err,
-				), nil, nil
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update reference", resp, body), nil, nil
-			}
-
-			// Create a response similar to what the DeleteFile API would return
-			response := map[string]any{
-				"commit":  newCommit,
-				"content": nil,
-			}
-
-			r, err := json.Marshal(response)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return utils.NewToolResultText(string(r)), nil, nil
-		},
-	)
+// contentsPathMissing reports whether GetContents confirms that path does not
+// exist on branch.
+func contentsPathMissing(ctx context.Context, client *github.Client, owner, repo, path, branch string) bool {
+	_, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: branch})
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return err != nil && resp != nil && resp.StatusCode == http.StatusNotFound
 }
 
 // CreateBranch creates a tool to create a new branch.
@@ -1903,13 +2218,1200 @@ func listReleasesTool(t translations.TranslationHelperFunc, includeFields bool)
 	return NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
-			Name:        "list_releases",
-			Description: t("TOOL_LIST_RELEASES_DESCRIPTION", "List releases in a GitHub repository"),
+			Name:        "list_releases",
+			Description: t("TOOL_LIST_RELEASES_DESCRIPTION", "List releases in a GitHub repository"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_RELEASES_USER_TITLE", "List releases"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var fields []string
+			if includeFields {
+				fields, err = OptionalStringArrayParam(args, "fields")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list releases: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list releases", resp, body), nil, nil
+			}
+
+			minimalReleases := make([]MinimalRelease, 0, len(releases))
+			for _, release := range releases {
+				if release != nil {
+					minimalReleases = append(minimalReleases, convertToMinimalRelease(release))
+				}
+			}
+
+			filtered := false
+			var payload any = minimalReleases
+			if includeFields && len(fields) > 0 {
+				filteredReleases, err := filterEachField(minimalReleases, fields)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to filter releases", err), nil, nil
+				}
+				payload = filteredReleases
+				filtered = true
+			}
+
+			r, err := json.Marshal(payload)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			if includeFields {
+				recordFieldsUsageFor(ctx, deps, "list_releases", minimalReleases, filtered, len(r))
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// Releases are published by collaborators with push access, so
+			// integrity is trusted. Confidentiality follows repo visibility,
+			// but draft releases are visible only to push-access users and are
+			// not world-readable even on a public repo, so the result is only
+			// public when no returned release is a draft.
+			hasDraft := false
+			for _, mr := range minimalReleases {
+				if mr.Draft {
+					hasDraft = true
+					break
+				}
+			}
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result,
+				func(isPrivate bool) ifc.SecurityLabel {
+					return ifc.LabelRelease(isPrivate, hasDraft)
+				})
+			return result, nil, nil
+		},
+	)
+}
+
+// GetLatestRelease creates a tool to get the latest release in a GitHub repository.
+func GetLatestRelease(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_latest_release",
+			Description: t("TOOL_GET_LATEST_RELEASE_DESCRIPTION", "Get the latest release in a GitHub repository"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_LATEST_RELEASE_USER_TITLE", "Get latest release"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get latest release: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get latest release", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(release)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// Releases are published by collaborators with push access, so
+			// integrity is trusted. The "latest release" endpoint never returns
+			// a draft, but the draft flag is honored defensively: a draft is
+			// not world-readable even on a public repo.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result,
+				func(isPrivate bool) ifc.SecurityLabel {
+					return ifc.LabelRelease(isPrivate, release.GetDraft())
+				})
+			return result, nil, nil
+		},
+	)
+}
+
+func GetReleaseByTag(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_release_by_tag",
+			Description: t("TOOL_GET_RELEASE_BY_TAG_DESCRIPTION", "Get a specific release by its tag name in a GitHub repository"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_RELEASE_BY_TAG_USER_TITLE", "Get a release by tag name"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"tag": {
+						Type:        "string",
+						Description: "Tag name (e.g., 'v1.0.0')",
+					},
+				},
+				Required: []string{"owner", "repo", "tag"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			tag, err := RequiredParam[string](args, "tag")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			release, resp, err := client.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get release by tag: %s", tag),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get release by tag", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(release)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// Releases are published by collaborators with push access, so
+			// integrity is trusted. A release fetched by tag may be a draft,
+			// which is visible only to push-access users and not world-readable
+			// even on a public repo, so a draft forces private confidentiality.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result,
+				func(isPrivate bool) ifc.SecurityLabel {
+					return ifc.LabelRelease(isPrivate, release.GetDraft())
+				})
+			return result, nil, nil
+		},
+	)
+}
+
+// ListStarredRepositories creates a tool to list starred repositories for the authenticated user or a specified user.
+func ListStarredRepositories(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataStargazers,
+		mcp.Tool{
+			Name:        "list_starred_repositories",
+			Description: t("TOOL_LIST_STARRED_REPOSITORIES_DESCRIPTION", "List starred repositories"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_STARRED_REPOSITORIES_USER_TITLE", "List starred repositories"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "Username to list starred repositories for. Defaults to the authenticated user.",
+					},
+					"sort": {
+						Type:        "string",
+						Description: "How to sort the results. Can be either 'created' (when the repository was starred) or 'updated' (when the repository was last pushed to).",
+						Enum:        []any{"created", "updated"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "The direction to sort the results by.",
+						Enum:        []any{"asc", "desc"},
+					},
+				},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			username, err := OptionalParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.ActivityListStarredOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if sort != "" {
+				opts.Sort = sort
+			}
+			if direction != "" {
+				opts.Direction = direction
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var repos []*github.StarredRepository
+			var resp *github.Response
+			if username == "" {
+				// List starred repositories for the authenticated user
+				repos, resp, err = client.Activity.ListStarred(ctx, "", opts)
+			} else {
+				// List starred repositories for a specific user
+				repos, resp, err = client.Activity.ListStarred(ctx, username, opts)
+			}
+
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list starred repositories for user '%s'", username),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list starred repositories", resp, body), nil, nil
+			}
+
+			// Convert to minimal format
+			minimalRepos := make([]MinimalRepository, 0, len(repos))
+			for _, starredRepo := range repos {
+				repo := starredRepo.Repository
+				minimalRepo := MinimalRepository{
+					ID:            repo.GetID(),
+					Name:          repo.GetName(),
+					FullName:      repo.GetFullName(),
+					Description:   repo.GetDescription(),
+					HTMLURL:       repo.GetHTMLURL(),
+					Language:      repo.GetLanguage(),
+					Stars:         repo.GetStargazersCount(),
+					Forks:         repo.GetForksCount(),
+					OpenIssues:    repo.GetOpenIssuesCount(),
+					Private:       repo.GetPrivate(),
+					Fork:          repo.GetFork(),
+					Archived:      repo.GetArchived(),
+					DefaultBranch: repo.GetDefaultBranch(),
+				}
+
+				if repo.UpdatedAt != nil {
+					minimalRepo.UpdatedAt = repo.UpdatedAt.Format("2006-01-02T15:04:05Z")
+				}
+
+				minimalRepos = append(minimalRepos, minimalRepo)
+			}
+
+			r, err := json.Marshal(minimalRepos)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal starred repositories: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// A starred-repository listing exposes repository data across many
+			// repos; reuse the multi-repo join shared with search_repositories
+			// (public-only results stay public-untrusted, mixed-visibility
+			// results become private-untrusted, all-private results become
+			// private-trusted). Visibility is read directly from the response,
+			// so no extra API call is needed.
+			visibilities := make([]bool, 0, len(minimalRepos))
+			for _, mr := range minimalRepos {
+				visibilities = append(visibilities, mr.Private)
+			}
+			result = attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelSearchIssues)
+			return result, nil, nil
+		},
+	)
+}
+
+// ListOrgRepos creates a tool to list the repositories of an organization.
+func ListOrgRepos(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_org_repos",
+			Description: t("TOOL_LIST_ORG_REPOS_DESCRIPTION", "List repositories of a GitHub organization, optionally filtered by type and sorted"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ORG_REPOS_USER_TITLE", "List organization repositories"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: "Organization name",
+					},
+					"type": {
+						Type:        "string",
+						Description: "Type of repositories to list. Defaults to 'all'.",
+						Enum:        []any{"all", "public", "private", "forks", "sources", "member"},
+					},
+					"sort": {
+						Type:        "string",
+						Description: "Property to sort the results by. Defaults to 'created'.",
+						Enum:        []any{"created", "updated", "pushed", "full_name"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "The direction to sort the results by. Defaults to 'asc' when sorting by full_name, otherwise 'desc'.",
+						Enum:        []any{"asc", "desc"},
+					},
+				},
+				Required: []string{"org"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo, scopes.ReadOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repoType, err := OptionalParam[string](args, "type")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.RepositoryListByOrgOptions{
+				Type:      repoType,
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repos, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list repositories for organization '%s'", org),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list organization repositories", resp, body), nil, nil
+			}
+
+			minimalRepos := make([]MinimalRepository, 0, len(repos))
+			visibilities := make([]bool, 0, len(repos))
+			for _, repo := range repos {
+				minimalRepos = append(minimalRepos, convertToMinimalRepository(repo))
+				visibilities = append(visibilities, repo.GetPrivate())
+			}
+
+			r, err := json.Marshal(minimalRepos)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal organization repositories: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelSearchIssues)
+			return result, nil, nil
+		},
+	)
+}
+
+// ListMyRepos creates a tool to list repositories the authenticated user can access.
+func ListMyRepos(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_my_repos",
+			Description: t("TOOL_LIST_MY_REPOS_DESCRIPTION", "List repositories the authenticated user has access to, optionally filtered by visibility and affiliation"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_MY_REPOS_USER_TITLE", "List my repositories"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"visibility": {
+						Type:        "string",
+						Description: "Limit results to repositories with this visibility. Defaults to 'all'.",
+						Enum:        []any{"all", "public", "private"},
+					},
+					"affiliation": {
+						Type:        "array",
+						Description: "How the user is related to the repositories. Defaults to all affiliations.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+							Enum: []any{"owner", "collaborator", "organization_member"},
+						},
+					},
+					"sort": {
+						Type:        "string",
+						Description: "Property to sort the results by. Defaults to 'full_name'.",
+						Enum:        []any{"created", "updated", "pushed", "full_name"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "The direction to sort the results by. Defaults to 'asc' when sorting by full_name, otherwise 'desc'.",
+						Enum:        []any{"asc", "desc"},
+					},
+				},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			visibility, err := OptionalParam[string](args, "visibility")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			affiliation, err := OptionalStringArrayParam(args, "affiliation")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.RepositoryListByAuthenticatedUserOptions{
+				Visibility:  visibility,
+				Affiliation: strings.Join(affiliation, ","),
+				Sort:        sort,
+				Direction:   direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repos, resp, err := client.Repositories.ListByAuthenticatedUser(ctx, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repositories for the authenticated user", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list repositories for the authenticated user", resp, body), nil, nil
+			}
+
+			minimalRepos := make([]MinimalRepository, 0, len(repos))
+			visibilities := make([]bool, 0, len(repos))
+			for _, repo := range repos {
+				minimalRepos = append(minimalRepos, convertToMinimalRepository(repo))
+				visibilities = append(visibilities, repo.GetPrivate())
+			}
+
+			r, err := json.Marshal(minimalRepos)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal repositories: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelSearchIssues)
+			return result, nil, nil
+		},
+	)
+}
+
+// GetReadme creates a tool to get the README of a repository or one of its directories.
+func GetReadme(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_readme",
+			Description: t("TOOL_GET_README_DESCRIPTION", "Get the README of a GitHub repository, or of a directory within it, without needing to know the exact filename"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_README_USER_TITLE", "Get repository README"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"path": {
+						Type:        "string",
+						Description: "Directory to look for a README in. Defaults to the repository root.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag, or commit SHA to read from. Defaults to the default branch.",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			dir, err := OptionalParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var readme *github.RepositoryContent
+			var resp *github.Response
+			dir = strings.Trim(dir, "/")
+			if dir == "" {
+				readme, resp, err = client.Repositories.GetReadme(ctx, owner, repo, &github.RepositoryContentGetOptions{Ref: ref})
+			} else {
+				// go-github has no wrapper for the directory README endpoint.
+				segments := strings.Split(dir, "/")
+				for i, segment := range segments {
+					segments[i] = url.PathEscape(segment)
+				}
+				apiURL := fmt.Sprintf("repos/%s/%s/readme/%s", owner, repo, strings.Join(segments, "/"))
+				if ref != "" {
+					apiURL += "?ref=" + url.QueryEscape(ref)
+				}
+				req, reqErr := client.NewRequest(ctx, http.MethodGet, apiURL, nil)
+				if reqErr != nil {
+					return utils.NewToolResultErrorFromErr("failed to create request", reqErr), nil, nil
+				}
+				resp, err = client.Do(req, &readme)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get README for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			content, err := readme.GetContent()
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to decode README content: %s", err)), nil, nil
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"name":     readme.GetName(),
+				"path":     readme.GetPath(),
+				"sha":      readme.GetSHA(),
+				"html_url": readme.GetHTMLURL(),
+				"content":  content,
+			})
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelGetFileContents)
+			return result, nil, nil
+		},
+	)
+}
+
+// GetArchiveLink creates a tool to get a download link for a repository archive.
+func GetArchiveLink(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_archive_link",
+			Description: t("TOOL_GET_ARCHIVE_LINK_DESCRIPTION", "Get a temporary download URL for a tarball or zipball archive of a repository at a given ref"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ARCHIVE_LINK_USER_TITLE", "Get repository archive link"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"format": {
+						Type:        "string",
+						Description: "Archive format",
+						Enum:        []any{"tarball", "zipball"},
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag, or commit SHA to archive. Defaults to the default branch.",
+					},
+				},
+				Required: []string{"owner", "repo", "format"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			format, err := RequiredParam[string](args, "format")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var archiveFormat github.ArchiveFormat
+			switch format {
+			case "tarball":
+				archiveFormat = github.Tarball
+			case "zipball":
+				archiveFormat = github.Zipball
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid format %q: must be tarball or zipball", format)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			archiveURL, resp, err := client.Repositories.GetArchiveLink(ctx, owner, repo, archiveFormat, &github.RepositoryContentGetOptions{Ref: ref}, 1)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get %s link for %s/%s", format, owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]any{
+				"archive_url": archiveURL.String(),
+				"format":      format,
+				"ref":         ref,
+				"note":        "The archive_url is a temporary signed link that expires after a few minutes.",
+			})
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// ListAutolinks creates a tool to list the autolink references of a repository.
+func ListAutolinks(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_autolinks",
+			Description: t("TOOL_LIST_AUTOLINKS_DESCRIPTION", "List the autolink references configured for a repository. Requires admin access to the repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_AUTOLINKS_USER_TITLE", "List repository autolinks"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			autolinks, resp, err := client.Repositories.ListAutolinks(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list autolinks for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(autolinks)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal autolinks", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+// CreateAutolink creates a tool to add an autolink reference to a repository.
+func CreateAutolink(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "create_autolink",
+			Description: t("TOOL_CREATE_AUTOLINK_DESCRIPTION", "Create an autolink reference that turns references like TICKET-123 into links to an external system. Requires admin access to the repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_AUTOLINK_USER_TITLE", "Create repository autolink"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"key_prefix": {
+						Type:        "string",
+						Description: "Prefix that triggers the link, e.g. 'TICKET-'",
+					},
+					"url_template": {
+						Type:        "string",
+						Description: "URL to link to. Must contain <num> for the reference number, e.g. 'https://example.com/TICKET?query=<num>'",
+					},
+					"is_alphanumeric": {
+						Type:        "boolean",
+						Description: "Whether the reference matches alphanumeric characters (true) or only digits (false). Defaults to true.",
+						Default:     json.RawMessage("true"),
+					},
+				},
+				Required: []string{"owner", "repo", "key_prefix", "url_template"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			keyPrefix, err := RequiredParam[string](args, "key_prefix")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			urlTemplate, err := RequiredParam[string](args, "url_template")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			isAlphanumeric, err := OptionalBoolParamWithDefault(args, "is_alphanumeric", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			if !strings.Contains(urlTemplate, "<num>") {
+				return utils.NewToolResultError("url_template must contain <num> where the reference number is substituted"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			autolink, resp, err := client.Repositories.AddAutolink(ctx, owner, repo, &github.AutolinkOptions{
+				KeyPrefix:      github.Ptr(keyPrefix),
+				URLTemplate:    github.Ptr(urlTemplate),
+				IsAlphanumeric: github.Ptr(isAlphanumeric),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create autolink for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(autolink)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal autolink", err), nil, nil
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// ListDeployKeys creates a tool to list the deploy keys of a repository.
+func ListDeployKeys(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_deploy_keys",
+			Description: t("TOOL_LIST_DEPLOY_KEYS_DESCRIPTION", "List the deploy keys of a repository"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_DEPLOY_KEYS_USER_TITLE", "List deploy keys"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			keys, resp, err := client.Repositories.ListKeys(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list deploy keys for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalKeys := make([]MinimalDeployKey, 0, len(keys))
+			for _, key := range keys {
+				minimalKeys = append(minimalKeys, convertToMinimalDeployKey(key))
+			}
+
+			r, err := json.Marshal(minimalKeys)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal deploy keys", err), nil, nil
+			}
+
+			result := utils.NewToolResultText(string(r))
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+// CreateDeployKey creates a tool to add a deploy key to a repository.
+func CreateDeployKey(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "create_deploy_key",
+			Description: t("TOOL_CREATE_DEPLOY_KEY_DESCRIPTION", "Add a deploy key to a repository, granting the holder of the private key SSH access to it"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_CREATE_DEPLOY_KEY_USER_TITLE", "Create deploy key"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Name for the key",
+					},
+					"key": {
+						Type:        "string",
+						Description: "The contents of the public SSH key",
+					},
+					"read_only": {
+						Type:        "boolean",
+						Description: "If true, the key can only read from the repository. Defaults to true.",
+						Default:     json.RawMessage("true"),
+					},
+				},
+				Required: []string{"owner", "repo", "title", "key"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			title, err := RequiredParam[string](args, "title")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			publicKey, err := RequiredParam[string](args, "key")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			readOnly, err := OptionalBoolParamWithDefault(args, "read_only", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			key, resp, err := client.Repositories.CreateKey(ctx, owner, repo, &github.Key{
+				Title:    github.Ptr(title),
+				Key:      github.Ptr(publicKey),
+				ReadOnly: github.Ptr(readOnly),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create deploy key for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(convertToMinimalDeployKey(key))
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal deploy key", err), nil, nil
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// DeleteDeployKey creates a tool to remove a deploy key from a repository.
+func DeleteDeployKey(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "delete_deploy_key",
+			Description: t("TOOL_DELETE_DEPLOY_KEY_DESCRIPTION", "Delete a deploy key from a repository, revoking its access"),
 			Annotations: &mcp.ToolAnnotations{
-				Title:        t("TOOL_LIST_RELEASES_USER_TITLE", "List releases"),
-				ReadOnlyHint: true,
+				Title:           t("TOOL_DELETE_DEPLOY_KEY_USER_TITLE", "Delete deploy key"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"key_id": {
+						Type:        "number",
+						Description: "ID of the deploy key, as returned by list_deploy_keys",
+					},
+				},
+				Required: []string{"owner", "repo", "key_id"},
 			},
-			InputSchema: schema,
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
@@ -1921,100 +3423,46 @@ func listReleasesTool(t translations.TranslationHelperFunc, includeFields bool)
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			var fields []string
-			if includeFields {
-				fields, err = OptionalStringArrayParam(args, "fields")
-				if err != nil {
-					return utils.NewToolResultError(err.Error()), nil, nil
-				}
-			}
-			pagination, err := OptionalPaginationParams(args)
+			keyID, err := RequiredBigInt(args, "key_id")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			opts := &github.ListOptions{
-				Page:    pagination.Page,
-				PerPage: pagination.PerPage,
-			}
-
 			client, err := deps.GetClient(ctx)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
-			releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, opts)
+			resp, err := client.Repositories.DeleteKey(ctx, owner, repo, keyID)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to list releases: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete deploy key %d from %s/%s", keyID, owner, repo),
+					resp,
+					err,
+				), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list releases", resp, body), nil, nil
-			}
-
-			minimalReleases := make([]MinimalRelease, 0, len(releases))
-			for _, release := range releases {
-				if release != nil {
-					minimalReleases = append(minimalReleases, convertToMinimalRelease(release))
-				}
-			}
-
-			filtered := false
-			var payload any = minimalReleases
-			if includeFields && len(fields) > 0 {
-				filteredReleases, err := filterEachField(minimalReleases, fields)
-				if err != nil {
-					return utils.NewToolResultErrorFromErr("failed to filter releases", err), nil, nil
-				}
-				payload = filteredReleases
-				filtered = true
-			}
-
-			r, err := json.Marshal(payload)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			if includeFields {
-				recordFieldsUsageFor(ctx, deps, "list_releases", minimalReleases, filtered, len(r))
-			}
-
-			result := utils.NewToolResultText(string(r))
-			// Releases are published by collaborators with push access, so
-			// integrity is trusted. Confidentiality follows repo visibility,
-			// but draft releases are visible only to push-access users and are
-			// not world-readable even on a public repo, so the result is only
-			// public when no returned release is a draft.
-			hasDraft := false
-			for _, mr := range minimalReleases {
-				if mr.Draft {
-					hasDraft = true
-					break
-				}
-			}
-			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result,
-				func(isPrivate bool) ifc.SecurityLabel {
-					return ifc.LabelRelease(isPrivate, hasDraft)
-				})
-			return result, nil, nil
+			return utils.NewToolResultText(fmt.Sprintf("Successfully deleted deploy key %d from %s/%s", keyID, owner, repo)), nil, nil
 		},
 	)
 }
 
-// GetLatestRelease creates a tool to get the latest release in a GitHub repository.
-func GetLatestRelease(t translations.TranslationHelperFunc) inventory.ServerTool {
+// pagesNotConfiguredMessage is returned instead of an error when a repository
+// has no GitHub Pages site, which the API reports as a 404.
+func pagesNotConfiguredMessage(owner, repo string) string {
+	return fmt.Sprintf("GitHub Pages is not configured for %s/%s", owner, repo)
+}
+
+// GetPages creates a tool to get the GitHub Pages configuration of a repository.
+func GetPages(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
-			Name:        "get_latest_release",
-			Description: t("TOOL_GET_LATEST_RELEASE_DESCRIPTION", "Get the latest release in a GitHub repository"),
+			Name:        "get_pages",
+			Description: t("TOOL_GET_PAGES_DESCRIPTION", "Get the GitHub Pages site of a repository, including its URL, build status, source branch and path, and custom domain"),
 			Annotations: &mcp.ToolAnnotations{
-				Title:        t("TOOL_GET_LATEST_RELEASE_USER_TITLE", "Get latest release"),
+				Title:        t("TOOL_GET_PAGES_USER_TITLE", "Get GitHub Pages site"),
 				ReadOnlyHint: true,
 			},
 			InputSchema: &jsonschema.Schema{
@@ -2045,53 +3493,46 @@ func GetLatestRelease(t translations.TranslationHelperFunc) inventory.ServerTool
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
-			release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+			pages, resp, err := client.Repositories.GetPagesInfo(ctx, owner, repo)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get latest release: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return utils.NewToolResultText(pagesNotConfiguredMessage(owner, repo)), nil, nil
 				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get latest release", resp, body), nil, nil
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get GitHub Pages site for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
 			}
+			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(release)
+			r, err := json.Marshal(convertToMinimalPages(pages))
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+				return utils.NewToolResultErrorFromErr("failed to marshal GitHub Pages site", err), nil, nil
 			}
 
 			result := utils.NewToolResultText(string(r))
-			// Releases are published by collaborators with push access, so
-			// integrity is trusted. The "latest release" endpoint never returns
-			// a draft, but the draft flag is honored defensively: a draft is
-			// not world-readable even on a public repo.
-			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result,
-				func(isPrivate bool) ifc.SecurityLabel {
-					return ifc.LabelRelease(isPrivate, release.GetDraft())
-				})
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
 			return result, nil, nil
 		},
 	)
 }
 
-func GetReleaseByTag(t translations.TranslationHelperFunc) inventory.ServerTool {
+// ListPagesBuilds creates a tool to list the recent GitHub Pages builds of a repository.
+func ListPagesBuilds(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
-			Name:        "get_release_by_tag",
-			Description: t("TOOL_GET_RELEASE_BY_TAG_DESCRIPTION", "Get a specific release by its tag name in a GitHub repository"),
+			Name:        "list_pages_builds",
+			Description: t("TOOL_LIST_PAGES_BUILDS_DESCRIPTION", "List the recent GitHub Pages builds of a repository, most recent first"),
 			Annotations: &mcp.ToolAnnotations{
-				Title:        t("TOOL_GET_RELEASE_BY_TAG_USER_TITLE", "Get a release by tag name"),
+				Title:        t("TOOL_LIST_PAGES_BUILDS_USER_TITLE", "List GitHub Pages builds"),
 				ReadOnlyHint: true,
 			},
-			InputSchema: &jsonschema.Schema{
+			InputSchema: WithPagination(&jsonschema.Schema{
 				Type: "object",
 				Properties: map[string]*jsonschema.Schema{
 					"owner": {
@@ -2102,13 +3543,9 @@ func GetReleaseByTag(t translations.TranslationHelperFunc) inventory.ServerTool
 						Type:        "string",
 						Description: "Repository name",
 					},
-					"tag": {
-						Type:        "string",
-						Description: "Tag name (e.g., 'v1.0.0')",
-					},
 				},
-				Required: []string{"owner", "repo", "tag"},
-			},
+				Required: []string{"owner", "repo"},
+			}),
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
@@ -2120,95 +3557,91 @@ func GetReleaseByTag(t translations.TranslationHelperFunc) inventory.ServerTool
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			tag, err := RequiredParam[string](args, "tag")
+			pagination, err := OptionalPaginationParams(args)
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
-			release, resp, err := client.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+			builds, resp, err := client.Repositories.ListPagesBuilds(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
 			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return utils.NewToolResultText(pagesNotConfiguredMessage(owner, repo)), nil, nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					fmt.Sprintf("failed to get release by tag: %s", tag),
+					fmt.Sprintf("failed to list GitHub Pages builds for %s/%s", owner, repo),
 					resp,
 					err,
 				), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get release by tag", resp, body), nil, nil
+			minimalBuilds := make([]MinimalPagesBuild, 0, len(builds))
+			for _, build := range builds {
+				minimalBuilds = append(minimalBuilds, convertToMinimalPagesBuild(build))
 			}
 
-			r, err := json.Marshal(release)
+			r, err := json.Marshal(minimalBuilds)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+				return utils.NewToolResultErrorFromErr("failed to marshal GitHub Pages builds", err), nil, nil
 			}
 
 			result := utils.NewToolResultText(string(r))
-			// Releases are published by collaborators with push access, so
-			// integrity is trusted. A release fetched by tag may be a draft,
-			// which is visible only to push-access users and not world-readable
-			// even on a public repo, so a draft forces private confidentiality.
-			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result,
-				func(isPrivate bool) ifc.SecurityLabel {
-					return ifc.LabelRelease(isPrivate, release.GetDraft())
-				})
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
 			return result, nil, nil
 		},
 	)
 }
 
-// ListStarredRepositories creates a tool to list starred repositories for the authenticated user or a specified user.
-func ListStarredRepositories(t translations.TranslationHelperFunc) inventory.ServerTool {
+// ListForks creates a tool to list the forks of a repository.
+func ListForks(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
-		ToolsetMetadataStargazers,
+		ToolsetMetadataRepos,
 		mcp.Tool{
-			Name:        "list_starred_repositories",
-			Description: t("TOOL_LIST_STARRED_REPOSITORIES_DESCRIPTION", "List starred repositories"),
+			Name:        "list_forks",
+			Description: t("TOOL_LIST_FORKS_DESCRIPTION", "List forks of a GitHub repository"),
 			Annotations: &mcp.ToolAnnotations{
-				Title:        t("TOOL_LIST_STARRED_REPOSITORIES_USER_TITLE", "List starred repositories"),
+				Title:        t("TOOL_LIST_FORKS_USER_TITLE", "List repository forks"),
 				ReadOnlyHint: true,
 			},
 			InputSchema: WithPagination(&jsonschema.Schema{
 				Type: "object",
 				Properties: map[string]*jsonschema.Schema{
-					"username": {
+					"owner": {
 						Type:        "string",
-						Description: "Username to list starred repositories for. Defaults to the authenticated user.",
+						Description: "Repository owner",
 					},
-					"sort": {
+					"repo": {
 						Type:        "string",
-						Description: "How to sort the results. Can be either 'created' (when the repository was starred) or 'updated' (when the repository was last pushed to).",
-						Enum:        []any{"created", "updated"},
+						Description: "Repository name",
 					},
-					"direction": {
+					"sort": {
 						Type:        "string",
-						Description: "The direction to sort the results by.",
-						Enum:        []any{"asc", "desc"},
+						Description: "How to sort the forks. Defaults to 'newest'.",
+						Enum:        []any{"newest", "oldest", "stargazers"},
 					},
 				},
+				Required: []string{"owner", "repo"},
 			}),
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			username, err := OptionalParam[string](args, "username")
+			owner, err := RequiredParam[string](args, "owner")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			sort, err := OptionalParam[string](args, "sort")
+			repo, err := RequiredParam[string](args, "repo")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			direction, err := OptionalParam[string](args, "direction")
+			sort, err := OptionalParam[string](args, "sort")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -2217,94 +3650,60 @@ func ListStarredRepositories(t translations.TranslationHelperFunc) inventory.Ser
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			opts := &github.ActivityListStarredOptions{
+			opts := &github.RepositoryListForksOptions{
+				Sort: sort,
 				ListOptions: github.ListOptions{
 					Page:    pagination.Page,
 					PerPage: pagination.PerPage,
 				},
 			}
-			if sort != "" {
-				opts.Sort = sort
-			}
-			if direction != "" {
-				opts.Direction = direction
-			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			var repos []*github.StarredRepository
-			var resp *github.Response
-			if username == "" {
-				// List starred repositories for the authenticated user
-				repos, resp, err = client.Activity.ListStarred(ctx, "", opts)
-			} else {
-				// List starred repositories for a specific user
-				repos, resp, err = client.Activity.ListStarred(ctx, username, opts)
-			}
-
+			forks, resp, err := client.Repositories.ListForks(ctx, owner, repo, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					fmt.Sprintf("failed to list starred repositories for user '%s'", username),
+					fmt.Sprintf("failed to list forks for repository %s/%s", owner, repo),
 					resp,
 					err,
 				), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list starred repositories", resp, body), nil, nil
-			}
-
-			// Convert to minimal format
-			minimalRepos := make([]MinimalRepository, 0, len(repos))
-			for _, starredRepo := range repos {
-				repo := starredRepo.Repository
-				minimalRepo := MinimalRepository{
-					ID:            repo.GetID(),
-					Name:          repo.GetName(),
-					FullName:      repo.GetFullName(),
-					Description:   repo.GetDescription(),
-					HTMLURL:       repo.GetHTMLURL(),
-					Language:      repo.GetLanguage(),
-					Stars:         repo.GetStargazersCount(),
-					Forks:         repo.GetForksCount(),
-					OpenIssues:    repo.GetOpenIssuesCount(),
-					Private:       repo.GetPrivate(),
-					Fork:          repo.GetFork(),
-					Archived:      repo.GetArchived(),
-					DefaultBranch: repo.GetDefaultBranch(),
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list forks", resp, body), nil, nil
+			}
+
+			minimalForks := make([]MinimalFork, 0, len(forks))
+			visibilities := make([]bool, 0, len(forks))
+			for _, fork := range forks {
+				minimalFork := MinimalFork{
+					FullName: fork.GetFullName(),
+					Owner:    fork.GetOwner().GetLogin(),
+					HTMLURL:  fork.GetHTMLURL(),
+					Stars:    fork.GetStargazersCount(),
+					Private:  fork.GetPrivate(),
 				}
-
-				if repo.UpdatedAt != nil {
-					minimalRepo.UpdatedAt = repo.UpdatedAt.Format("2006-01-02T15:04:05Z")
+				if fork.PushedAt != nil {
+					minimalFork.PushedAt = fork.PushedAt.Format("2006-01-02T15:04:05Z")
 				}
-
-				minimalRepos = append(minimalRepos, minimalRepo)
+				minimalForks = append(minimalForks, minimalFork)
+				visibilities = append(visibilities, fork.GetPrivate())
 			}
 
-			r, err := json.Marshal(minimalRepos)
+			r, err := json.Marshal(minimalForks)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal starred repositories: %w", err)
+				return nil, nil, fmt.Errorf("failed to marshal forks: %w", err)
 			}
 
 			result := utils.NewToolResultText(string(r))
-			// A starred-repository listing exposes repository data across many
-			// repos; reuse the multi-repo join shared with search_repositories
-			// (public-only results stay public-untrusted, mixed-visibility
-			// results become private-untrusted, all-private results become
-			// private-trusted). Visibility is read directly from the response,
-			// so no extra API call is needed.
-			visibilities := make([]bool, 0, len(minimalRepos))
-			for _, mr := range minimalRepos {
-				visibilities = append(visibilities, mr.Private)
-			}
 			result = attachJoinedIFCLabel(ctx, deps, result, visibilities, ifc.LabelSearchIssues)
 			return result, nil, nil
 		},
@@ -2956,13 +4355,13 @@ func ListRepositoryCollaborators(t translations.TranslationHelperFunc) inventory
 				})
 			}
 
-			response := map[string]any{
+			response := withEmptyListMessage(map[string]any{
 				"items":     result,
 				"nextPage":  resp.NextPage,
 				"prevPage":  resp.PrevPage,
 				"firstPage": resp.FirstPage,
 				"lastPage":  resp.LastPage,
-			}
+			}, "items", result)
 
 			callResult := MarshalledTextResult(response)
 			// The collaborator roster is GitHub-maintained membership data
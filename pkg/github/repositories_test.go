@@ -755,6 +755,118 @@ func Test_GetFileContents_IFC_InsidersMode(t *testing.T) {
 	})
 }
 
+func Test_GetFiles(t *testing.T) {
+	serverTool := GetFiles(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_files", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "paths"})
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetRawReposContentsByOwnerByRepoByPath: func(w http.ResponseWriter, r *http.Request) {
+			switch strings.TrimPrefix(r.URL.Path, "/owner/repo/HEAD/") {
+			case "go.mod":
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte("module example.com/repo\n\ngo 1.24\n"))
+			case ".github/dependabot.yml":
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte("version: 2\n"))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte("404: Not Found"))
+			}
+		},
+	})
+
+	tests := []struct {
+		name              string
+		contentWindowSize int
+		expectedGoMod     string
+		expectedTruncated bool
+	}{
+		{
+			name:              "fetches each path and reports missing ones",
+			contentWindowSize: 5000,
+			expectedGoMod:     "module example.com/repo\n\ngo 1.24\n",
+		},
+		{
+			name:              "content window is shared across files",
+			contentWindowSize: 3,
+			expectedGoMod:     "module example.com/repo\n",
+			expectedTruncated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, mockedClient)
+			rawClient, err := raw.NewClient(client, &url.URL{Scheme: "https", Host: "raw.example.com", Path: "/"})
+			require.NoError(t, err)
+			deps := BaseDeps{
+				Client:            client,
+				RawClient:         rawClient,
+				ContentWindowSize: tc.contentWindowSize,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"paths": []any{"go.mod", ".github/dependabot.yml", "missing.txt"},
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var response struct {
+				Files map[string]struct {
+					Content   *string `json:"content"`
+					Truncated bool    `json:"truncated"`
+					Error     string  `json:"error"`
+				} `json:"files"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			require.Len(t, response.Files, 3)
+
+			goMod := response.Files["go.mod"]
+			require.NotNil(t, goMod.Content)
+			assert.Equal(t, tc.expectedGoMod, *goMod.Content)
+			assert.Equal(t, tc.expectedTruncated, goMod.Truncated)
+			assert.Empty(t, goMod.Error)
+
+			dependabot := response.Files[".github/dependabot.yml"]
+			require.NotNil(t, dependabot.Content)
+			assert.Equal(t, "version: 2\n", *dependabot.Content)
+			assert.False(t, dependabot.Truncated)
+
+			missing := response.Files["missing.txt"]
+			assert.Nil(t, missing.Content)
+			assert.Equal(t, "file not found", missing.Error)
+		})
+	}
+
+	t.Run("too many paths", func(t *testing.T) {
+		deps := BaseDeps{}
+		handler := serverTool.Handler(deps)
+		paths := make([]any, getFilesMaxPaths+1)
+		for i := range paths {
+			paths[i] = "file.txt"
+		}
+		request := createMCPRequest(map[string]any{
+			"owner": "owner",
+			"repo":  "repo",
+			"paths": paths,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "too many paths")
+	})
+}
+
 // Test_GetCommit_IFC_FeatureFlag verifies that the IFC security label is only
 // attached to get_commit results when the ifc_labels feature flag is enabled,
 // and that the label content matches the commit-contents rule (untrusted on
@@ -1949,7 +2061,7 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 			expectedContent: mockFileResponse,
 		},
 		{
-			name: "no sha provided - file exists, rejects update",
+			name: "no sha provided - file exists, uses current sha",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
 				"GET /repos/owner/repo/contents/docs/example.md": mockResponse(t, http.StatusOK, &github.RepositoryContent{
 					SHA:  github.Ptr("existing123"),
@@ -1959,6 +2071,22 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 					SHA:  github.Ptr("existing123"),
 					Type: github.Ptr("file"),
 				}),
+				PutReposContentsByOwnerByRepoByPath: expectRequestBody(t, map[string]any{
+					"message": "Update without SHA",
+					"content": "IyBVcGRhdGVkCgpVcGRhdGVkIHdpdGhvdXQgU0hBLg==",
+					"branch":  "main",
+					"sha":     "existing123",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockFileResponse),
+				),
+				"PUT /repos/{owner}/{repo}/contents/{path:.*}": expectRequestBody(t, map[string]any{
+					"message": "Update without SHA",
+					"content": "IyBVcGRhdGVkCgpVcGRhdGVkIHdpdGhvdXQgU0hBLg==",
+					"branch":  "main",
+					"sha":     "existing123",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockFileResponse),
+				),
 			}),
 			requestArgs: map[string]any{
 				"owner":   "owner",
@@ -1968,8 +2096,8 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 				"message": "Update without SHA",
 				"branch":  "main",
 			},
-			expectError:    true,
-			expectedErrMsg: "File already exists at docs/example.md",
+			expectError:     false,
+			expectedContent: mockFileResponse,
 		},
 		{
 			name: "no sha provided - file doesn't exist, no warning",
@@ -2217,6 +2345,33 @@ func Test_CreateRepository(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to create repository",
 		},
+		{
+			name: "repository name already exists",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					EndpointPattern("POST /user/repos"),
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Repository creation failed.", "errors": [{"resource": "Repository", "code": "custom", "field": "name", "message": "name already exists on this account"}]}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"name": "test-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "name already exists on this account",
+		},
+		{
+			name:         "default_branch without an initial commit",
+			mockedClient: NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"name":           "test-repo",
+				"default_branch": "trunk",
+			},
+			expectError:    true,
+			expectedErrMsg: "default_branch requires autoInit",
+		},
 	}
 
 	for _, tc := range tests {
@@ -2260,6 +2415,70 @@ func Test_CreateRepository(t *testing.T) {
 	}
 }
 
+func Test_CreateRepository_TemplatesAndDefaultBranch(t *testing.T) {
+	serverTool := CreateRepository(translations.NullTranslationHelper)
+
+	createdRepo := &github.Repository{
+		ID:            github.Ptr(int64(99)),
+		Name:          github.Ptr("service"),
+		FullName:      github.Ptr("acme/service"),
+		HTMLURL:       github.Ptr("https://github.com/acme/service"),
+		CloneURL:      github.Ptr("https://github.com/acme/service.git"),
+		SSHURL:        github.Ptr("git@github.com:acme/service.git"),
+		DefaultBranch: github.Ptr("main"),
+		Owner:         &github.User{Login: github.Ptr("acme")},
+	}
+
+	renamed := false
+	client := mustNewGHClient(t, NewMockedHTTPClient(
+		WithRequestMatchHandler(
+			EndpointPattern("POST /orgs/acme/repos"),
+			expectRequestBody(t, map[string]any{
+				"name":               "service",
+				"description":        "",
+				"private":            true,
+				"auto_init":          false,
+				"gitignore_template": "Go",
+				"license_template":   "mit",
+			}).andThen(
+				mockResponse(t, http.StatusCreated, createdRepo),
+			),
+		),
+		WithRequestMatchHandler(
+			EndpointPattern("POST /repos/acme/service/branches/main/rename"),
+			expectRequestBody(t, map[string]any{
+				"new_name": "trunk",
+			}).andThen(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				renamed = true
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"name": "trunk"}`))
+			})),
+		),
+	))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"name":               "service",
+		"organization":       "acme",
+		"gitignore_template": "Go",
+		"license_template":   "mit",
+		"default_branch":     "trunk",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+	assert.True(t, renamed, "expected the initial branch to be renamed")
+
+	var got MinimalCreatedRepository
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	assert.Equal(t, "99", got.ID)
+	assert.Equal(t, "acme/service", got.FullName)
+	assert.Equal(t, "https://github.com/acme/service.git", got.CloneURL)
+	assert.Equal(t, "git@github.com:acme/service.git", got.SSHURL)
+	assert.Equal(t, "trunk", got.DefaultBranch)
+}
+
 func Test_PushFiles(t *testing.T) {
 	// Verify tool definition once
 	serverTool := PushFiles(translations.NullTranslationHelper)
@@ -3140,32 +3359,19 @@ func Test_DeleteFile(t *testing.T) {
 	assert.Contains(t, schema.Properties, "path")
 	assert.Contains(t, schema.Properties, "message")
 	assert.Contains(t, schema.Properties, "branch")
-	// SHA is no longer required since we're using Git Data API
+	assert.Contains(t, schema.Properties, "sha")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "path", "message", "branch"})
 
-	// Setup mock objects for Git Data API
-	mockRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123"),
-		},
-	}
-
-	mockCommit := &github.Commit{
-		SHA: github.Ptr("abc123"),
-		Tree: &github.Tree{
-			SHA: github.Ptr("def456"),
+	mockDeleteResponse := &github.RepositoryContentResponse{
+		Commit: github.Commit{
+			SHA:     github.Ptr("jkl012"),
+			Message: github.Ptr("Delete example file"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/jkl012"),
 		},
 	}
-
-	mockTree := &github.Tree{
-		SHA: github.Ptr("ghi789"),
-	}
-
-	mockNewCommit := &github.Commit{
-		SHA:     github.Ptr("jkl012"),
-		Message: github.Ptr("Delete example file"),
-		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/jkl012"),
+	notFound := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
 	}
 
 	tests := []struct {
@@ -3177,92 +3383,127 @@ func Test_DeleteFile(t *testing.T) {
 		expectedErrMsg    string
 	}{
 		{
-			name: "successful file deletion using Git Data API",
-			mockedClient: NewMockedHTTPClient(
-				// Get branch reference
-				WithRequestMatch(
-					GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
-				),
-				// Get commit
-				WithRequestMatch(
-					GetReposGitCommitsByOwnerByRepoByCommitSHA,
-					mockCommit,
-				),
-				// Create tree
-				WithRequestMatchHandler(
-					PostReposGitTreesByOwnerByRepo,
-					expectRequestBody(t, map[string]any{
-						"base_tree": "def456",
-						"tree": []any{
-							map[string]any{
-								"path": "docs/example.md",
-								"mode": "100644",
-								"type": "blob",
-								"sha":  nil,
-							},
-						},
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockTree),
-					),
-				),
-				// Create commit
-				WithRequestMatchHandler(
-					PostReposGitCommitsByOwnerByRepo,
-					expectRequestBody(t, map[string]any{
-						"message": "Delete example file",
-						"tree":    "ghi789",
-						"parents": []any{"abc123"},
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockNewCommit),
-					),
-				),
-				// Update reference
-				WithRequestMatchHandler(
-					PatchReposGitRefsByOwnerByRepoByRef,
-					expectRequestBody(t, map[string]any{
-						"sha":   "jkl012",
-						"force": false,
-					}).andThen(
-						mockResponse(t, http.StatusOK, &github.Reference{
-							Ref: github.Ptr("refs/heads/main"),
-							Object: &github.GitObject{
-								SHA: github.Ptr("jkl012"),
-							},
-						}),
-					),
+			name: "successful file deletion with sha",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"DELETE /repos/{owner}/{repo}/contents/{path:.*}": expectRequestBody(t, map[string]any{
+					"message": "Delete example file",
+					"content": nil,
+					"sha":     "abc123",
+					"branch":  "main",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockDeleteResponse),
 				),
-			),
+			}),
 			requestArgs: map[string]any{
 				"owner":   "owner",
 				"repo":    "repo",
 				"path":    "docs/example.md",
 				"message": "Delete example file",
 				"branch":  "main",
+				"sha":     "abc123",
 			},
 			expectError:       false,
 			expectedCommitSHA: "jkl012",
 		},
 		{
-			name: "file deletion fails - branch not found",
-			mockedClient: NewMockedHTTPClient(
-				WithRequestMatchHandler(
-					GetReposGitRefByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Reference not found"}`))
+			name: "sha resolved from current file",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": expectQueryParams(t, map[string]string{
+					"ref": "main",
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.RepositoryContent{
+						SHA:  github.Ptr("current456"),
+						Type: github.Ptr("file"),
 					}),
 				),
-			),
+				"DELETE /repos/{owner}/{repo}/contents/{path:.*}": expectRequestBody(t, map[string]any{
+					"message": "Delete example file",
+					"content": nil,
+					"sha":     "current456",
+					"branch":  "main",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockDeleteResponse),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"message": "Delete example file",
+				"branch":  "main",
+			},
+			expectError:       false,
+			expectedCommitSHA: "jkl012",
+		},
+		{
+			name: "file does not exist",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": notFound,
+			}),
 			requestArgs: map[string]any{
 				"owner":   "owner",
 				"repo":    "repo",
 				"path":    "docs/nonexistent.md",
 				"message": "Delete nonexistent file",
-				"branch":  "nonexistent-branch",
+				"branch":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "file docs/nonexistent.md does not exist on branch main",
+		},
+		{
+			name: "file does not exist with sha",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"DELETE /repos/{owner}/{repo}/contents/{path:.*}": notFound,
+				"GET /repos/{owner}/{repo}/contents/{path:.*}":    notFound,
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/nonexistent.md",
+				"message": "Delete nonexistent file",
+				"branch":  "main",
+				"sha":     "abc123",
+			},
+			expectError:    true,
+			expectedErrMsg: "file docs/nonexistent.md does not exist on branch main",
+		},
+		{
+			name: "delete not found for existing file",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"DELETE /repos/{owner}/{repo}/contents/{path:.*}": notFound,
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": mockResponse(t, http.StatusOK, &github.RepositoryContent{
+					Name: github.Ptr("example.md"),
+					Type: github.Ptr("file"),
+					SHA:  github.Ptr("abc123"),
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"message": "Delete example file",
+				"branch":  "main",
+				"sha":     "abc123",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to delete file",
+		},
+		{
+			name: "path is a directory",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+					{Name: github.Ptr("example.md"), Type: github.Ptr("file")},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs",
+				"message": "Delete docs",
+				"branch":  "main",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get branch reference",
+			expectedErrMsg: "Path docs is a directory, not a file",
 		},
 	}
 
@@ -3280,30 +3521,30 @@ func Test_DeleteFile(t *testing.T) {
 
 			// Call handler
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
 
 			// Verify results
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
+			require.False(t, result.IsError)
 
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var response map[string]any
+			var response MinimalFileContentResponse
 			err = json.Unmarshal([]byte(textContent.Text), &response)
 			require.NoError(t, err)
 
 			// Verify the response contains the expected commit
-			commit, ok := response["commit"].(map[string]any)
-			require.True(t, ok)
-			commitSHA, ok := commit["sha"].(string)
-			require.True(t, ok)
-			assert.Equal(t, tc.expectedCommitSHA, commitSHA)
+			require.NotNil(t, response.Commit)
+			assert.Equal(t, tc.expectedCommitSHA, response.Commit.SHA)
+			assert.Nil(t, response.Content)
 		})
 	}
 }
@@ -5680,3 +5921,997 @@ func Test_ListRepositoryCollaborators(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListOrgRepos(t *testing.T) {
+	serverTool := ListOrgRepos(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_org_repos", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "type")
+	assert.Contains(t, schema.Properties, "sort")
+	assert.Contains(t, schema.Properties, "direction")
+	assert.ElementsMatch(t, []string{"org"}, schema.Required)
+
+	pushedAt := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	mockRepos := []*github.Repository{
+		{
+			ID:       github.Ptr(int64(1)),
+			Name:     github.Ptr("internal-tool"),
+			FullName: github.Ptr("acme/internal-tool"),
+			Language: github.Ptr("Go"),
+			Private:  github.Ptr(true),
+			PushedAt: &github.Timestamp{Time: pushedAt},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "forwards type, sort and direction",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsReposByOrg: expect(t, expectations{
+					path: "/orgs/acme/repos",
+					queryParams: map[string]string{
+						"type":      "private",
+						"sort":      "pushed",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					},
+				}).andThen(mockResponse(t, http.StatusOK, mockRepos)),
+			}),
+			requestArgs: map[string]any{
+				"org":       "acme",
+				"type":      "private",
+				"sort":      "pushed",
+				"direction": "desc",
+			},
+		},
+		{
+			name:           "missing org",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: org",
+		},
+		{
+			name: "organization not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsReposByOrg: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"org": "missing"},
+			expectError:    true,
+			expectedErrMsg: "failed to list repositories for organization 'missing'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got []MinimalRepository
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			require.Len(t, got, 1)
+			assert.Equal(t, "acme/internal-tool", got[0].FullName)
+			assert.True(t, got[0].Private)
+			assert.Equal(t, "Go", got[0].Language)
+			assert.Equal(t, "2024-03-04T05:06:07Z", got[0].PushedAt)
+		})
+	}
+}
+
+func Test_ListMyRepos(t *testing.T) {
+	serverTool := ListMyRepos(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_my_repos", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "visibility")
+	assert.Contains(t, schema.Properties, "affiliation")
+	assert.Empty(t, schema.Required)
+
+	mockRepos := []*github.Repository{
+		{
+			ID:         github.Ptr(int64(1)),
+			FullName:   github.Ptr("octocat/secret-project"),
+			Private:    github.Ptr(true),
+			Visibility: github.Ptr("private"),
+			Permissions: &github.RepositoryPermissions{
+				Admin: github.Ptr(true),
+				Push:  github.Ptr(true),
+				Pull:  github.Ptr(true),
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		requestArgs  map[string]any
+		expectedArgs map[string]string
+	}{
+		{
+			name:        "affiliation owner",
+			requestArgs: map[string]any{"affiliation": []any{"owner"}},
+			expectedArgs: map[string]string{
+				"affiliation": "owner",
+				"page":        "1",
+				"per_page":    "30",
+			},
+		},
+		{
+			name:        "visibility private with multiple affiliations",
+			requestArgs: map[string]any{"visibility": "private", "affiliation": []any{"owner", "collaborator"}},
+			expectedArgs: map[string]string{
+				"visibility":  "private",
+				"affiliation": "owner,collaborator",
+				"page":        "1",
+				"per_page":    "30",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserRepos: expectQueryParams(t, tc.expectedArgs).andThen(
+					mockResponse(t, http.StatusOK, mockRepos),
+				),
+			}))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var got []MinimalRepository
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			require.Len(t, got, 1)
+			assert.Equal(t, "octocat/secret-project", got[0].FullName)
+			assert.Equal(t, "private", got[0].Visibility)
+			require.NotNil(t, got[0].Permissions)
+			assert.True(t, got[0].Permissions.Admin)
+			assert.True(t, got[0].Permissions.Push)
+			assert.False(t, got[0].Permissions.Maintain)
+		})
+	}
+}
+
+func Test_UpdateRepositorySettings(t *testing.T) {
+	serverTool := UpdateRepositorySettings(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "update_repository_settings", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo"}, schema.Required)
+
+	updatedRepo := &github.Repository{
+		FullName:            github.Ptr("owner/repo"),
+		DefaultBranch:       github.Ptr("main"),
+		HasIssues:           github.Ptr(true),
+		HasWiki:             github.Ptr(false),
+		AllowSquashMerge:    github.Ptr(true),
+		AllowMergeCommit:    github.Ptr(false),
+		DeleteBranchOnMerge: github.Ptr(true),
+	}
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		expectedBody   map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "only provided fields are sent",
+			requestArgs: map[string]any{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"has_wiki":               false,
+				"allow_merge_commit":     false,
+				"delete_branch_on_merge": true,
+			},
+			expectedBody: map[string]any{
+				"has_wiki":               false,
+				"allow_merge_commit":     false,
+				"delete_branch_on_merge": true,
+			},
+		},
+		{
+			name: "default branch only",
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"default_branch": "main",
+			},
+			expectedBody: map[string]any{
+				"default_branch": "main",
+			},
+		},
+		{
+			name: "no settings provided",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "no settings provided to update",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: expectRequestBody(t, tc.expectedBody).andThen(
+					mockResponse(t, http.StatusOK, updatedRepo),
+				),
+			}))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, "owner/repo", got["full_name"])
+			assert.Equal(t, true, got["delete_branch_on_merge"])
+			assert.Equal(t, false, got["has_wiki"])
+		})
+	}
+}
+
+func Test_ListForks(t *testing.T) {
+	serverTool := ListForks(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_forks", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "sort")
+	assert.ElementsMatch(t, []string{"owner", "repo"}, schema.Required)
+
+	mockForks := []*github.Repository{
+		{
+			FullName:        github.Ptr("alice/repo"),
+			Owner:           &github.User{Login: github.Ptr("alice")},
+			StargazersCount: github.Ptr(12),
+		},
+		{
+			FullName:        github.Ptr("bob/repo"),
+			Owner:           &github.User{Login: github.Ptr("bob")},
+			StargazersCount: github.Ptr(3),
+		},
+	}
+
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposForksByOwnerByRepo: expect(t, expectations{
+			path: "/repos/owner/repo/forks",
+			queryParams: map[string]string{
+				"sort":     "stargazers",
+				"page":     "1",
+				"per_page": "30",
+			},
+		}).andThen(mockResponse(t, http.StatusOK, mockForks)),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"sort":  "stargazers",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got []MinimalFork
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	require.Len(t, got, 2)
+	assert.Equal(t, "alice/repo", got[0].FullName)
+	assert.Equal(t, "alice", got[0].Owner)
+	assert.Equal(t, 12, got[0].Stars)
+	assert.Equal(t, "bob", got[1].Owner)
+	assert.Equal(t, 3, got[1].Stars)
+}
+
+func Test_GetReadme(t *testing.T) {
+	serverTool := GetReadme(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_readme", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "path")
+	assert.Contains(t, schema.Properties, "ref")
+	assert.ElementsMatch(t, []string{"owner", "repo"}, schema.Required)
+
+	readme := func(path, text string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Name:     github.Ptr("README.md"),
+			Path:     github.Ptr(path),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(text))),
+		}
+	}
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectError     bool
+		expectedErrMsg  string
+		expectedPath    string
+		expectedContent string
+	}{
+		{
+			name: "root README",
+			handlers: map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepo: expectQueryParams(t, map[string]string{"ref": "v1.0.0"}).andThen(
+					mockResponse(t, http.StatusOK, readme("readme.MD", "# Project")),
+				),
+			},
+			requestArgs:     map[string]any{"owner": "owner", "repo": "repo", "ref": "v1.0.0"},
+			expectedPath:    "readme.MD",
+			expectedContent: "# Project",
+		},
+		{
+			name: "directory README",
+			handlers: map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepoByDir: expectPath(t, "/repos/owner/repo/readme/docs/api").andThen(
+					mockResponse(t, http.StatusOK, readme("docs/api/README.md", "# API docs")),
+				),
+			},
+			requestArgs:     map[string]any{"owner": "owner", "repo": "repo", "path": "/docs/api/"},
+			expectedPath:    "docs/api/README.md",
+			expectedContent: "# API docs",
+		},
+		{
+			name: "directory README with reserved characters",
+			handlers: map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepoByDir: expectPath(t, "/repos/owner/repo/readme/docs/q&a?/#1").andThen(
+					mockResponse(t, http.StatusOK, readme("docs/q&a?/#1/README.md", "# FAQ")),
+				),
+			},
+			requestArgs:     map[string]any{"owner": "owner", "repo": "repo", "path": "docs/q&a?/#1", "ref": "main"},
+			expectedPath:    "docs/q&a?/#1/README.md",
+			expectedContent: "# FAQ",
+		},
+		{
+			name: "no README found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "failed to get README for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, tc.expectedPath, got["path"])
+			assert.Equal(t, tc.expectedContent, got["content"])
+		})
+	}
+}
+
+func Test_GetArchiveLink(t *testing.T) {
+	serverTool := GetArchiveLink(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_archive_link", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "format"}, schema.Required)
+
+	redirect := func(location string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Location", location)
+			w.WriteHeader(http.StatusFound)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedURL    string
+	}{
+		{
+			name: "tarball at ref",
+			handlers: map[string]http.HandlerFunc{
+				GetReposTarballByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/tarball/v1.2.3").andThen(
+					redirect("https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.2.3?token=abc"),
+				),
+			},
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "format": "tarball", "ref": "v1.2.3"},
+			expectedURL: "https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.2.3?token=abc",
+		},
+		{
+			name: "zipball of default branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposZipballByOwnerByRepoByRef: redirect("https://codeload.github.com/owner/repo/legacy.zip/refs/heads/main?token=def"),
+			},
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "format": "zipball"},
+			expectedURL: "https://codeload.github.com/owner/repo/legacy.zip/refs/heads/main?token=def",
+		},
+		{
+			name:           "invalid format",
+			handlers:       map[string]http.HandlerFunc{},
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo", "format": "7z"},
+			expectError:    true,
+			expectedErrMsg: "invalid format",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var got map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, tc.expectedURL, got["archive_url"])
+		})
+	}
+}
+
+func Test_ListAutolinks(t *testing.T) {
+	serverTool := ListAutolinks(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_autolinks", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	autolinks := []*github.Autolink{
+		{
+			ID:             github.Ptr(int64(1)),
+			KeyPrefix:      github.Ptr("JIRA-"),
+			URLTemplate:    github.Ptr("https://jira.example.com/browse/JIRA-<num>"),
+			IsAlphanumeric: github.Ptr(true),
+		},
+	}
+
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposAutolinksByOwnerByRepo: mockResponse(t, http.StatusOK, autolinks),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got []*github.Autolink
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "JIRA-", got[0].GetKeyPrefix())
+	assert.Equal(t, "https://jira.example.com/browse/JIRA-<num>", got[0].GetURLTemplate())
+}
+
+func Test_CreateAutolink(t *testing.T) {
+	serverTool := CreateAutolink(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "create_autolink", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "key_prefix", "url_template"}, schema.Required)
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create autolink with numeric references",
+			handlers: map[string]http.HandlerFunc{
+				PostReposAutolinksByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"key_prefix":      "TICKET-",
+					"url_template":    "https://tickets.example.com/<num>",
+					"is_alphanumeric": false,
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Autolink{
+					ID:             github.Ptr(int64(7)),
+					KeyPrefix:      github.Ptr("TICKET-"),
+					URLTemplate:    github.Ptr("https://tickets.example.com/<num>"),
+					IsAlphanumeric: github.Ptr(false),
+				})),
+			},
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"key_prefix":      "TICKET-",
+				"url_template":    "https://tickets.example.com/<num>",
+				"is_alphanumeric": false,
+			},
+		},
+		{
+			name:     "template without <num> is rejected",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"key_prefix":   "TICKET-",
+				"url_template": "https://tickets.example.com/",
+			},
+			expectError:    true,
+			expectedErrMsg: "url_template must contain <num>",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var got github.Autolink
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, int64(7), got.GetID())
+			assert.False(t, got.GetIsAlphanumeric())
+		})
+	}
+}
+
+func Test_ListDeployKeys(t *testing.T) {
+	serverTool := ListDeployKeys(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_deploy_keys", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, []string{"owner", "repo"}, schema.Required)
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	lastUsed := time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectError    bool
+		expectedKeys   []MinimalDeployKey
+		expectedErrMsg string
+	}{
+		{
+			name: "list deploy keys with pagination",
+			handlers: map[string]http.HandlerFunc{
+				GetReposKeysByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.Key{
+					{
+						ID:        github.Ptr(int64(5)),
+						Title:     github.Ptr("ci"),
+						Key:       github.Ptr("ssh-ed25519 AAAAC3Nza ci@example.com"),
+						URL:       github.Ptr("https://api.github.com/repos/owner/repo/keys/5"),
+						ReadOnly:  github.Ptr(true),
+						Verified:  github.Ptr(true),
+						CreatedAt: &github.Timestamp{Time: createdAt},
+						LastUsed:  &github.Timestamp{Time: lastUsed},
+					},
+					{
+						ID:    github.Ptr(int64(6)),
+						Title: github.Ptr("deploy"),
+						Key:   github.Ptr("ssh-ed25519 AAAAC3Nzb deploy@example.com"),
+					},
+				})),
+			},
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedKeys: []MinimalDeployKey{
+				{
+					ID:        5,
+					Title:     "ci",
+					ReadOnly:  true,
+					Verified:  true,
+					CreatedAt: "2024-01-02T03:04:05Z",
+					LastUsed:  "2024-06-07T08:09:10Z",
+				},
+				{ID: 6, Title: "deploy"},
+			},
+		},
+		{
+			name: "repository not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposKeysByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "failed to list deploy keys for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			text := getTextResult(t, result).Text
+			assert.NotContains(t, text, "ssh-ed25519", "the public key material is not returned")
+
+			var got []MinimalDeployKey
+			require.NoError(t, json.Unmarshal([]byte(text), &got))
+			assert.Equal(t, tc.expectedKeys, got)
+		})
+	}
+}
+
+func Test_CreateDeployKey(t *testing.T) {
+	serverTool := CreateDeployKey(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "create_deploy_key", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "title", "key"}, schema.Required)
+
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		PostReposKeysByOwnerByRepo: expectRequestBody(t, map[string]any{
+			"title":     "ci",
+			"key":       "ssh-ed25519 AAAAC3Nza ci@example.com",
+			"read_only": true,
+		}).andThen(mockResponse(t, http.StatusCreated, &github.Key{
+			ID:       github.Ptr(int64(5)),
+			Title:    github.Ptr("ci"),
+			Key:      github.Ptr("ssh-ed25519 AAAAC3Nza ci@example.com"),
+			ReadOnly: github.Ptr(true),
+		})),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"title": "ci",
+		"key":   "ssh-ed25519 AAAAC3Nza ci@example.com",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var got MinimalDeployKey
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+	assert.Equal(t, int64(5), got.ID)
+	assert.Equal(t, "ci", got.Title)
+	assert.True(t, got.ReadOnly)
+}
+
+func Test_DeleteDeployKey(t *testing.T) {
+	serverTool := DeleteDeployKey(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_deploy_key", tool.Name)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "delete deploy key",
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposKeysByOwnerByRepoByKeyID: expectPath(t, "/repos/owner/repo/keys/5").andThen(
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					},
+				),
+			},
+		},
+		{
+			name: "deploy key not found",
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposKeysByOwnerByRepoByKeyID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to delete deploy key 5 from owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "key_id": float64(5)})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Successfully deleted deploy key 5")
+		})
+	}
+}
+
+func Test_GetPages(t *testing.T) {
+	serverTool := GetPages(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pages", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		expectedPages   *MinimalPages
+		expectedMessage string
+	}{
+		{
+			name: "configured pages site",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPagesByOwnerByRepo: mockResponse(t, http.StatusOK, &github.Pages{
+					HTMLURL:       github.Ptr("https://docs.example.com/"),
+					Status:        github.Ptr("built"),
+					BuildType:     github.Ptr("legacy"),
+					CNAME:         github.Ptr("docs.example.com"),
+					HTTPSEnforced: github.Ptr(true),
+					Public:        github.Ptr(true),
+					Source: &github.PagesSource{
+						Branch: github.Ptr("gh-pages"),
+						Path:   github.Ptr("/"),
+					},
+				}),
+			},
+			expectedPages: &MinimalPages{
+				HTMLURL:       "https://docs.example.com/",
+				Status:        "built",
+				BuildType:     "legacy",
+				SourceBranch:  "gh-pages",
+				SourcePath:    "/",
+				CustomDomain:  "docs.example.com",
+				HTTPSEnforced: true,
+				Public:        true,
+			},
+		},
+		{
+			name: "pages not configured",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPagesByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectedMessage: "GitHub Pages is not configured for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			text := getTextResult(t, result).Text
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, text)
+				return
+			}
+
+			var got MinimalPages
+			require.NoError(t, json.Unmarshal([]byte(text), &got))
+			assert.Equal(t, *tc.expectedPages, got)
+		})
+	}
+}
+
+func Test_ListPagesBuilds(t *testing.T) {
+	serverTool := ListPagesBuilds(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pages_builds", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		expectedBuilds  []MinimalPagesBuild
+		expectedMessage string
+	}{
+		{
+			name: "recent builds",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPagesBuildsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.PagesBuild{
+					{
+						Status:    github.Ptr("built"),
+						Commit:    github.Ptr("abc123"),
+						Pusher:    &github.User{Login: github.Ptr("octocat")},
+						Duration:  github.Ptr(2104),
+						CreatedAt: &github.Timestamp{Time: createdAt},
+					},
+					{
+						Status: github.Ptr("errored"),
+						Error:  &github.PagesError{Message: github.Ptr("Page build failed.")},
+						Commit: github.Ptr("def456"),
+					},
+				})),
+			},
+			expectedBuilds: []MinimalPagesBuild{
+				{
+					Status:     "built",
+					Commit:     "abc123",
+					Pusher:     "octocat",
+					DurationMS: 2104,
+					CreatedAt:  "2024-01-02T03:04:05Z",
+				},
+				{Status: "errored", Error: "Page build failed.", Commit: "def456"},
+			},
+		},
+		{
+			name: "pages not configured",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPagesBuildsByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectedMessage: "GitHub Pages is not configured for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			text := getTextResult(t, result).Text
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, text)
+				return
+			}
+
+			var got []MinimalPagesBuild
+			require.NoError(t, json.Unmarshal([]byte(text), &got))
+			assert.Equal(t, tc.expectedBuilds, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -28,8 +29,14 @@ var (
 	repositoryResourceCommitContentURITemplate = uritemplate.MustNew("repo://{owner}/{repo}/sha/{sha}/contents{/path*}")
 	repositoryResourceTagContentURITemplate    = uritemplate.MustNew("repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}")
 	repositoryResourcePrContentURITemplate     = uritemplate.MustNew("repo://{owner}/{repo}/refs/pull/{prNumber}/head/contents{/path*}")
+	repositoryResourceTreeURITemplate          = uritemplate.MustNew("repo://{owner}/{repo}/tree/{ref}")
 )
 
+// maxRepositoryTreeResourceEntries bounds the number of paths returned by the
+// repository tree resource so that very large repositories do not flood the
+// client's context.
+const maxRepositoryTreeResourceEntries = 5000
+
 // GetRepositoryResourceContent defines the resource template for getting repository content.
 func GetRepositoryResourceContent(t translations.TranslationHelperFunc) inventory.ServerResourceTemplate {
 	return inventory.NewServerResourceTemplate(
@@ -100,6 +107,24 @@ func GetRepositoryResourcePrContent(t translations.TranslationHelperFunc) invent
 	)
 }
 
+// GetRepositoryResourceTree defines the resource template for getting the
+// recursive file tree of a repository at a branch, tag or commit.
+func GetRepositoryResourceTree(t translations.TranslationHelperFunc) inventory.ServerResourceTemplate {
+	return inventory.NewServerResourceTemplate(
+		ToolsetMetadataRepos,
+		mcp.ResourceTemplate{
+			Name:        "repository_tree",
+			URITemplate: repositoryResourceTreeURITemplate.Raw(),
+			Description: t("RESOURCE_REPOSITORY_TREE_DESCRIPTION", "Recursive file tree of a repository at a branch, tag or commit SHA"),
+			MIMEType:    "application/json",
+			Icons:       octicons.Icons("code-square"),
+		},
+		func(_ any) mcp.ResourceHandler {
+			return RepositoryResourceTreeHandler()
+		},
+	)
+}
+
 // repositoryResourceContentsHandlerFunc returns a ResourceHandlerFunc that creates handlers on-demand.
 func repositoryResourceContentsHandlerFunc(resourceURITemplate *uritemplate.Template) inventory.ResourceHandlerFunc {
 	return func(_ any) mcp.ResourceHandler {
@@ -180,9 +205,10 @@ func RepositoryResourceContentsHandler(resourceURITemplate *uritemplate.Template
 			rawOpts.SHA = sha
 			opts.Ref = sha
 		}
-		//  if it's a directory
+		// Directories cannot be fetched as raw content, so list them through
+		// the contents API instead.
 		if path == "" || strings.HasSuffix(path, "/") {
-			return nil, fmt.Errorf("directories are not supported: %s", path)
+			return repositoryDirectoryResource(ctx, deps, request.Params.URI, owner, repo, strings.TrimSuffix(path, "/"), opts)
 		}
 		rawClient, err := deps.GetRawClient(ctx)
 
@@ -190,6 +216,14 @@ func RepositoryResourceContentsHandler(resourceURITemplate *uritemplate.Template
 			return nil, fmt.Errorf("failed to get GitHub raw content client: %w", err)
 		}
 
+		// The content cache lets a file read before be revalidated instead
+		// of downloaded again.
+		contentCache := deps.GetRawContentCache(ctx)
+		cached := contentCache.Get(rawClient.Host(), owner, repo, path, rawOpts)
+		if cached != nil {
+			rawOpts.IfNoneMatch = cached.ETag
+		}
+
 		resp, err := rawClient.GetRawContent(ctx, owner, repo, path, rawOpts)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get raw content: %w", err)
@@ -197,54 +231,24 @@ func RepositoryResourceContentsHandler(resourceURITemplate *uritemplate.Template
 		defer func() {
 			_ = resp.Body.Close()
 		}()
-		// If the raw content is not found, we will fall back to the GitHub API (in case it is a directory)
+
+		var content []byte
+		var mimeType string
 		switch {
+		case resp.StatusCode == http.StatusNotModified && cached != nil:
+			content = cached.Body
+			mimeType = cached.ContentType
 		case resp.StatusCode == http.StatusOK:
-			ext := filepath.Ext(path)
-			mimeType := resp.Header.Get("Content-Type")
-			if ext == ".md" {
-				mimeType = "text/markdown"
-			} else if mimeType == "" {
-				mimeType = mime.TypeByExtension(ext)
-			}
-
-			content, err := io.ReadAll(resp.Body)
+			content, err = io.ReadAll(resp.Body)
 			if err != nil {
 				return nil, fmt.Errorf("failed to read file content: %w", err)
 			}
-
-			switch {
-			case strings.HasPrefix(mimeType, "text"), strings.HasPrefix(mimeType, "application"):
-				return &mcp.ReadResourceResult{
-					Contents: []*mcp.ResourceContents{
-						{
-							URI:      request.Params.URI,
-							MIMEType: mimeType,
-							Text:     string(content),
-						},
-					},
-				}, nil
-			default:
-				var buf bytes.Buffer
-				base64Encoder := base64.NewEncoder(base64.StdEncoding, &buf)
-				_, err := base64Encoder.Write(content)
-				if err != nil {
-					return nil, fmt.Errorf("failed to base64 encode content: %w", err)
-				}
-				if err := base64Encoder.Close(); err != nil {
-					return nil, fmt.Errorf("failed to close base64 encoder: %w", err)
-				}
-
-				return &mcp.ReadResourceResult{
-					Contents: []*mcp.ResourceContents{
-						{
-							URI:      request.Params.URI,
-							MIMEType: mimeType,
-							Blob:     buf.Bytes(),
-						},
-					},
-				}, nil
-			}
+			mimeType = resp.Header.Get("Content-Type")
+			contentCache.Add(rawClient.Host(), owner, repo, path, rawOpts, &raw.CachedContent{
+				ETag:        resp.Header.Get("ETag"),
+				ContentType: mimeType,
+				Body:        content,
+			})
 		case resp.StatusCode != http.StatusNotFound:
 			// If we got a response but it is not 200 OK, we return an error
 			body, err := io.ReadAll(resp.Body)
@@ -256,9 +260,190 @@ func RepositoryResourceContentsHandler(resourceURITemplate *uritemplate.Template
 			// This should be unreachable because GetContents should return an error if neither file nor directory content is found.
 			return nil, errors.New("404 Not Found")
 		}
+
+		ext := filepath.Ext(path)
+		if ext == ".md" {
+			mimeType = "text/markdown"
+		} else if mimeType == "" {
+			mimeType = mime.TypeByExtension(ext)
+		}
+
+		switch {
+		case strings.HasPrefix(mimeType, "text"), strings.HasPrefix(mimeType, "application"):
+			return &mcp.ReadResourceResult{
+				Contents: []*mcp.ResourceContents{
+					{
+						URI:      request.Params.URI,
+						MIMEType: mimeType,
+						Text:     string(content),
+					},
+				},
+			}, nil
+		default:
+			var buf bytes.Buffer
+			base64Encoder := base64.NewEncoder(base64.StdEncoding, &buf)
+			_, err := base64Encoder.Write(content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to base64 encode content: %w", err)
+			}
+			if err := base64Encoder.Close(); err != nil {
+				return nil, fmt.Errorf("failed to close base64 encoder: %w", err)
+			}
+
+			return &mcp.ReadResourceResult{
+				Contents: []*mcp.ResourceContents{
+					{
+						URI:      request.Params.URI,
+						MIMEType: mimeType,
+						Blob:     buf.Bytes(),
+					},
+				},
+			}, nil
+		}
 	}
 }
 
+// repositoryTreeResourceEntry is a single path in the repository tree resource.
+type repositoryTreeResourceEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int    `json:"size,omitempty"`
+}
+
+// repositoryTreeResource is the JSON body of the repository tree resource.
+type repositoryTreeResource struct {
+	Ref       string                        `json:"ref"`
+	SHA       string                        `json:"sha"`
+	Count     int                           `json:"count"`
+	Truncated bool                          `json:"truncated"`
+	Entries   []repositoryTreeResourceEntry `json:"entries"`
+}
+
+// RepositoryResourceTreeHandler returns a handler that lists every path in a
+// repository at a ref using the Git Trees API. The listing is truncated at
+// maxRepositoryTreeResourceEntries, or wherever GitHub truncated it.
+func RepositoryResourceTreeHandler() mcp.ResourceHandler {
+	return func(ctx context.Context, request *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		deps := MustDepsFromContext(ctx)
+		uriValues := repositoryResourceTreeURITemplate.Match(request.Params.URI)
+		if uriValues == nil {
+			return nil, fmt.Errorf("failed to match URI: %s", request.Params.URI)
+		}
+
+		owner := uriValues.Get("owner").String()
+		repo := uriValues.Get("repo").String()
+		ref := uriValues.Get("ref").String()
+		if owner == "" {
+			return nil, errors.New("owner is required")
+		}
+		if repo == "" {
+			return nil, errors.New("repo is required")
+		}
+		if ref == "" {
+			return nil, errors.New("ref is required")
+		}
+
+		githubClient, err := deps.GetClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+		tree, resp, err := githubClient.Git.GetTree(ctx, owner, repo, ref, true)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repository tree: %w", err)
+		}
+
+		result := repositoryTreeResource{
+			Ref:       ref,
+			SHA:       tree.GetSHA(),
+			Count:     len(tree.Entries),
+			Truncated: tree.GetTruncated(),
+			Entries:   make([]repositoryTreeResourceEntry, 0, min(len(tree.Entries), maxRepositoryTreeResourceEntries)),
+		}
+		for _, entry := range tree.Entries {
+			if len(result.Entries) == maxRepositoryTreeResourceEntries {
+				result.Truncated = true
+				break
+			}
+			result.Entries = append(result.Entries, repositoryTreeResourceEntry{
+				Path: entry.GetPath(),
+				Type: entry.GetType(),
+				Size: entry.GetSize(),
+			})
+		}
+
+		body, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal repository tree: %w", err)
+		}
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{
+				{
+					URI:      request.Params.URI,
+					MIMEType: "application/json",
+					Text:     string(body),
+				},
+			},
+		}, nil
+	}
+}
+
+// repositoryDirectoryEntry is a single entry of a directory listing returned
+// for a repo:// URI that names a directory.
+type repositoryDirectoryEntry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+	SHA  string `json:"sha"`
+}
+
+// repositoryDirectoryResource lists the directory at path as a JSON text
+// resource.
+func repositoryDirectoryResource(ctx context.Context, deps ToolDependencies, uri, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*mcp.ReadResourceResult, error) {
+	githubClient, err := deps.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	_, dirContent, resp, err := githubClient.Repositories.GetContents(ctx, owner, repo, path, opts)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory %q: %w", path, err)
+	}
+	if dirContent == nil {
+		return nil, fmt.Errorf("not a directory: %s", path)
+	}
+
+	entries := make([]repositoryDirectoryEntry, 0, len(dirContent))
+	for _, entry := range dirContent {
+		entries = append(entries, repositoryDirectoryEntry{
+			Name: entry.GetName(),
+			Path: entry.GetPath(),
+			Type: entry.GetType(),
+			Size: entry.GetSize(),
+			SHA:  entry.GetSHA(),
+		})
+	}
+	listing, err := json.Marshal(entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal directory listing: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      uri,
+				MIMEType: "application/json",
+				Text:     string(listing),
+			},
+		},
+	}, nil
+}
+
 // expandRepoResourceURI builds a resource URI using the appropriate URI template
 // based on the provided parameters (sha, ref, or default).
 func expandRepoResourceURI(owner, repo, sha, ref string, pathParts []string) (string, error) {
@@ -21,6 +21,7 @@ var RepositoryResourceArgumentResolvers = map[string]CompleteHandler{
 	"sha":      completeSHA,
 	"tag":      completeTag,
 	"prNumber": completePRNumber,
+	"ref":      completeRef,
 	"path":     completePath,
 }
 
@@ -207,6 +208,20 @@ func completeTag(ctx context.Context, client *github.Client, resolved map[string
 	return values, nil
 }
 
+// completeRef completes the ref of the repository tree resource with
+// matching branch names followed by matching tag names.
+func completeRef(ctx context.Context, client *github.Client, resolved map[string]string, argValue string) ([]string, error) {
+	branches, err := completeBranch(ctx, client, resolved, argValue)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := completeTag(ctx, client, resolved, argValue)
+	if err != nil {
+		return nil, err
+	}
+	return append(branches, tags...), nil
+}
+
 func completePRNumber(ctx context.Context, client *github.Client, resolved map[string]string, argValue string) ([]string, error) {
 	var values []string
 	owner := resolved["owner"]
@@ -169,6 +169,22 @@ func TestCompleteTag_MissingDependencies(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestCompleteRef_MissingDependencies(t *testing.T) {
+	ctx := t.Context()
+
+	// Test missing owner
+	resolved := map[string]string{"repo": "testrepo"}
+	result, err := completeRef(ctx, nil, resolved, "main")
+	require.Error(t, err)
+	assert.Nil(t, result)
+
+	// Test missing repo
+	resolved = map[string]string{"owner": "testowner"}
+	result, err = completeRef(ctx, nil, resolved, "main")
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
 func TestCompletePRNumber_MissingDependencies(t *testing.T) {
 	ctx := t.Context()
 
@@ -230,7 +246,7 @@ func TestCompletePath_RefSelection(t *testing.T) {
 func TestRepositoryResourceArgumentResolvers_Existence(t *testing.T) {
 	// Test that all expected resolvers are present
 	expectedResolvers := []string{
-		"owner", "repo", "branch", "sha", "tag", "prNumber", "path",
+		"owner", "repo", "branch", "sha", "tag", "prNumber", "path", "ref",
 	}
 
 	for _, resolver := range expectedResolvers {
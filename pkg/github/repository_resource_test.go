@@ -2,12 +2,15 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
 
 	"github.com/github/github-mcp-server/pkg/raw"
+	"github.com/google/go-github/v89/github"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/require"
 )
@@ -226,6 +229,47 @@ func Test_repositoryResourceContents(t *testing.T) {
 					URI:      "",
 				}}},
 		},
+		{
+			name: "directory listing (root)",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+					{Name: github.Ptr("README.md"), Path: github.Ptr("README.md"), Type: github.Ptr("file"), Size: github.Ptr(42), SHA: github.Ptr("abc123")},
+					{Name: github.Ptr("docs"), Path: github.Ptr("docs"), Type: github.Ptr("dir"), SHA: github.Ptr("def456")},
+				}),
+			}),
+			uri: "repo://owner/repo/contents",
+			handlerFn: func() mcp.ResourceHandler {
+				return RepositoryResourceContentsHandler(repositoryResourceContentURITemplate)
+			},
+			expectedResponseType: resourceResponseTypeText,
+			expectedResult: &mcp.ReadResourceResult{
+				Contents: []*mcp.ResourceContents{{
+					Text:     `[{"name":"README.md","path":"README.md","type":"file","size":42,"sha":"abc123"},{"name":"docs","path":"docs","type":"dir","size":0,"sha":"def456"}]`,
+					MIMEType: "application/json",
+				}}},
+		},
+		{
+			name: "directory listing (branch)",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": expectQueryParams(t, map[string]string{
+					"ref": "refs/heads/main",
+				}).andThen(
+					mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+						{Name: github.Ptr("guide.md"), Path: github.Ptr("docs/guide.md"), Type: github.Ptr("file"), Size: github.Ptr(7), SHA: github.Ptr("aaa111")},
+					}),
+				),
+			}),
+			uri: "repo://owner/repo/refs/heads/main/contents/docs/",
+			handlerFn: func() mcp.ResourceHandler {
+				return RepositoryResourceContentsHandler(repositoryResourceBranchContentURITemplate)
+			},
+			expectedResponseType: resourceResponseTypeText,
+			expectedResult: &mcp.ReadResourceResult{
+				Contents: []*mcp.ResourceContents{{
+					Text:     `[{"name":"guide.md","path":"docs/guide.md","type":"file","size":7,"sha":"aaa111"}]`,
+					MIMEType: "application/json",
+				}}},
+		},
 		{
 			name: "content fetch fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -313,3 +357,144 @@ func Test_repositoryResourceContentsHandler_NetworkError(t *testing.T) {
 	require.Nil(t, resp)
 	require.ErrorContains(t, err, "failed to get raw content")
 }
+
+func Test_repositoryResourceTree(t *testing.T) {
+	manyEntries := make([]*github.TreeEntry, maxRepositoryTreeResourceEntries+1)
+	for i := range manyEntries {
+		manyEntries[i] = &github.TreeEntry{Path: github.Ptr(fmt.Sprintf("file%d.txt", i)), Type: github.Ptr("blob")}
+	}
+
+	tests := []struct {
+		name              string
+		handlers          map[string]http.HandlerFunc
+		uri               string
+		expectError       string
+		expectedTruncated bool
+		expectedCount     int
+		expectedEntries   int
+	}{
+		{
+			name: "recursive tree at branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: expectQueryParams(t, map[string]string{
+					"recursive": "1",
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.Tree{
+						SHA:       github.Ptr("tree123"),
+						Truncated: github.Ptr(false),
+						Entries: []*github.TreeEntry{
+							{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), Size: github.Ptr(42)},
+							{Path: github.Ptr("src"), Type: github.Ptr("tree")},
+							{Path: github.Ptr("src/main.go"), Type: github.Ptr("blob"), Size: github.Ptr(7)},
+						},
+					}),
+				),
+			},
+			uri:             "repo://owner/repo/tree/main",
+			expectedCount:   3,
+			expectedEntries: 3,
+		},
+		{
+			name: "truncated by GitHub",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: mockResponse(t, http.StatusOK, &github.Tree{
+					SHA:       github.Ptr("tree123"),
+					Truncated: github.Ptr(true),
+					Entries:   []*github.TreeEntry{{Path: github.Ptr("README.md"), Type: github.Ptr("blob")}},
+				}),
+			},
+			uri:               "repo://owner/repo/tree/main",
+			expectedTruncated: true,
+			expectedCount:     1,
+			expectedEntries:   1,
+		},
+		{
+			name: "truncated at entry limit",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: mockResponse(t, http.StatusOK, &github.Tree{
+					SHA:       github.Ptr("tree123"),
+					Truncated: github.Ptr(false),
+					Entries:   manyEntries,
+				}),
+			},
+			uri:               "repo://owner/repo/tree/main",
+			expectedTruncated: true,
+			expectedCount:     maxRepositoryTreeResourceEntries + 1,
+			expectedEntries:   maxRepositoryTreeResourceEntries,
+		},
+		{
+			name: "unknown ref",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				},
+			},
+			uri:         "repo://owner/repo/tree/missing",
+			expectError: "failed to get repository tree",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			ctx := ContextWithDeps(context.Background(), deps)
+			handler := RepositoryResourceTreeHandler()
+
+			resp, err := handler(ctx, &mcp.ReadResourceRequest{
+				Params: &mcp.ReadResourceParams{URI: tc.uri},
+			})
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+
+			content := resp.Contents[0]
+			require.Equal(t, "application/json", content.MIMEType)
+			var tree repositoryTreeResource
+			require.NoError(t, json.Unmarshal([]byte(content.Text), &tree))
+			require.Equal(t, "main", tree.Ref)
+			require.Equal(t, "tree123", tree.SHA)
+			require.Equal(t, tc.expectedTruncated, tree.Truncated)
+			require.Equal(t, tc.expectedCount, tree.Count)
+			require.Len(t, tree.Entries, tc.expectedEntries)
+		})
+	}
+}
+
+func Test_repositoryResourceContents_RevalidatesCachedContent(t *testing.T) {
+	base, _ := url.Parse("https://raw.example.com/")
+	const etag = `"readme-v1"`
+	downloads := 0
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetRawReposContentsByOwnerByRepoByPath: func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			downloads++
+			w.Header().Set("Content-Type", "text/plain")
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte("# Cached README"))
+		},
+	})
+	client := mustNewGHClient(t, mockedClient)
+	rawClient, err := raw.NewClient(client, base)
+	require.NoError(t, err)
+	deps := BaseDeps{Client: client, RawClient: rawClient, RawContentCache: raw.NewContentCache(raw.DefaultContentCacheEntries)}
+	ctx := ContextWithDeps(context.Background(), deps)
+	handler := RepositoryResourceContentsHandler(repositoryResourceContentURITemplate)
+
+	for range 2 {
+		resp, err := handler(ctx, &mcp.ReadResourceRequest{
+			Params: &mcp.ReadResourceParams{URI: "repo://etag-owner/etag-repo/contents/README.txt"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "# Cached README", resp.Contents[0].Text)
+		require.Equal(t, "text/plain", resp.Contents[0].MIMEType)
+	}
+	require.Equal(t, 1, downloads)
+}
@@ -235,6 +235,11 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 				Description: "Sort order for results",
 				Enum:        []any{"asc", "desc"},
 			},
+			"text_matches": {
+				Type:        "boolean",
+				Description: "Include text_matches fragments highlighting where the query matched in each file. Set to false to reduce response size.",
+				Default:     json.RawMessage(`true`),
+			},
 		},
 		Required: []string{"query"},
 	}
@@ -271,6 +276,10 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			textMatches, err := OptionalBoolParamWithDefault(args, "text_matches", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 			var fields []string
 			if includeFields {
 				fields, err = OptionalStringArrayParam(args, "fields")
@@ -286,7 +295,7 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 			opts := &github.SearchOptions{
 				Sort:      sort,
 				Order:     order,
-				TextMatch: true,
+				TextMatch: textMatches,
 				ListOptions: github.ListOptions{
 					PerPage: pagination.PerPage,
 					Page:    pagination.Page,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -444,6 +445,29 @@ func Test_SearchCode(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockSearchResult,
 		},
+		{
+			name: "code search without text matches",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchCode: func(w http.ResponseWriter, r *http.Request) {
+					assert.NotContains(t, r.Header.Get("Accept"), "text-match")
+					mockResponse(t, http.StatusOK, &github.CodeSearchResult{
+						Total:             github.Ptr(1),
+						IncompleteResults: github.Ptr(false),
+						CodeResults:       mockSearchResult.CodeResults[1:],
+					})(w, r)
+				},
+			}),
+			requestArgs: map[string]any{
+				"query":        "fmt.Println language:go",
+				"text_matches": false,
+			},
+			expectError: false,
+			expectedResult: &github.CodeSearchResult{
+				Total:             github.Ptr(1),
+				IncompleteResults: github.Ptr(false),
+				CodeResults:       mockSearchResult.CodeResults[1:],
+			},
+		},
 		{
 			name: "search code fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -458,6 +482,24 @@ func Test_SearchCode(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to search code",
 		},
+		{
+			name: "search code rate limit names the code_search resource",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchCode: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("X-RateLimit-Limit", "10")
+					w.Header().Set("X-RateLimit-Remaining", "0")
+					w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+					w.Header().Set("X-RateLimit-Resource", "code_search")
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+				}),
+			}),
+			requestArgs: map[string]any{
+				"query": "fmt.Println language:go",
+			},
+			expectError:    true,
+			expectedErrMsg: "GitHub API rate limit exceeded for the code_search resource",
+		},
 	}
 
 	for _, tc := range tests {
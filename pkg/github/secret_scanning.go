@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
@@ -214,3 +215,135 @@ func ListSecretScanningAlerts(t translations.TranslationHelperFunc) inventory.Se
 		},
 	)
 }
+
+// secretScanningResolutions are the resolutions GitHub accepts when resolving
+// a secret scanning alert.
+var secretScanningResolutions = []any{"false_positive", "wont_fix", "revoked", "used_in_tests"}
+
+// UpdateSecretScanningAlert creates a tool to resolve or reopen a secret scanning alert.
+func UpdateSecretScanningAlert(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataSecretProtection,
+		mcp.Tool{
+			Name:        "update_secret_scanning_alert",
+			Description: t("TOOL_UPDATE_SECRET_SCANNING_ALERT_DESCRIPTION", "Resolve or reopen a secret scanning alert in a GitHub repository. Resolving requires a resolution. Returns the updated alert."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_SECRET_SCANNING_ALERT_USER_TITLE", "Update secret scanning alert"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert.",
+					},
+					"state": {
+						Type:        "string",
+						Description: "The new state of the alert. Use 'open' to reopen a resolved alert.",
+						Enum:        []any{"resolved", "open"},
+					},
+					"resolution": {
+						Type:        "string",
+						Description: "The reason for resolving the alert. Required when state is 'resolved'.",
+						Enum:        secretScanningResolutions,
+					},
+					"resolution_comment": {
+						Type:        "string",
+						Description: "An optional comment explaining the resolution.",
+					},
+				},
+				Required: []string{"owner", "repo", "alertNumber", "state"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			state, err := RequiredParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			resolution, err := OptionalParam[string](args, "resolution")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			resolutionComment, err := OptionalParam[string](args, "resolution_comment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			update := &github.SecretScanningAlertUpdateOptions{State: state}
+			switch state {
+			case "resolved":
+				if resolution == "" {
+					return utils.NewToolResultError("resolution is required when state is 'resolved'"), nil, nil
+				}
+				if !slices.Contains(secretScanningResolutions, any(resolution)) {
+					return utils.NewToolResultError(fmt.Sprintf("invalid resolution %q: must be one of false_positive, wont_fix, revoked, used_in_tests", resolution)), nil, nil
+				}
+				update.Resolution = github.Ptr(resolution)
+				if resolutionComment != "" {
+					update.ResolutionComment = github.Ptr(resolutionComment)
+				}
+			case "open":
+				if resolution != "" || resolutionComment != "" {
+					return utils.NewToolResultError("resolution and resolution_comment can only be set when state is 'resolved'"), nil, nil
+				}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid state %q: must be 'resolved' or 'open'", state)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.SecretScanning.UpdateAlert(ctx, owner, repo, int64(alertNumber), update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update alert", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// The updated alert carries the same label as get_secret_scanning_alert.
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelSecurityAlert())
+			return result, nil, nil
+		},
+	)
+}
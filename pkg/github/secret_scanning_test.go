@@ -10,6 +10,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -273,3 +274,187 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateSecretScanningAlert(t *testing.T) {
+	toolDef := UpdateSecretScanningAlert(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Tool.Name, toolDef.Tool))
+
+	assert.Equal(t, "update_secret_scanning_alert", toolDef.Tool.Name)
+	assert.NotEmpty(t, toolDef.Tool.Description)
+	schema, ok := toolDef.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "resolution")
+	assert.Contains(t, schema.Properties, "resolution_comment")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "alertNumber", "state"})
+
+	resolvedAlert := &github.SecretScanningAlert{
+		Number:     github.Ptr(42),
+		State:      github.Ptr("resolved"),
+		Resolution: github.Ptr("revoked"),
+	}
+	openAlert := &github.SecretScanningAlert{
+		Number: github.Ptr(42),
+		State:  github.Ptr("open"),
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectedAlert  *github.SecretScanningAlert
+		expectedErrMsg string
+	}{
+		{
+			name: "resolve alert",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state":              "resolved",
+					"resolution":         "revoked",
+					"resolution_comment": "Rotated the key",
+				}).andThen(mockResponse(t, http.StatusOK, resolvedAlert)),
+			},
+			requestArgs: map[string]any{
+				"owner":              "owner",
+				"repo":               "repo",
+				"alertNumber":        float64(42),
+				"state":              "resolved",
+				"resolution":         "revoked",
+				"resolution_comment": "Rotated the key",
+			},
+			expectedAlert: resolvedAlert,
+		},
+		{
+			name: "reopen alert",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(mockResponse(t, http.StatusOK, openAlert)),
+			},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectedAlert: openAlert,
+		},
+		{
+			name:     "resolve without resolution",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "resolved",
+			},
+			expectedErrMsg: "resolution is required when state is 'resolved'",
+		},
+		{
+			name:     "invalid resolution",
+			handlers: map[string]http.HandlerFunc{},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "resolved",
+				"resolution":  "pattern_deleted",
+			},
+			expectedErrMsg: `invalid resolution "pattern_deleted"`,
+		},
+		{
+			name: "update fails",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			},
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectedErrMsg: "failed to update alert with number '42'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returnedAlert github.SecretScanningAlert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+			assert.Equal(t, *tc.expectedAlert.Number, *returnedAlert.Number)
+			assert.Equal(t, *tc.expectedAlert.State, *returnedAlert.State)
+			assert.Equal(t, tc.expectedAlert.GetResolution(), returnedAlert.GetResolution())
+		})
+	}
+}
+
+func Test_UpdateSecretScanningAlert_NotRedacted(t *testing.T) {
+	alert := &github.SecretScanningAlert{
+		Number:                github.Ptr(42),
+		State:                 github.Ptr("resolved"),
+		Resolution:            github.Ptr("revoked"),
+		Secret:                github.Ptr("ghp_leakedtoken"),
+		SecretType:            github.Ptr("github_personal_access_token"),
+		SecretTypeDisplayName: github.Ptr("GitHub Personal Access Token"),
+	}
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusOK, alert),
+		})),
+	}
+
+	cfg := &MCPServerConfig{
+		Version:      "test",
+		Translator:   translations.NullTranslationHelper,
+		RedactFields: CredentialRedactFields,
+	}
+	inv, err := NewInventory(cfg.Translator).WithTools([]string{"update_secret_scanning_alert"}).Build()
+	require.NoError(t, err)
+	srv, err := NewMCPServer(context.Background(), cfg, deps, inv)
+	require.NoError(t, err)
+
+	st, ct := mcp.NewInMemoryTransports()
+	ss, err := srv.Connect(context.Background(), st, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ss.Close() })
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil).Connect(context.Background(), ct, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cs.Close() })
+
+	result, err := cs.CallTool(context.Background(), &mcp.CallToolParams{
+		Name: "update_secret_scanning_alert",
+		Arguments: map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"alertNumber": 42,
+			"state":       "resolved",
+			"resolution":  "revoked",
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var returnedAlert github.SecretScanningAlert
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+	assert.Equal(t, "ghp_leakedtoken", returnedAlert.GetSecret())
+	assert.Equal(t, "github_personal_access_token", returnedAlert.GetSecretType())
+	assert.Equal(t, "GitHub Personal Access Token", returnedAlert.GetSecretTypeDisplayName())
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -23,6 +25,14 @@ type MCPServerConfig struct {
 	// GitHub Host to target for API requests (e.g. github.com or github.enterprise.com)
 	Host string
 
+	// UserAgent is sent with every outbound GitHub API request.
+	// Defaults to github-mcp-server/<version> when empty.
+	UserAgent string
+
+	// Transport is the base round tripper for outbound GitHub API requests
+	// (e.g. one configured with a proxy). Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
 	// GitHub Token to authenticate with the GitHub API
 	Token string
 
@@ -47,6 +57,10 @@ type MCPServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// MaxWorkflowJobPages bounds how many pages of 100 jobs are read when
+	// collecting the jobs of a workflow run. Zero uses the default.
+	MaxWorkflowJobPages int
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
@@ -58,6 +72,10 @@ type MCPServerConfig struct {
 	// RepoAccessTTL overrides the default TTL for repository access cache entries.
 	RepoAccessTTL *time.Duration
 
+	// LockdownTrustedOrgs lists repository owners whose content lockdown mode
+	// never filters.
+	LockdownTrustedOrgs []string
+
 	// ExcludeTools is a list of tool names that should be disabled regardless of
 	// other configuration. These tools will be excluded even if their toolset is enabled
 	// or they are explicitly listed in EnabledTools.
@@ -72,6 +90,10 @@ type MCPServerConfig struct {
 	// request instead of the static Token.
 	TokenProvider func() string
 
+	// RedactFields lists field names whose string values are replaced
+	// with RedactedValue in tool output. Empty disables redaction.
+	RedactFields []string
+
 	// ToolHandlerMiddleware wraps every registered tool handler. Unlike MCP
 	// receiving middleware, these wrappers execute inside Server.callTool, so
 	// SDK result finalization still runs on results they return.
@@ -83,6 +105,15 @@ type MCPServerConfig struct {
 
 type MCPServerOption func(*mcp.ServerOptions)
 
+// UserAgent returns the configured User-Agent for outbound GitHub API requests,
+// falling back to github-mcp-server/<version> when none is configured.
+func UserAgent(userAgent, version string) string {
+	if userAgent != "" {
+		return userAgent
+	}
+	return fmt.Sprintf("github-mcp-server/%s", version)
+}
+
 func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependencies, inv *inventory.Inventory, middleware ...mcp.Middleware) (*mcp.Server, error) {
 	// Create the MCP server
 	serverOpts := &mcp.ServerOptions{
@@ -109,7 +140,11 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 	}
 
 	// Register GitHub tools/resources/prompts from the inventory.
-	inv.RegisterAll(ctx, ghServer, deps, cfg.ToolHandlerMiddleware...)
+	toolMiddleware := cfg.ToolHandlerMiddleware
+	if len(cfg.RedactFields) > 0 {
+		toolMiddleware = append(slices.Clone(toolMiddleware), RedactionMiddleware(cfg.RedactFields, redactionExemptTools))
+	}
+	inv.RegisterAll(ctx, ghServer, deps, toolMiddleware...)
 
 	// Register MCP App UI resources whenever the embedded UI assets are
 	// available. The resources are static HTML and are only referenced by
@@ -204,3 +239,15 @@ func MarshalledTextResult(v any) *mcp.CallToolResult {
 
 	return utils.NewToolResultText(string(data))
 }
+
+// withEmptyListMessage adds a human-readable "message" to a list response when
+// the list stored under key is empty, so agents do not mistake an empty page
+// for a failure. The empty list is always encoded as an array rather than null.
+// Responses with results are left unchanged.
+func withEmptyListMessage[T any](response map[string]any, key string, list []T) map[string]any {
+	if len(list) == 0 {
+		response[key] = []T{}
+		response["message"] = fmt.Sprintf("No %s matched", key)
+	}
+	return response
+}
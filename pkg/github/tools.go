@@ -188,11 +188,15 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetMe(t),
 		GetTeams(t),
 		GetTeamMembers(t),
+		GetToolInfo(t),
+		ListScopeFilteredTools(t),
+		GetRateLimit(t),
 
 		// Repository tools
 		SearchRepositories(t),
 		GetFileContents(t),
 		LegacyGetFileContents(t),
+		GetFiles(t),
 		ListCommits(t),
 		LegacyListCommits(t),
 		SearchCode(t),
@@ -217,6 +221,19 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		StarRepository(t),
 		UnstarRepository(t),
 		ListRepositoryCollaborators(t),
+		ListOrgRepos(t),
+		ListMyRepos(t),
+		UpdateRepositorySettings(t),
+		ListForks(t),
+		GetReadme(t),
+		GetArchiveLink(t),
+		ListAutolinks(t),
+		CreateAutolink(t),
+		ListDeployKeys(t),
+		CreateDeployKey(t),
+		DeleteDeployKey(t),
+		GetPages(t),
+		ListPagesBuilds(t),
 
 		// Git tools
 		GetRepositoryTree(t),
@@ -231,6 +248,8 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ListIssueFields(t),
 		IssueWrite(t),
 		AddIssueComment(t),
+		LockIssue(t),
+		UnlockIssue(t),
 		SubIssueWrite(t),
 		IssueDependencyRead(t),
 		IssueDependencyWrite(t),
@@ -252,6 +271,7 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		CreatePullRequest(t),
 		UpdatePullRequest(t),
 		PullRequestReviewWrite(t),
+		PullRequestAutoMergeWrite(t),
 		AddCommentToPendingReview(t),
 		AddReplyToPullRequestComment(t),
 
@@ -268,10 +288,12 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		// Code security tools
 		GetCodeScanningAlert(t),
 		ListCodeScanningAlerts(t),
+		UpdateCodeScanningAlert(t),
 
 		// Secret protection tools
 		GetSecretScanningAlert(t),
 		ListSecretScanningAlerts(t),
+		UpdateSecretScanningAlert(t),
 
 		// Dependabot tools
 		GetDependabotAlert(t),
@@ -297,6 +319,7 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ActionsGet(t),
 		ActionsRunTrigger(t),
 		ActionsGetJobLogs(t),
+		ActionsDiagnoseWorkflowRun(t),
 
 		// Security advisories tools
 		ListGlobalSecurityAdvisories(t),
@@ -309,6 +332,14 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetGist(t),
 		CreateGist(t),
 		UpdateGist(t),
+		ListGistComments(t),
+		CreateGistComment(t),
+		IsGistStarred(t),
+		StarGist(t),
+		UnstarGist(t),
+		ForkGist(t),
+		ListGistCommits(t),
+		GetGistRevision(t),
 
 		// Project tools
 		ProjectsList(t),
@@ -346,6 +377,7 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GranularUpdatePullRequestState(t),
 		GranularUpdatePullRequestDraftState(t),
 		GranularRequestPullRequestReviewers(t),
+		GranularRemovePullRequestReviewers(t),
 		GranularCreatePullRequestReview(t),
 		GranularSubmitPendingPullRequestReview(t),
 		GranularDeletePendingPullRequestReview(t),
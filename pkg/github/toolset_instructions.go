@@ -39,15 +39,24 @@ func generateProjectsToolsetInstructions(_ *inventory.Inventory) string {
 
 Workflow: 1) list_project_fields (get field IDs), 2) list_project_items (with pagination), 3) optional updates.
 
-Project lifecycle: Use create_project to create a new ProjectsV2 for a user or organization (requires owner_type and title). Returns the new project's id, number, title, and url; pass the returned number as project_number to subsequent project tools.
+Project lifecycle: Use create_project to create a new ProjectsV2 for a user or organization (requires owner_type and title). Returns the new project's id, number, title, and url; pass the returned number as project_number to subsequent project tools. Use update_project to change the title, short_description, or readme, or set closed to close or reopen a project.
 
 Iteration fields: Use create_iteration_field to add a new ITERATION field (e.g. "Sprint") to an existing project. Required: field_name, iteration_duration (days), start_date (YYYY-MM-DD). Only pass the iterations array when iterations need varying durations, breaks between them, or specific titles; otherwise omit it and GitHub creates three default iterations of iteration_duration days starting on start_date.
 
 Status updates: Use list_project_status_updates to read recent project status updates (newest first). Use get_project_status_update with a node ID to get a single update. Use create_project_status_update to create a new status update for a project.
 
+Moving items: Use set_project_item_status with status_name (e.g. "In Progress") to move an item between Status columns without looking up field or option IDs. Unknown names return the list of valid options.
+
+Views: Use list_project_views to get each view's number, name, and layout (board, table, or roadmap). View numbers are needed to deep-link to a view (e.g. https://github.com/orgs/ORG/projects/N/views/VIEW).
+
+Single items: Use get_project_item with all_fields=true to get every field value of one item without looking up field IDs first.
+
+Reporting: Use export_project_items with the same fields/field_names and pagination as list_project_items to get {columns, rows}, one column per field. Prefer it when summarizing or tabulating items.
+
 Field usage:
 	- Call list_project_fields first to understand available fields and get IDs/types before filtering.
 	- Use EXACT returned field names (case-insensitive match). Don't invent names or IDs.
+	- To look up a single field's ID and options by name, use get_project_field with field_name instead of field_id.
 	- Iteration synonyms (sprint/cycle) only if that field exists; map to the actual name (e.g. sprint:@current).
 	- Only include filters for fields that exist and are relevant.
 
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// DebugEffectiveConfigToolName is the tool registered when
+// ServerConfig.EnableDebugConfigTool is set.
+const DebugEffectiveConfigToolName = "debug_effective_config"
+
+// EffectiveConfig is what the server resolved for a single request from its
+// static configuration, the URL path and the X-MCP-* headers.
+type EffectiveConfig struct {
+	ReadOnly      bool     `json:"read_only"`
+	Toolsets      []string `json:"toolsets"`
+	Tools         []string `json:"tools"`
+	ExcludedTools []string `json:"excluded_tools,omitempty"`
+	Features      []string `json:"features"`
+	InsidersMode  bool     `json:"insiders_mode"`
+	LockdownMode  bool     `json:"lockdown_mode"`
+	ScopeFiltered bool     `json:"scope_filtered"`
+	TokenScopes   []string `json:"token_scopes,omitempty"`
+}
+
+// effectiveConfig resolves the configuration applied to r, whose tools were
+// filtered into inv.
+func (h *Handler) effectiveConfig(r *http.Request, inv *inventory.Inventory) EffectiveConfig {
+	ctx := r.Context()
+
+	cfg := EffectiveConfig{
+		ReadOnly:      h.config.ReadOnly || ghcontext.IsReadonly(ctx),
+		Toolsets:      []string{},
+		Tools:         []string{},
+		ExcludedTools: append(slices.Clone(h.config.ExcludeTools), ghcontext.GetExcludeTools(ctx)...),
+		Features:      []string{},
+		InsidersMode:  h.config.InsidersMode || ghcontext.IsInsidersMode(ctx),
+	}
+	for _, ts := range inv.EnabledToolsets() {
+		cfg.Toolsets = append(cfg.Toolsets, string(ts.ID))
+	}
+	for _, tool := range inv.AvailableTools(ctx) {
+		cfg.Tools = append(cfg.Tools, tool.Tool.Name)
+	}
+
+	requested := append(slices.Clone(h.config.EnabledFeatures), ghcontext.GetHeaderFeatures(ctx)...)
+	for flag, enabled := range github.ResolveFeatureFlags(requested, cfg.InsidersMode) {
+		if enabled {
+			cfg.Features = append(cfg.Features, flag)
+		}
+	}
+	slices.Sort(cfg.Features)
+
+	if h.deps != nil {
+		cfg.LockdownMode = h.deps.GetFlags(ctx).LockdownMode
+	}
+
+	// PATScopeFilter only filters tools for classic PATs, whose scopes are
+	// placed in the context by the WithPATScopes middleware.
+	if tokenInfo, ok := ghcontext.GetTokenInfo(ctx); ok && tokenInfo != nil && tokenInfo.TokenType == utils.TokenTypePersonalAccessToken {
+		cfg.ScopeFiltered = true
+		cfg.TokenScopes, _ = ghcontext.GetTokenScopes(ctx)
+	}
+
+	return cfg
+}
+
+// registerDebugConfigTool adds a read-only tool to s that reports the
+// effective configuration of the request it was created for. It is
+// registered outside the inventory so that toolset and tool filters never
+// hide it.
+func (h *Handler) registerDebugConfigTool(s *mcp.Server, r *http.Request, inv *inventory.Inventory) {
+	cfg := h.effectiveConfig(r, inv)
+	s.AddTool(&mcp.Tool{
+		Name:        DebugEffectiveConfigToolName,
+		Description: "Report the configuration the server resolved for this request: read-only mode, enabled toolsets and tools, feature flags, lockdown mode, and whether tools were filtered by token scopes.",
+		Annotations: &mcp.ToolAnnotations{
+			Title:        "Show effective server configuration",
+			ReadOnlyHint: true,
+		},
+		InputSchema: &jsonschema.Schema{Type: "object"},
+	}, func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		b, err := json.Marshal(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return utils.NewToolResultText(string(b)), nil
+	})
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// callDebugConfigTool sends a tools/call for debug_effective_config to path
+// and returns the response status and body.
+func callDebugConfigTool(t *testing.T, cfg *ServerConfig, path string) (int, string) {
+	t.Helper()
+
+	apiHost, err := utils.NewAPIHost("https://api.github.com")
+	require.NoError(t, err)
+
+	handler := NewHTTPMcpHandler(
+		context.Background(),
+		cfg,
+		nil,
+		translations.NullTranslationHelper,
+		slog.Default(),
+		apiHost,
+		WithInventoryFactory(func(r *http.Request) (*inventory.Inventory, error) {
+			b := inventory.NewBuilder().
+				SetTools(testTools()).
+				WithToolsets([]string{"all"}).
+				WithFeatureChecker(createHTTPFeatureChecker(nil, false))
+			return InventoryFiltersForRequest(r, b).Build()
+		}),
+		WithGitHubMCPServerFactory(func(_ *http.Request, _ github.ToolDependencies, _ *inventory.Inventory, _ *github.MCPServerConfig) (*mcp.Server, error) {
+			return mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0.0.1"}, nil), nil
+		}),
+		WithScopeFetcher(allScopesFetcher{}),
+	)
+
+	r := chi.NewRouter()
+	handler.RegisterMiddleware(r)
+	handler.RegisterRoutes(r)
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"debug_effective_config","arguments":{}}}`
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(headers.AuthorizationHeader, "Bearer github_pat_xyz")
+	req.Header.Set(headers.ContentTypeHeader, headers.ContentTypeJSON)
+	req.Header.Set(headers.AcceptHeader, strings.Join([]string{headers.ContentTypeJSON, headers.ContentTypeEventStream}, ", "))
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr.Code, rr.Body.String()
+}
+
+func TestDebugEffectiveConfigTool(t *testing.T) {
+	status, payload := callDebugConfigTool(t, &ServerConfig{Version: "test", EnableDebugConfigTool: true}, "/x/repos/readonly")
+	require.Equal(t, http.StatusOK, status, payload)
+
+	// The stateless handler may answer with a single server-sent event.
+	if i := strings.Index(payload, "data: "); i >= 0 {
+		payload = strings.TrimSpace(payload[i+len("data: "):])
+	}
+
+	var rpc struct {
+		Result struct {
+			IsError bool `json:"isError"`
+			Content []struct {
+				Text string `json:"text"`
+			} `json:"content"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(payload), &rpc), payload)
+	require.False(t, rpc.Result.IsError)
+	require.Len(t, rpc.Result.Content, 1)
+
+	var cfg EffectiveConfig
+	require.NoError(t, json.Unmarshal([]byte(rpc.Result.Content[0].Text), &cfg))
+	assert.True(t, cfg.ReadOnly)
+	assert.Equal(t, []string{"repos"}, cfg.Toolsets)
+	assert.ElementsMatch(t, []string{"get_file_contents", "hidden_by_holdback"}, cfg.Tools)
+	assert.False(t, cfg.InsidersMode)
+	assert.False(t, cfg.ScopeFiltered)
+}
+
+func TestDebugEffectiveConfigToolDisabledByDefault(t *testing.T) {
+	_, payload := callDebugConfigTool(t, &ServerConfig{Version: "test"}, "/x/repos/readonly")
+	assert.NotContains(t, payload, `"read_only"`)
+}
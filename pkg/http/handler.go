@@ -200,11 +200,13 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ghServer, err := h.githubMcpServerFactory(r, h.deps, invToUse, &github.MCPServerConfig{
-		Version:           h.config.Version,
-		Translator:        h.t,
-		ContentWindowSize: h.config.ContentWindowSize,
-		Logger:            h.logger,
-		RepoAccessTTL:     h.config.RepoAccessCacheTTL,
+		Version:             h.config.Version,
+		Translator:          h.t,
+		ContentWindowSize:   h.config.ContentWindowSize,
+		MaxWorkflowJobPages: h.config.MaxWorkflowJobPages,
+		Logger:              h.logger,
+		RepoAccessTTL:       h.config.RepoAccessCacheTTL,
+		RedactFields:        h.config.RedactFields,
 		// Explicitly set empty capabilities. inv.ForMCPRequest currently returns nothing for Initialize.
 		ServerOptions: []github.MCPServerOption{
 			func(so *mcp.ServerOptions) {
@@ -223,6 +225,10 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if h.config.EnableDebugConfigTool {
+		h.registerDebugConfigTool(ghServer, r, inv)
+	}
+
 	// Cross-origin protection is intentionally left unset: this server
 	// authenticates via bearer tokens (not cookies), so Sec-Fetch-Site CSRF
 	// checks are unnecessary and would block browser-based MCP clients. As of
@@ -112,6 +112,20 @@ func TestInventoryFiltersForRequest(t *testing.T) {
 			},
 			expectedTools: []string{"get_file_contents", "create_repository", "list_issues"},
 		},
+		{
+			name: "tool patterns expand to matching tools",
+			contextSetup: func(ctx context.Context) context.Context {
+				return ghcontext.WithTools(ctx, []string{"*_issues", "create_*"})
+			},
+			expectedTools: []string{"list_issues", "create_repository"},
+		},
+		{
+			name: "tool pattern matching nothing yields no tools",
+			contextSetup: func(ctx context.Context) context.Context {
+				return ghcontext.WithTools(ctx, []string{"pull_request_*"})
+			},
+			expectedTools: []string{},
+		},
 		{
 			name: "excluded tools removes specific tools",
 			contextSetup: func(ctx context.Context) context.Context {
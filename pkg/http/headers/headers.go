@@ -25,6 +25,8 @@ const (
 	ForwardedHostHeader = "X-Forwarded-Host"
 	// ForwardedProtoHeader is a standard HTTP Header for preserving the original protocol when proxying.
 	ForwardedProtoHeader = "X-Forwarded-Proto"
+	// ForwardedPrefixHeader is a de facto standard HTTP Header carrying the path prefix a proxy stripped before forwarding.
+	ForwardedPrefixHeader = "X-Forwarded-Prefix"
 
 	// RequestHmacHeader is used to authenticate requests to the Raw API.
 	RequestHmacHeader = "Request-Hmac"
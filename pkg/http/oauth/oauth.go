@@ -5,9 +5,11 @@ package oauth
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/go-chi/chi/v5"
 	"github.com/modelcontextprotocol/go-sdk/auth"
@@ -17,15 +19,19 @@ import (
 const (
 	// OAuthProtectedResourcePrefix is the well-known path prefix for OAuth protected resource metadata.
 	OAuthProtectedResourcePrefix = "/.well-known/oauth-protected-resource"
+
+	// DefaultResourceName is the resource_name advertised in the protected
+	// resource metadata when Config.ResourceName is empty.
+	DefaultResourceName = "GitHub MCP Server"
 )
 
-// SupportedScopes lists every OAuth scope that an MCP tool may require. It is the
-// source of truth in two places: HTTP mode advertises it as scopes_supported in
-// the protected-resource metadata, and stdio OAuth login requests it by default
-// and then filters the exposed tools to the granted scopes. A tool whose required
-// scope is absent here is therefore hidden under default OAuth even though a PAT
-// carrying that scope would expose it, so keep this list in sync with tool scope
-// requirements when scopes change.
+// SupportedScopes lists every OAuth scope that an MCP tool may require. Stdio
+// OAuth login requests it by default and then filters the exposed tools to the
+// granted scopes, so a tool whose required scope is absent here is hidden under
+// default OAuth even though a PAT carrying that scope would expose it; keep this
+// list in sync with tool scope requirements when scopes change. HTTP mode
+// derives scopes_supported from the global tool scope map instead and only
+// falls back to this list when the map is empty.
 var SupportedScopes = []string{
 	"repo",
 	"read:org",
@@ -48,17 +54,28 @@ type Config struct {
 	BaseURL string
 
 	// AuthorizationServer is the OAuth authorization server URL.
-	// Defaults to GitHub's OAuth server if not specified.
+	// Defaults to GitHub's OAuth server if neither it nor
+	// AuthorizationServers is specified.
 	AuthorizationServer string
 
+	// AuthorizationServers lists additional OAuth authorization server URLs,
+	// for example an enterprise identity provider alongside GitHub's OAuth
+	// server. They are advertised after AuthorizationServer, in order.
+	AuthorizationServers []string
+
+	// ResourceName is the human-readable resource_name advertised in the
+	// protected resource metadata. Defaults to DefaultResourceName.
+	ResourceName string
+
 	// ResourcePath is the externally visible base path for the MCP server (e.g., "/mcp").
 	// This is used to restore the original path when a proxy strips a base path before forwarding.
-	// If empty, requests are treated as already using the external path.
+	// If empty, requests are treated as already using the external path. A trusted
+	// X-Forwarded-Prefix header takes precedence (see TrustProxyHeaders).
 	ResourcePath string
 
-	// TrustProxyHeaders indicates whether X-Forwarded-Host and X-Forwarded-Proto
-	// should be honored when deriving the effective host and scheme for OAuth
-	// resource URLs. This must only be enabled when the server is deployed
+	// TrustProxyHeaders indicates whether X-Forwarded-Host, X-Forwarded-Proto
+	// and X-Forwarded-Prefix should be honored when deriving the effective
+	// host, scheme and base path for OAuth resource URLs. This must only be enabled when the server is deployed
 	// behind a trusted proxy that sets these headers; otherwise an untrusted
 	// client can influence the OAuth resource metadata URL advertised to MCP
 	// clients. When BaseURL is set, it always takes precedence and these
@@ -68,8 +85,10 @@ type Config struct {
 
 // AuthHandler handles OAuth-related HTTP endpoints.
 type AuthHandler struct {
-	cfg     *Config
-	apiHost utils.APIHostResolver
+	cfg             *Config
+	apiHost         utils.APIHostResolver
+	resourceName    string
+	scopesSupported []string
 }
 
 // NewAuthHandler creates a new OAuth auth handler.
@@ -86,12 +105,44 @@ func NewAuthHandler(cfg *Config, apiHost utils.APIHostResolver) (*AuthHandler, e
 		}
 	}
 
+	resourceName := cfg.ResourceName
+	if resourceName == "" {
+		resourceName = DefaultResourceName
+	}
+
 	return &AuthHandler{
-		cfg:     cfg,
-		apiHost: apiHost,
+		cfg:             cfg,
+		apiHost:         apiHost,
+		resourceName:    resourceName,
+		scopesSupported: supportedScopes(),
 	}, nil
 }
 
+// supportedScopes returns the sorted union of the required and accepted scopes
+// of every tool in the global tool scope map, so that the advertised scopes
+// follow tool definitions without a hand-maintained list. It returns
+// SupportedScopes when the map has not been populated.
+func supportedScopes() []string {
+	toolScopes, err := scopes.GetToolScopeMap()
+	if err != nil || len(toolScopes) == 0 {
+		return SupportedScopes
+	}
+
+	set := make(scopes.ScopeSet)
+	for _, info := range toolScopes {
+		for _, s := range info.RequiredScopes {
+			set[scopes.Scope(s)] = true
+		}
+		for _, s := range info.AcceptedScopes {
+			set[scopes.Scope(s)] = true
+		}
+	}
+	if len(set) == 0 {
+		return SupportedScopes
+	}
+	return set.ToStringSlice()
+}
+
 // routePatterns defines the route patterns for OAuth protected resource metadata.
 var routePatterns = []string{
 	"",          // Root: /.well-known/oauth-protected-resource
@@ -116,27 +167,25 @@ func (h *AuthHandler) metadataHandler() http.Handler {
 		ctx := r.Context()
 		resourcePath := resolveResourcePath(
 			strings.TrimPrefix(r.URL.Path, OAuthProtectedResourcePrefix),
-			h.cfg.ResourcePath,
+			effectiveBasePath(r, h.cfg),
 		)
 		resourceURL := h.buildResourceURL(r, resourcePath)
 
-		var authorizationServerURL string
-		if h.cfg.AuthorizationServer != "" {
-			authorizationServerURL = h.cfg.AuthorizationServer
-		} else {
+		authorizationServers := h.configuredAuthorizationServers()
+		if len(authorizationServers) == 0 {
 			authURL, err := h.apiHost.AuthorizationServerURL(ctx)
 			if err != nil {
 				http.Error(w, fmt.Sprintf("failed to resolve authorization server URL: %v", err), http.StatusInternalServerError)
 				return
 			}
-			authorizationServerURL = authURL.String()
+			authorizationServers = []string{authURL.String()}
 		}
 
 		metadata := &oauthex.ProtectedResourceMetadata{
 			Resource:               resourceURL,
-			AuthorizationServers:   []string{authorizationServerURL},
-			ResourceName:           "GitHub MCP Server",
-			ScopesSupported:        SupportedScopes,
+			AuthorizationServers:   authorizationServers,
+			ResourceName:           h.resourceName,
+			ScopesSupported:        h.scopesSupported,
 			BearerMethodsSupported: []string{"header"},
 		}
 
@@ -144,6 +193,18 @@ func (h *AuthHandler) metadataHandler() http.Handler {
 	})
 }
 
+// configuredAuthorizationServers returns AuthorizationServer followed by
+// AuthorizationServers, skipping empty and duplicate entries.
+func (h *AuthHandler) configuredAuthorizationServers() []string {
+	servers := make([]string, 0, 1+len(h.cfg.AuthorizationServers))
+	for _, server := range append([]string{h.cfg.AuthorizationServer}, h.cfg.AuthorizationServers...) {
+		if server != "" && !slices.Contains(servers, server) {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
 // routesForPattern generates route variants for a given pattern.
 // GitHub strips the /mcp prefix before forwarding, so we register both variants:
 // - With /mcp prefix: for direct access or when GitHub doesn't strip
@@ -187,11 +248,26 @@ func resolveResourcePath(path, basePath string) string {
 // ResolveResourcePath returns the externally visible resource path for a request.
 // Exported for use by middleware.
 func ResolveResourcePath(r *http.Request, cfg *Config) string {
-	basePath := ""
-	if cfg != nil {
-		basePath = cfg.ResourcePath
+	return resolveResourcePath(r.URL.Path, effectiveBasePath(r, cfg))
+}
+
+// effectiveBasePath returns the base path a proxy stripped from r. The
+// X-Forwarded-Prefix header is only honored when cfg.TrustProxyHeaders is
+// true, for the same reason as in GetEffectiveHostAndScheme; otherwise, or
+// when the header is absent, the configured ResourcePath is used.
+func effectiveBasePath(r *http.Request, cfg *Config) string {
+	if cfg == nil {
+		return ""
+	}
+	if cfg.TrustProxyHeaders {
+		// Chained proxies may append their own prefixes; the first one is
+		// the prefix closest to the client.
+		prefix, _, _ := strings.Cut(r.Header.Get(headers.ForwardedPrefixHeader), ",")
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			return prefix
+		}
 	}
-	return resolveResourcePath(r.URL.Path, basePath)
+	return cfg.ResourcePath
 }
 
 // buildResourceURL constructs the full resource URL for OAuth metadata.
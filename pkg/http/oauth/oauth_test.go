@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/github/github-mcp-server/pkg/http/headers"
+	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
@@ -243,6 +244,54 @@ func TestResolveResourcePath(t *testing.T) {
 			},
 			expectedPath: "/api/x/repos",
 		},
+		{
+			name: "trusted forwarded prefix restored",
+			cfg: &Config{
+				TrustProxyHeaders: true,
+			},
+			setupRequest: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/readonly", nil)
+				req.Header.Set(headers.ForwardedPrefixHeader, "/github/mcp")
+				return req
+			},
+			expectedPath: "/github/mcp/readonly",
+		},
+		{
+			name: "trusted forwarded prefix overrides configured base path",
+			cfg: &Config{
+				ResourcePath:      "/mcp",
+				TrustProxyHeaders: true,
+			},
+			setupRequest: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.Header.Set(headers.ForwardedPrefixHeader, "/tenant-a/mcp/, /internal")
+				return req
+			},
+			expectedPath: "/tenant-a/mcp",
+		},
+		{
+			name: "configured base path used when forwarded prefix is absent",
+			cfg: &Config{
+				ResourcePath:      "/mcp",
+				TrustProxyHeaders: true,
+			},
+			setupRequest: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/readonly", nil)
+			},
+			expectedPath: "/mcp/readonly",
+		},
+		{
+			name: "untrusted forwarded prefix ignored",
+			cfg: &Config{
+				ResourcePath: "/mcp",
+			},
+			setupRequest: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/readonly", nil)
+				req.Header.Set(headers.ForwardedPrefixHeader, "/evil")
+				return req
+			},
+			expectedPath: "/mcp/readonly",
+		},
 	}
 
 	for _, tc := range tests {
@@ -468,6 +517,55 @@ func TestHandleProtectedResource(t *testing.T) {
 				assert.Equal(t, "https://custom.auth.example.com/oauth", authServers[0])
 			},
 		},
+		{
+			name: "custom resource name in response",
+			cfg: &Config{
+				BaseURL:      "https://api.example.com",
+				ResourceName: "Acme GitHub MCP",
+			},
+			path:               OAuthProtectedResourcePrefix,
+			host:               "api.example.com",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, "Acme GitHub MCP", body["resource_name"])
+			},
+		},
+		{
+			name: "multiple authorization servers in response",
+			cfg: &Config{
+				BaseURL:             "https://api.example.com",
+				AuthorizationServer: "https://ghes.example.com/login/oauth",
+				AuthorizationServers: []string{
+					"https://idp.example.com",
+					"https://ghes.example.com/login/oauth",
+				},
+			},
+			path:               OAuthProtectedResourcePrefix,
+			host:               "api.example.com",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, []any{"https://ghes.example.com/login/oauth", "https://idp.example.com"}, body["authorization_servers"])
+			},
+		},
+		{
+			name: "authorization servers without the single-value field",
+			cfg: &Config{
+				BaseURL:              "https://api.example.com",
+				AuthorizationServers: []string{"https://idp.example.com", "https://github.com/login/oauth"},
+			},
+			path:               OAuthProtectedResourcePrefix,
+			host:               "api.example.com",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+			validateResponse: func(t *testing.T, body map[string]any) {
+				t.Helper()
+				assert.Equal(t, []any{"https://idp.example.com", "https://github.com/login/oauth"}, body["authorization_servers"])
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -585,6 +683,53 @@ func TestSupportedScopes(t *testing.T) {
 	assert.Equal(t, expectedScopes, SupportedScopes)
 }
 
+// TestScopesSupportedFromToolScopeMap is not parallel because it replaces the
+// global tool scope map; parallel tests in this package only start after it
+// has been restored.
+func TestScopesSupportedFromToolScopeMap(t *testing.T) {
+	previous, err := scopes.GetToolScopeMap()
+	require.NoError(t, err)
+	t.Cleanup(func() { scopes.SetGlobalToolScopeMap(previous) })
+
+	scopes.SetGlobalToolScopeMap(scopes.ToolScopeMap{
+		"get_issue": {
+			RequiredScopes: []string{"repo"},
+			AcceptedScopes: []string{"repo"},
+		},
+		"list_org_members": {
+			RequiredScopes: []string{"read:org"},
+			AcceptedScopes: []string{"read:org", "write:org", "admin:org"},
+		},
+		"new_tool": {
+			RequiredScopes: []string{"admin:repo_hook"},
+			AcceptedScopes: []string{"admin:repo_hook"},
+		},
+	})
+
+	dotcomHost, err := utils.NewAPIHost("https://api.github.com")
+	require.NoError(t, err)
+
+	handler, err := NewAuthHandler(&Config{BaseURL: "https://api.example.com"}, dotcomHost)
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+
+	req := httptest.NewRequest(http.MethodGet, OAuthProtectedResourcePrefix, nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		ScopesSupported []string `json:"scopes_supported"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []string{"admin:org", "admin:repo_hook", "read:org", "repo", "write:org"}, response.ScopesSupported)
+
+	scopes.SetGlobalToolScopeMap(nil)
+	assert.Equal(t, SupportedScopes, supportedScopes(), "an empty map falls back to SupportedScopes")
+}
+
 func TestProtectedResourceResponseFormat(t *testing.T) {
 	t.Parallel()
 
@@ -629,8 +774,26 @@ func TestProtectedResourceResponseFormat(t *testing.T) {
 	// Verify authorization_servers is an array with GitHub OAuth
 	authServers, ok := response["authorization_servers"].([]any)
 	require.True(t, ok)
-	assert.Len(t, authServers, 1)
-	assert.Equal(t, defaultAuthorizationServer, authServers[0])
+	assert.Equal(t, []any{defaultAuthorizationServer}, authServers)
+
+	// Every configured authorization server is listed in the array
+	handler, err = NewAuthHandler(&Config{
+		BaseURL:              "https://api.example.com",
+		AuthorizationServer:  "https://ghes.example.com/login/oauth",
+		AuthorizationServers: []string{"https://idp.example.com"},
+	}, dotcomHost)
+	require.NoError(t, err)
+
+	router = chi.NewRouter()
+	handler.RegisterRoutes(router)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	response = nil
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []any{"https://ghes.example.com/login/oauth", "https://idp.example.com"}, response["authorization_servers"])
 }
 
 func TestOAuthProtectedResourcePrefix(t *testing.T) {
@@ -17,10 +17,12 @@ import (
 	"github.com/github/github-mcp-server/pkg/github"
 	"github.com/github/github-mcp-server/pkg/http/middleware"
 	"github.com/github/github-mcp-server/pkg/http/oauth"
+	"github.com/github/github-mcp-server/pkg/http/transport"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	"github.com/github/github-mcp-server/pkg/observability"
 	"github.com/github/github-mcp-server/pkg/observability/metrics"
+	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -34,6 +36,22 @@ type ServerConfig struct {
 	// GitHub Host to target for API requests (e.g. github.com or github.enterprise.com)
 	Host string
 
+	// UserAgent overrides the User-Agent sent with outbound GitHub API requests.
+	// Defaults to github-mcp-server/<version> when empty.
+	UserAgent string
+
+	// Proxy routes outbound GitHub API requests through an HTTP(S) proxy.
+	// When Proxy.URL is empty, the standard proxy environment variables apply.
+	Proxy transport.ProxyConfig
+
+	// TLS configures client certificates and trusted CAs for outbound
+	// GitHub API requests (e.g. GHES instances requiring mutual TLS).
+	TLS transport.TLSConfig
+
+	// ResponseCache enables an in-memory cache of GET responses from the
+	// GitHub API when ResponseCache.MaxEntries is positive.
+	ResponseCache transport.ResponseCacheConfig
+
 	// Port to listen on (default: 8082).
 	Port int
 
@@ -49,13 +67,22 @@ type ServerConfig struct {
 	// This is used to restore the original path when a proxy strips a base path before forwarding.
 	ResourcePath string
 
-	// TrustProxyHeaders indicates whether X-Forwarded-Host and X-Forwarded-Proto
-	// should be honored when constructing OAuth resource metadata URLs. Only
-	// enable this when the server is deployed behind a trusted proxy that sets
-	// these headers. When BaseURL is set, it always wins and this setting has
-	// no effect.
+	// TrustProxyHeaders indicates whether X-Forwarded-Host, X-Forwarded-Proto
+	// and X-Forwarded-Prefix should be honored when constructing OAuth resource
+	// metadata URLs. Only enable this when the server is deployed behind a
+	// trusted proxy that sets these headers. When BaseURL is set, it always
+	// wins over the host and scheme headers; X-Forwarded-Prefix still
+	// overrides ResourcePath.
 	TrustProxyHeaders bool
 
+	// OAuthAuthorizationServers lists additional OAuth authorization server
+	// URLs advertised in the protected resource metadata after GitHub's.
+	OAuthAuthorizationServers []string
+
+	// OAuthResourceName overrides the resource_name advertised in the
+	// protected resource metadata.
+	OAuthResourceName string
+
 	// ExportTranslations indicates if we should export translations
 	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#i18n--overriding-descriptions
 	ExportTranslations bool
@@ -69,12 +96,25 @@ type ServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// MaxWorkflowJobPages bounds how many pages of 100 jobs are read when
+	// collecting the jobs of a workflow run. Zero uses the default.
+	MaxWorkflowJobPages int
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
 
+	// LockdownTrustedOrgs lists repository owners whose content lockdown mode
+	// never filters.
+	LockdownTrustedOrgs []string
+
+	// WebhookSecret enables the /webhooks endpoint when set. Deliveries are
+	// verified against it and used to invalidate repo access and workflow ID
+	// cache entries as repositories change.
+	WebhookSecret string
+
 	// ScopeChallenge indicates if we should return OAuth scope challenges, and if we should perform
 	// tool filtering based on token scopes.
 	ScopeChallenge bool
@@ -100,6 +140,14 @@ type ServerConfig struct {
 
 	// InsidersMode expands to the curated set of feature flags enabled for insiders.
 	InsidersMode bool
+
+	// RedactFields lists field names whose string values are redacted
+	// from tool output. Empty disables redaction.
+	RedactFields []string
+
+	// EnableDebugConfigTool registers the debug_effective_config tool, which
+	// reports the configuration resolved for each request.
+	EnableDebugConfigTool bool
 }
 
 func RunHTTPServer(cfg ServerConfig) error {
@@ -125,10 +173,19 @@ func RunHTTPServer(cfg ServerConfig) error {
 	logger := slog.New(slogHandler)
 	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "lockdownEnabled", cfg.LockdownMode, "readOnly", cfg.ReadOnly, "insidersMode", cfg.InsidersMode)
 
-	apiHost, err := utils.NewAPIHost(cfg.Host)
+	outboundTransport, err := transport.NewOutboundTransport(transport.OutboundConfig{Proxy: cfg.Proxy, TLS: cfg.TLS})
+	if err != nil {
+		return fmt.Errorf("failed to configure outbound transport: %w", err)
+	}
+
+	apiHost, err := utils.NewAPIHost(cfg.Host, utils.WithHTTPClient(&http.Client{Transport: outboundTransport}))
 	if err != nil {
 		return fmt.Errorf("failed to parse API host: %w", err)
 	}
+	var baseTransport http.RoundTripper = outboundTransport
+	if cfg.ResponseCache.Enabled() {
+		baseTransport = transport.NewResponseCacheTransport(outboundTransport, cfg.ResponseCache)
+	}
 
 	repoAccessOpts := []lockdown.RepoAccessOption{
 		lockdown.WithLogger(logger.With("component", "lockdown")),
@@ -136,6 +193,9 @@ func RunHTTPServer(cfg ServerConfig) error {
 	if cfg.RepoAccessCacheTTL != nil {
 		repoAccessOpts = append(repoAccessOpts, lockdown.WithTTL(*cfg.RepoAccessCacheTTL))
 	}
+	if len(cfg.LockdownTrustedOrgs) > 0 {
+		repoAccessOpts = append(repoAccessOpts, lockdown.WithTrustedOrgs(cfg.LockdownTrustedOrgs...))
+	}
 
 	featureChecker := createHTTPFeatureChecker(cfg.EnabledFeatures, cfg.InsidersMode)
 
@@ -147,10 +207,14 @@ func RunHTTPServer(cfg ServerConfig) error {
 	deps := github.NewRequestDeps(
 		apiHost,
 		cfg.Version,
+		cfg.UserAgent,
+		baseTransport,
 		cfg.LockdownMode,
 		repoAccessOpts,
+		raw.NewContentCache(raw.DefaultContentCacheEntries),
 		t,
 		cfg.ContentWindowSize,
+		cfg.MaxWorkflowJobPages,
 		featureChecker,
 		obs,
 	)
@@ -163,9 +227,11 @@ func RunHTTPServer(cfg ServerConfig) error {
 
 	// Register OAuth protected resource metadata endpoints
 	oauthCfg := &oauth.Config{
-		BaseURL:           cfg.BaseURL,
-		ResourcePath:      cfg.ResourcePath,
-		TrustProxyHeaders: cfg.TrustProxyHeaders,
+		BaseURL:              cfg.BaseURL,
+		ResourcePath:         cfg.ResourcePath,
+		TrustProxyHeaders:    cfg.TrustProxyHeaders,
+		AuthorizationServers: cfg.OAuthAuthorizationServers,
+		ResourceName:         cfg.OAuthResourceName,
 	}
 
 	serverOptions := []HandlerOption{}
@@ -198,6 +264,17 @@ func RunHTTPServer(cfg ServerConfig) error {
 	})
 	logger.Info("OAuth protected resource endpoints registered", "baseURL", cfg.BaseURL)
 
+	if cfg.WebhookSecret != "" {
+		// The clients are unused: the handler only evicts entries from the
+		// cache table shared with the per-request instances.
+		repoAccess := lockdown.NewRepoAccessCache(nil, nil, repoAccessOpts...)
+		webhookHandler := NewWebhookHandler(cfg.WebhookSecret, repoAccess, logger.With("component", "webhooks"))
+		r.Group(func(r chi.Router) {
+			webhookHandler.RegisterRoutes(r)
+		})
+		logger.Info("webhook endpoint registered", "path", WebhooksPath)
+	}
+
 	addr := resolveListenAddress(cfg.ListenHost, cfg.Port)
 	httpSvr := http.Server{
 		Addr:              addr,
@@ -0,0 +1,130 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyConfig routes outbound GitHub API requests through an HTTP(S) proxy.
+type ProxyConfig struct {
+	// URL of the proxy (e.g. http://proxy.internal:3128). When empty, the
+	// HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment variables apply.
+	URL string
+
+	// Username and Password, when set, are sent as proxy basic auth and take
+	// precedence over any credentials embedded in URL.
+	Username string
+	Password string
+
+	// NoProxy lists hosts, domains, or CIDRs that bypass the proxy, using the
+	// same syntax as the NO_PROXY environment variable.
+	NoProxy []string
+}
+
+// TLSConfig configures client certificates and trusted CAs for outbound
+// GitHub API requests, e.g. for GHES instances that require mutual TLS.
+type TLSConfig struct {
+	// ClientCertFile and ClientKeyFile are PEM files holding the client
+	// certificate presented to the server. Both must be set together.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// CACertFile is a PEM bundle of additional CAs trusted when verifying the
+	// server certificate, on top of the system roots.
+	CACertFile string
+}
+
+// OutboundConfig configures the base transport for outbound GitHub API requests.
+type OutboundConfig struct {
+	Proxy ProxyConfig
+	TLS   TLSConfig
+}
+
+// NewOutboundTransport returns a clone of http.DefaultTransport configured
+// according to cfg.
+func NewOutboundTransport(cfg OutboundConfig) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.Proxy.URL != "" {
+		proxyFunc, err := newProxyFunc(cfg.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		t.Proxy = proxyFunc
+	}
+
+	tlsConfig, err := newTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		t.TLSClientConfig = tlsConfig
+	}
+
+	return t, nil
+}
+
+// newTLSConfig loads the configured certificate files, returning nil when no
+// TLS options are set so the transport keeps its defaults.
+func newTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	if cfg.ClientCertFile == "" && cfg.ClientKeyFile == "" && cfg.CACertFile == "" {
+		return nil, nil
+	}
+	if (cfg.ClientCertFile == "") != (cfg.ClientKeyFile == "") {
+		return nil, fmt.Errorf("client certificate and key must be provided together")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate %q and key %q: %w", cfg.ClientCertFile, cfg.ClientKeyFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.CACertFile != "" {
+		pem, err := os.ReadFile(cfg.CACertFile) //#nosec G304 -- operator-supplied path to their own CA bundle
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle %q: %w", cfg.CACertFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %q contains no valid PEM certificates", cfg.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+func newProxyFunc(cfg ProxyConfig) (func(*http.Request) (*url.URL, error), error) {
+	proxyURL, err := url.Parse(cfg.URL)
+	if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: must include a scheme and host", cfg.URL)
+	}
+	if cfg.Username != "" {
+		proxyURL.User = url.UserPassword(cfg.Username, cfg.Password)
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    strings.Join(cfg.NoProxy, ","),
+	}).ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, nil
+}
@@ -0,0 +1,241 @@
+package transport
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOutboundTransportProxy(t *testing.T) {
+	t.Parallel()
+
+	var gotURL, gotProxyAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL.String()
+		gotProxyAuth = r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	rt, err := NewOutboundTransport(OutboundConfig{
+		Proxy: ProxyConfig{
+			URL:      proxy.URL,
+			Username: "user",
+			Password: "secret",
+			NoProxy:  []string{"internal.example.com", ".corp.example.com"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("requests go through the proxy", func(t *testing.T) {
+		client := &http.Client{Transport: rt}
+		resp, err := client.Get("http://api.example.com/user")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "http://api.example.com/user", gotURL)
+		assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), gotProxyAuth)
+	})
+
+	t.Run("NoProxy entries bypass the proxy", func(t *testing.T) {
+		for _, target := range []string{"https://internal.example.com/api/v3/", "https://ghes.corp.example.com/api/v3/"} {
+			req, err := http.NewRequest(http.MethodGet, target, nil)
+			require.NoError(t, err)
+
+			proxyURL, err := rt.Proxy(req)
+			require.NoError(t, err)
+			assert.Nil(t, proxyURL, target)
+		}
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.github.com/", nil)
+		require.NoError(t, err)
+		proxyURL, err := rt.Proxy(req)
+		require.NoError(t, err)
+		require.NotNil(t, proxyURL)
+		wantURL, err := url.Parse(proxy.URL)
+		require.NoError(t, err)
+		assert.Equal(t, wantURL.Host, proxyURL.Host)
+	})
+}
+
+func TestNewOutboundTransportInvalidProxyURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewOutboundTransport(OutboundConfig{Proxy: ProxyConfig{URL: "proxy.internal:3128"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid proxy URL")
+}
+
+// testCA issues certificates for mutual TLS tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM-encoded certificate and key signed by the CA.
+func (ca *testCA) issue(t *testing.T, serial int64, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "mcp-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestNewOutboundTransportClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	serverCertPEM, serverKeyPEM := ca.issue(t, 2, x509.ExtKeyUsageServerAuth)
+	clientCertPEM, clientKeyPEM := ca.issue(t, 3, x509.ExtKeyUsageClientAuth)
+
+	serverCert, err := tls.X509KeyPair(serverCertPEM, serverKeyPEM)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	var gotClientCN string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotClientCN = r.TLS.PeerCertificates[0].Subject.CommonName
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := writeFile(t, dir, "ca.pem", ca.pem)
+	certFile := writeFile(t, dir, "client.pem", clientCertPEM)
+	keyFile := writeFile(t, dir, "client-key.pem", clientKeyPEM)
+
+	t.Run("presents the configured client certificate", func(t *testing.T) {
+		rt, err := NewOutboundTransport(OutboundConfig{
+			TLS: TLSConfig{ClientCertFile: certFile, ClientKeyFile: keyFile, CACertFile: caFile},
+		})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "mcp-test", gotClientCN)
+	})
+
+	t.Run("fails without a client certificate", func(t *testing.T) {
+		rt, err := NewOutboundTransport(OutboundConfig{TLS: TLSConfig{CACertFile: caFile}})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		require.Error(t, err)
+	})
+}
+
+func TestNewOutboundTransportInvalidTLSFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	garbage := writeFile(t, dir, "garbage.pem", []byte("not a certificate"))
+
+	tests := []struct {
+		name    string
+		cfg     TLSConfig
+		wantErr string
+	}{
+		{
+			name:    "cert without key",
+			cfg:     TLSConfig{ClientCertFile: garbage},
+			wantErr: "must be provided together",
+		},
+		{
+			name:    "missing cert file",
+			cfg:     TLSConfig{ClientCertFile: filepath.Join(dir, "missing.pem"), ClientKeyFile: garbage},
+			wantErr: "failed to load client certificate",
+		},
+		{
+			name:    "missing CA bundle",
+			cfg:     TLSConfig{CACertFile: filepath.Join(dir, "missing.pem")},
+			wantErr: "failed to read CA bundle",
+		},
+		{
+			name:    "CA bundle without certificates",
+			cfg:     TLSConfig{CACertFile: garbage},
+			wantErr: "contains no valid PEM certificates",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewOutboundTransport(OutboundConfig{TLS: tc.cfg})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
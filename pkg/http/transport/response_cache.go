@@ -0,0 +1,310 @@
+package transport
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FromCacheHeader is set on responses served by ResponseCacheTransport,
+// whether fresh from the cache or revalidated with a 304 from upstream.
+const FromCacheHeader = "X-From-Cache"
+
+// maxCachedBodySize bounds the size of a response body kept in the cache;
+// larger responses are passed through without being stored.
+const maxCachedBodySize = 1 << 20
+
+// ResponseCacheConfig configures the in-memory cache for GET responses.
+type ResponseCacheConfig struct {
+	// MaxEntries is the number of responses kept before the least recently
+	// used one is evicted. Zero disables the cache.
+	MaxEntries int
+
+	// TTL is how long a cached response is served without contacting GitHub.
+	// A response's Cache-Control max-age shortens it; once it lapses, a
+	// response with an ETag is revalidated with If-None-Match.
+	TTL time.Duration
+}
+
+// Enabled reports whether the configuration turns the cache on.
+func (c ResponseCacheConfig) Enabled() bool {
+	return c.MaxEntries > 0
+}
+
+// ResponseCacheTransport caches successful GET responses in a size-bounded
+// LRU so repeated reads within a session do not consume rate limit. Entries
+// are keyed by URL, Accept and Authorization so different media types and
+// different users never share a response. Any other method drops the cached
+// responses for the same URL path and for its parent collection, so a PATCH
+// to /repos/o/r/issues/1 also drops a cached /repos/o/r/issues listing.
+//
+// Only REST writes made through this transport invalidate entries. GraphQL
+// mutations and changes made outside the server are not seen until the
+// cached response's TTL lapses.
+type ResponseCacheTransport struct {
+	Transport http.RoundTripper
+
+	cfg ResponseCacheConfig
+	now func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	// paths indexes entries by URL path so writes can invalidate them
+	// without scanning the whole cache.
+	paths map[string]map[string]*list.Element
+}
+
+type cachedResponse struct {
+	key       string
+	path      string
+	status    int
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+// NewResponseCacheTransport wraps next with a response cache configured by cfg.
+func NewResponseCacheTransport(next http.RoundTripper, cfg ResponseCacheConfig) *ResponseCacheTransport {
+	return &ResponseCacheTransport{
+		Transport: next,
+		cfg:       cfg,
+		now:       time.Now,
+		lru:       list.New(),
+		entries:   make(map[string]*list.Element),
+		paths:     make(map[string]map[string]*list.Element),
+	}
+}
+
+func (t *ResponseCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.invalidatePath(req.URL.Path)
+		return t.Transport.RoundTrip(req)
+	}
+	if !cacheableRequest(req) {
+		return t.Transport.RoundTrip(req)
+	}
+
+	key := responseCacheKey(req)
+	cached := t.get(key)
+	if cached != nil && t.now().Before(cached.expiresAt) {
+		return cached.response(req), nil
+	}
+
+	outReq := req
+	if etag := cachedETag(cached); etag != "" {
+		outReq = req.Clone(req.Context())
+		outReq.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := t.Transport.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		_ = resp.Body.Close()
+		if ttl, ok := t.cacheTTL(resp.Header); ok {
+			t.refresh(cached, ttl, resp.Header)
+		}
+		return cached.response(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	ttl, ok := t.cacheTTL(resp.Header)
+	if !ok {
+		t.remove(key)
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCachedBodySize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.add(&cachedResponse{
+		key:       key,
+		path:      cachePath(req.URL.Path),
+		status:    resp.StatusCode,
+		header:    resp.Header.Clone(),
+		body:      body,
+		expiresAt: t.now().Add(ttl),
+	})
+	return resp, nil
+}
+
+// cacheableRequest reports whether a GET may be answered from the cache.
+// Conditional and ranged requests are left to the caller, as are requests
+// that explicitly ask to bypass caches.
+func cacheableRequest(req *http.Request) bool {
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" || req.Header.Get("Range") != "" {
+		return false
+	}
+	cc := req.Header.Get("Cache-Control")
+	return !strings.Contains(cc, "no-cache") && !strings.Contains(cc, "no-store")
+}
+
+// cacheTTL returns how long a response may be served from the cache, and
+// false when its Cache-Control forbids storing it.
+func (t *ResponseCacheTransport) cacheTTL(header http.Header) (time.Duration, bool) {
+	ttl := t.cfg.TTL
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(strings.ToLower(directive))
+		switch {
+		case directive == "no-store":
+			return 0, false
+		case directive == "no-cache":
+			ttl = 0
+		case strings.HasPrefix(directive, "max-age="):
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				ttl = min(ttl, time.Duration(seconds)*time.Second)
+			}
+		}
+	}
+	// A response that must be revalidated is only worth keeping for its ETag.
+	if ttl <= 0 && header.Get("ETag") == "" {
+		return 0, false
+	}
+	return max(ttl, 0), true
+}
+
+func responseCacheKey(req *http.Request) string {
+	auth := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(auth[:]) + " " + req.Header.Get("Accept") + " " + req.URL.String()
+}
+
+func cachedETag(cached *cachedResponse) string {
+	if cached == nil {
+		return ""
+	}
+	return cached.header.Get("ETag")
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	header := c.header.Clone()
+	header.Set(FromCacheHeader, "1")
+	return &http.Response{
+		Status:        strconv.Itoa(c.status) + " " + http.StatusText(c.status),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+func (t *ResponseCacheTransport) get(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	elem, ok := t.entries[key]
+	if !ok {
+		return nil
+	}
+	t.lru.MoveToFront(elem)
+	return elem.Value.(*cachedResponse)
+}
+
+func (t *ResponseCacheTransport) add(entry *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if elem, ok := t.entries[entry.key]; ok {
+		t.removeElement(elem)
+	}
+	elem := t.lru.PushFront(entry)
+	t.entries[entry.key] = elem
+	byKey, ok := t.paths[entry.path]
+	if !ok {
+		byKey = make(map[string]*list.Element)
+		t.paths[entry.path] = byKey
+	}
+	byKey[entry.key] = elem
+	for t.lru.Len() > t.cfg.MaxEntries {
+		t.removeElement(t.lru.Back())
+	}
+}
+
+// refresh extends a revalidated entry. The entry is replaced rather than
+// mutated because responses built from it may still be in flight.
+func (t *ResponseCacheTransport) refresh(entry *cachedResponse, ttl time.Duration, header http.Header) {
+	updated := *entry
+	updated.header = entry.header.Clone()
+	for _, name := range []string{"ETag", "Cache-Control", "Date"} {
+		if v := header.Get(name); v != "" {
+			updated.header.Set(name, v)
+		}
+	}
+	updated.expiresAt = t.now().Add(ttl)
+	t.add(&updated)
+}
+
+func (t *ResponseCacheTransport) remove(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if elem, ok := t.entries[key]; ok {
+		t.removeElement(elem)
+	}
+}
+
+// invalidatePath drops the entries cached for urlPath and for its parent
+// collection.
+func (t *ResponseCacheTransport) invalidatePath(urlPath string) {
+	urlPath = cachePath(urlPath)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.removePath(urlPath)
+	if parent := path.Dir(urlPath); parent != urlPath && parent != "/" {
+		t.removePath(parent)
+	}
+}
+
+// removePath drops every entry cached for urlPath. t.mu must be held.
+func (t *ResponseCacheTransport) removePath(urlPath string) {
+	for _, elem := range t.paths[urlPath] {
+		t.removeElement(elem)
+	}
+}
+
+// removeElement unlinks elem from the LRU and both indexes. t.mu must be held.
+func (t *ResponseCacheTransport) removeElement(elem *list.Element) {
+	entry := elem.Value.(*cachedResponse)
+	t.lru.Remove(elem)
+	delete(t.entries, entry.key)
+	if byKey, ok := t.paths[entry.path]; ok {
+		delete(byKey, entry.key)
+		if len(byKey) == 0 {
+			delete(t.paths, entry.path)
+		}
+	}
+}
+
+// cachePath normalises a URL path for the path index so /issues and
+// /issues/ refer to the same collection.
+func cachePath(urlPath string) string {
+	if trimmed := strings.TrimRight(urlPath, "/"); trimmed != "" {
+		return trimmed
+	}
+	return "/"
+}
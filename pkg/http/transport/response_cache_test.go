@@ -0,0 +1,182 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachingClient(t *testing.T, handler http.HandlerFunc, cfg ResponseCacheConfig) (*http.Client, *ResponseCacheTransport, string) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	rt := NewResponseCacheTransport(http.DefaultTransport, cfg)
+	return &http.Client{Transport: rt}, rt, server.URL
+}
+
+func doRequest(t *testing.T, client *http.Client, method, url, token string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestResponseCacheTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("second identical GET is served from cache", func(t *testing.T) {
+		t.Parallel()
+		var hits atomic.Int32
+		client, _, baseURL := newCachingClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			_, _ = w.Write([]byte(`{"name":"repo"}`))
+		}, ResponseCacheConfig{MaxEntries: 10, TTL: time.Minute})
+
+		_, first := doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+		resp, second := doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+
+		assert.EqualValues(t, 1, hits.Load())
+		assert.Equal(t, first, second)
+		assert.Equal(t, "1", resp.Header.Get(FromCacheHeader))
+	})
+
+	t.Run("write to the same resource invalidates the cached GET", func(t *testing.T) {
+		t.Parallel()
+		var gets atomic.Int32
+		client, _, baseURL := newCachingClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				gets.Add(1)
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}, ResponseCacheConfig{MaxEntries: 10, TTL: time.Minute})
+
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/issues/1", "token")
+		doRequest(t, client, http.MethodPatch, baseURL+"/repos/o/r/issues/1", "token")
+		resp, _ := doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/issues/1", "token")
+
+		assert.EqualValues(t, 2, gets.Load())
+		assert.Empty(t, resp.Header.Get(FromCacheHeader))
+	})
+
+	t.Run("write to an item invalidates the cached collection", func(t *testing.T) {
+		t.Parallel()
+		var gets atomic.Int32
+		client, _, baseURL := newCachingClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				gets.Add(1)
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}, ResponseCacheConfig{MaxEntries: 10, TTL: time.Minute})
+
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/issues?state=open", "token")
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+		doRequest(t, client, http.MethodPatch, baseURL+"/repos/o/r/issues/1", "token")
+		issues, _ := doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/issues?state=open", "token")
+		repo, _ := doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+
+		assert.EqualValues(t, 3, gets.Load())
+		assert.Empty(t, issues.Header.Get(FromCacheHeader))
+		assert.Equal(t, "1", repo.Header.Get(FromCacheHeader))
+	})
+
+	t.Run("different credentials do not share entries", func(t *testing.T) {
+		t.Parallel()
+		var hits atomic.Int32
+		client, _, baseURL := newCachingClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			_, _ = w.Write([]byte(`{}`))
+		}, ResponseCacheConfig{MaxEntries: 10, TTL: time.Minute})
+
+		doRequest(t, client, http.MethodGet, baseURL+"/user", "alice")
+		doRequest(t, client, http.MethodGet, baseURL+"/user", "bob")
+
+		assert.EqualValues(t, 2, hits.Load())
+	})
+
+	t.Run("stale entry is revalidated with its ETag", func(t *testing.T) {
+		t.Parallel()
+		var hits atomic.Int32
+		client, rt, baseURL := newCachingClient(t, func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`{"v":1}`))
+		}, ResponseCacheConfig{MaxEntries: 10, TTL: time.Minute})
+		now := time.Now()
+		rt.now = func() time.Time { return now }
+
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+		now = now.Add(2 * time.Minute)
+		resp, body := doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+
+		assert.EqualValues(t, 2, hits.Load())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `{"v":1}`, body)
+		assert.Equal(t, "1", resp.Header.Get(FromCacheHeader))
+	})
+
+	t.Run("no-store responses are not cached", func(t *testing.T) {
+		t.Parallel()
+		var hits atomic.Int32
+		client, _, baseURL := newCachingClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			w.Header().Set("Cache-Control", "no-store")
+			_, _ = w.Write([]byte(`{}`))
+		}, ResponseCacheConfig{MaxEntries: 10, TTL: time.Minute})
+
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r", "token")
+
+		assert.EqualValues(t, 2, hits.Load())
+	})
+
+	t.Run("least recently used entry is evicted", func(t *testing.T) {
+		t.Parallel()
+		var hits atomic.Int32
+		client, _, baseURL := newCachingClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			_, _ = w.Write([]byte(`{}`))
+		}, ResponseCacheConfig{MaxEntries: 1, TTL: time.Minute})
+
+		doRequest(t, client, http.MethodGet, baseURL+"/a", "token")
+		doRequest(t, client, http.MethodGet, baseURL+"/b", "token")
+		doRequest(t, client, http.MethodGet, baseURL+"/a", "token")
+
+		assert.EqualValues(t, 3, hits.Load())
+	})
+
+	t.Run("evicted and invalidated entries leave the path index", func(t *testing.T) {
+		t.Parallel()
+		client, rt, baseURL := newCachingClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}, ResponseCacheConfig{MaxEntries: 2, TTL: time.Minute})
+
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/issues?page=1", "token")
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/issues?page=2", "token")
+		doRequest(t, client, http.MethodGet, baseURL+"/repos/o/r/pulls", "token")
+		assert.Len(t, rt.paths["/repos/o/r/issues"], 1)
+		assert.Len(t, rt.paths["/repos/o/r/pulls"], 1)
+
+		doRequest(t, client, http.MethodPost, baseURL+"/repos/o/r/issues/", "token")
+		doRequest(t, client, http.MethodDelete, baseURL+"/repos/o/r/pulls/1", "token")
+		assert.Empty(t, rt.paths)
+		assert.Zero(t, rt.lru.Len())
+	})
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/lockdown"
+	"github.com/go-chi/chi/v5"
+	gogithub "github.com/google/go-github/v89/github"
+)
+
+// WebhooksPath is the path GitHub webhook deliveries are received on.
+const WebhooksPath = "/webhooks"
+
+// WebhookHandler receives GitHub webhook deliveries and invalidates the caches
+// that the affected repositories' data was stored in, so that a long-lived
+// server does not keep serving stale access information until the cache
+// entries expire.
+type WebhookHandler struct {
+	secret     []byte
+	repoAccess *lockdown.RepoAccessCache
+	logger     *slog.Logger
+}
+
+// NewWebhookHandler creates a WebhookHandler that verifies deliveries against
+// the webhook secret. repoAccess may be nil when lockdown mode is disabled.
+func NewWebhookHandler(secret string, repoAccess *lockdown.RepoAccessCache, logger *slog.Logger) *WebhookHandler {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	return &WebhookHandler{
+		secret:     []byte(secret),
+		repoAccess: repoAccess,
+		logger:     logger,
+	}
+}
+
+// RegisterRoutes registers the webhook delivery route.
+func (h *WebhookHandler) RegisterRoutes(r chi.Router) {
+	r.Post(WebhooksPath, h.ServeHTTP)
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := gogithub.ValidatePayload(r, h.secret)
+	if err != nil {
+		h.logger.Warn("rejected webhook delivery", "delivery", gogithub.DeliveryID(r), "error", err)
+		http.Error(w, "invalid webhook signature", http.StatusUnauthorized)
+		return
+	}
+
+	eventType := gogithub.WebHookType(r)
+	event, err := gogithub.ParseWebHook(eventType, payload)
+	if err != nil {
+		// Deliveries for event types we do not know about are acknowledged
+		// so that GitHub does not flag the hook as failing.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.handleEvent(event)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) handleEvent(event any) {
+	switch e := event.(type) {
+	case *gogithub.MemberEvent:
+		h.invalidateRepoAccess(e.GetRepo())
+	case *gogithub.TeamAddEvent:
+		h.invalidateRepoAccess(e.GetRepo())
+	case *gogithub.RepositoryEvent:
+		switch e.GetAction() {
+		case "privatized", "publicized":
+			h.invalidateRepoAccess(e.GetRepo())
+		}
+	}
+}
+
+func (h *WebhookHandler) invalidateRepoAccess(repo *gogithub.Repository) {
+	if h.repoAccess == nil || repo == nil {
+		return
+	}
+	owner, name := repo.GetOwner().GetLogin(), repo.GetName()
+	h.logger.Debug("invalidating repo access cache", "owner", owner, "repo", name)
+	h.repoAccess.Invalidate(owner, name)
+}
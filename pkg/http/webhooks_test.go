@@ -0,0 +1,68 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/lockdown"
+	"github.com/go-chi/chi/v5"
+	"github.com/muesli/cache2go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+func newWebhookRequest(t *testing.T, event, body, secret string) *http.Request {
+	t.Helper()
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, err := mac.Write([]byte(body))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, WebhooksPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func newTestWebhookRouter(t *testing.T) (chi.Router, *cache2go.CacheTable) {
+	t.Helper()
+	cacheName := t.Name()
+	repoAccess := lockdown.NewRepoAccessCache(nil, nil, lockdown.WithCacheName(cacheName))
+	r := chi.NewRouter()
+	NewWebhookHandler(testWebhookSecret, repoAccess, nil).RegisterRoutes(r)
+	return r, cache2go.Cache(cacheName)
+}
+
+func TestWebhookHandler_MemberEventInvalidatesRepoAccess(t *testing.T) {
+	r, table := newTestWebhookRouter(t)
+	table.Add("octo-org/octo-repo", time.Hour, struct{}{})
+	table.Add("octo-org/other-repo", time.Hour, struct{}{})
+
+	body := `{"action":"added","member":{"login":"octocat"},"repository":{"name":"Octo-Repo","owner":{"login":"Octo-Org"}}}`
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, newWebhookRequest(t, "member", body, testWebhookSecret))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.False(t, table.Exists("octo-org/octo-repo"))
+	assert.True(t, table.Exists("octo-org/other-repo"))
+}
+
+func TestWebhookHandler_RejectsBadSignature(t *testing.T) {
+	r, table := newTestWebhookRouter(t)
+	table.Add("octo-org/octo-repo", time.Hour, struct{}{})
+
+	body := `{"action":"added","member":{"login":"octocat"},"repository":{"name":"octo-repo","owner":{"login":"octo-org"}}}`
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, newWebhookRequest(t, "member", body, "wrong-secret"))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.True(t, table.Exists("octo-org/octo-repo"))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"path"
 	"slices"
 	"strings"
 )
@@ -119,6 +120,9 @@ func (b *Builder) WithToolsets(toolsetIDs []string) *Builder {
 // Read-only filtering still applies to these tools.
 // Input is cleaned (trimmed, deduplicated) during Build().
 // Deprecated tool aliases are automatically resolved to their canonical names during Build().
+// Names containing glob metacharacters (such as "issue_*" or "*_write") are
+// patterns in path.Match syntax, expanded against the known tools during Build();
+// a pattern that matches no tool adds nothing rather than failing the build.
 // Returns self for chaining.
 func (b *Builder) WithTools(toolNames []string) *Builder {
 	b.additionalTools = toolNames
@@ -201,6 +205,26 @@ func cleanTools(tools []string) []string {
 	return cleaned
 }
 
+// isToolPattern reports whether a WithTools entry is a glob pattern rather
+// than a tool name.
+func isToolPattern(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}
+
+// matchToolPattern returns the names in validToolNames that match pattern.
+func matchToolPattern(pattern string, validToolNames map[string]bool) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid tool pattern %q: %w", pattern, err)
+	}
+	var matched []string
+	for name := range validToolNames {
+		if ok, _ := path.Match(pattern, name); ok {
+			matched = append(matched, name)
+		}
+	}
+	return matched, nil
+}
+
 // Build creates the final Inventory with all configuration applied.
 // This processes toolset filtering, tool name resolution, and sets up
 // the inventory for use. The returned Inventory is ready for use with
@@ -248,6 +272,16 @@ func (b *Builder) Build() (*Inventory, error) {
 		r.additionalTools = make(map[string]bool, len(cleanedTools))
 		var unrecognizedTools []string
 		for _, name := range cleanedTools {
+			if isToolPattern(name) {
+				matched, err := matchToolPattern(name, validToolNames)
+				if err != nil {
+					return nil, err
+				}
+				for _, match := range matched {
+					r.additionalTools[match] = true
+				}
+				continue
+			}
 			// Always include the original name - this handles the case where
 			// the tool exists but is controlled by a feature flag that's OFF.
 			r.additionalTools[name] = true
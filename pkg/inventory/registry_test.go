@@ -609,6 +609,58 @@ func TestWithToolsResolvesAliases(t *testing.T) {
 	}
 }
 
+func TestWithToolsGlobPatterns(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("issue_read", "toolset1", true),
+		mockTool("issue_write", "toolset1", false),
+		mockTool("repo_read", "toolset2", true),
+		mockTool("repo_write", "toolset2", false),
+	}
+
+	tests := []struct {
+		name     string
+		tools    []string
+		expected []string
+	}{
+		{
+			name:     "prefix pattern",
+			tools:    []string{"issue_*"},
+			expected: []string{"issue_read", "issue_write"},
+		},
+		{
+			name:     "suffix pattern",
+			tools:    []string{"*_write"},
+			expected: []string{"issue_write", "repo_write"},
+		},
+		{
+			name:     "pattern combined with exact name",
+			tools:    []string{"*_write", "repo_read"},
+			expected: []string{"issue_write", "repo_read", "repo_write"},
+		},
+		{
+			name:     "pattern matching nothing",
+			tools:    []string{"pull_request_*"},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{}).WithTools(tc.tools))
+
+			var names []string
+			for _, tool := range reg.AvailableTools(context.Background()) {
+				names = append(names, tool.Tool.Name)
+			}
+			require.ElementsMatch(t, tc.expected, names)
+		})
+	}
+
+	_, err := NewBuilder().SetTools(tools).WithToolsets([]string{}).WithTools([]string{"issue_["}).Build()
+	require.Error(t, err, "expected error for malformed pattern")
+	require.Contains(t, err.Error(), "issue_[")
+}
+
 func TestHasToolset(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("tool1", "toolset1", true),
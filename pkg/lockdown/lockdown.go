@@ -1,12 +1,14 @@
 package lockdown
 
 import (
+	"container/list"
 	"context"
 	"fmt"
 	"log/slog"
 	"maps"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/go-github/v89/github"
@@ -22,9 +24,14 @@ type RepoAccessCache struct {
 	client           *githubv4.Client
 	restClient       *github.Client
 	cache            *cache2go.CacheTable
+	cacheName        string
+	counters         *cacheCounters
+	index            *lruIndex
 	ttl              time.Duration
+	maxEntries       int
 	logger           *slog.Logger
 	trustedBotLogins map[string]struct{}
+	trustedOrgs      map[string]struct{}
 
 	viewerMu    sync.Mutex
 	viewerLogin string
@@ -42,8 +49,9 @@ type RepoAccessInfo struct {
 }
 
 const (
-	defaultRepoAccessTTL      = 20 * time.Minute
-	defaultRepoAccessCacheKey = "repo-access-cache"
+	defaultRepoAccessTTL        = 20 * time.Minute
+	defaultRepoAccessMaxEntries = 10000
+	defaultRepoAccessCacheKey   = "repo-access-cache"
 )
 
 // RepoAccessOption configures RepoAccessCache at construction time.
@@ -57,6 +65,31 @@ func WithTTL(ttl time.Duration) RepoAccessOption {
 	}
 }
 
+// WithMaxEntries bounds the number of repositories kept in the cache. When the
+// bound is exceeded the least recently used entries are evicted. A non-positive
+// value removes the bound, leaving only the TTL to limit growth.
+func WithMaxEntries(maxEntries int) RepoAccessOption {
+	return func(c *RepoAccessCache) {
+		c.maxEntries = maxEntries
+	}
+}
+
+// WithTrustedOrgs exempts content in repositories owned by the given
+// organizations or users from lockdown filtering, so IsSafeContent allows it
+// without querying GitHub. Logins are matched case-insensitively.
+func WithTrustedOrgs(logins ...string) RepoAccessOption {
+	return func(c *RepoAccessCache) {
+		for _, login := range logins {
+			if login = strings.ToLower(strings.TrimSpace(login)); login != "" {
+				if c.trustedOrgs == nil {
+					c.trustedOrgs = make(map[string]struct{}, len(logins))
+				}
+				c.trustedOrgs[login] = struct{}{}
+			}
+		}
+	}
+}
+
 // WithLogger sets the logger used for cache diagnostics.
 func WithLogger(logger *slog.Logger) RepoAccessOption {
 	return func(c *RepoAccessCache) {
@@ -70,6 +103,7 @@ func WithCacheName(name string) RepoAccessOption {
 	return func(c *RepoAccessCache) {
 		if name != "" {
 			c.cache = cache2go.Cache(name)
+			c.cacheName = name
 		}
 	}
 }
@@ -80,7 +114,9 @@ func NewRepoAccessCache(client *githubv4.Client, restClient *github.Client, opts
 		client:     client,
 		restClient: restClient,
 		cache:      cache2go.Cache(defaultRepoAccessCacheKey),
+		cacheName:  defaultRepoAccessCacheKey,
 		ttl:        defaultRepoAccessTTL,
+		maxEntries: defaultRepoAccessMaxEntries,
 		trustedBotLogins: map[string]struct{}{
 			"copilot":             {},
 			"github-actions[bot]": {},
@@ -91,18 +127,119 @@ func NewRepoAccessCache(client *githubv4.Client, restClient *github.Client, opts
 			opt(c)
 		}
 	}
+	c.counters = countersFor(c.cacheName)
+	c.index = lruIndexFor(c.cache, c.cacheName)
 	return c
 }
 
-// CacheStats summarizes cache activity counters.
+// CacheStats summarizes cache activity counters. A lookup is a hit only when
+// both the repository and the user's push access were cached; a lookup that
+// has to query GitHub for either is a miss.
 type CacheStats struct {
 	Hits      int64
 	Misses    int64
 	Evictions int64
 }
 
+// cacheCounters backs CacheStats. Counters are kept per cache table rather
+// than per instance because in HTTP mode every request constructs its own
+// instance over the shared table.
+type cacheCounters struct {
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+}
+
+// tableCounters maps a cache table name to its *cacheCounters.
+var tableCounters sync.Map
+
+func countersFor(name string) *cacheCounters {
+	counters, _ := tableCounters.LoadOrStore(name, &cacheCounters{})
+	return counters.(*cacheCounters)
+}
+
+// lruIndex orders the keys of a cache table from most to least recently used
+// so that eviction at capacity is O(1). Like the counters it is kept per table,
+// so every instance sharing the table evicts from the same order.
+type lruIndex struct {
+	mu    sync.Mutex
+	order *list.List // of string keys, most recently used at the front
+	elems map[string]*list.Element
+}
+
+// tableIndexes maps a cache table name to its *lruIndex.
+var tableIndexes sync.Map
+
+func lruIndexFor(table *cache2go.CacheTable, name string) *lruIndex {
+	if index, ok := tableIndexes.Load(name); ok {
+		return index.(*lruIndex)
+	}
+	index, loaded := tableIndexes.LoadOrStore(name, &lruIndex{
+		order: list.New(),
+		elems: make(map[string]*list.Element),
+	})
+	idx := index.(*lruIndex)
+	if !loaded {
+		// Entries also leave the table through TTL expiry and Invalidate.
+		table.AddAboutToDeleteItemCallback(func(item *cache2go.CacheItem) {
+			if key, ok := item.Key().(string); ok {
+				idx.remove(key)
+			}
+		})
+	}
+	return idx
+}
+
+// touch marks key as the most recently used.
+func (x *lruIndex) touch(key string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if elem, ok := x.elems[key]; ok {
+		x.order.MoveToFront(elem)
+		return
+	}
+	x.elems[key] = x.order.PushFront(key)
+}
+
+func (x *lruIndex) remove(key string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if elem, ok := x.elems[key]; ok {
+		x.order.Remove(elem)
+		delete(x.elems, key)
+	}
+}
+
+// trim drops the least recently used keys until at most maxEntries remain and
+// returns the dropped keys.
+func (x *lruIndex) trim(maxEntries int) []string {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	var dropped []string
+	for x.order.Len() > maxEntries {
+		key := x.order.Remove(x.order.Back()).(string)
+		delete(x.elems, key)
+		dropped = append(dropped, key)
+	}
+	return dropped
+}
+
+// Stats returns the activity counters of the cache table this instance uses,
+// accumulated across all instances sharing it.
+func (c *RepoAccessCache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Hits:      c.counters.hits.Load(),
+		Misses:    c.counters.misses.Load(),
+		Evictions: c.counters.evictions.Load(),
+	}
+}
+
 // IsSafeContent determines if the specified user can safely access the requested repository content.
 // Safe access applies when any of the following is true:
+// - the repository owner is a trusted organization (see WithTrustedOrgs);
 // - the content was created by a trusted bot;
 // - the author currently has push access to the repository;
 // - the repository is private;
@@ -112,6 +249,10 @@ func (c *RepoAccessCache) IsSafeContent(ctx context.Context, username, owner, re
 		return false, fmt.Errorf("nil repo access cache")
 	}
 
+	if c.isTrustedOrg(owner) {
+		return true, nil
+	}
+
 	if c.isTrustedBot(username) {
 		return true, nil
 	}
@@ -138,6 +279,15 @@ func (c *RepoAccessCache) IsSafeContent(ctx context.Context, username, owner, re
 	return viewerLogin == strings.ToLower(username), nil
 }
 
+// Invalidate drops the cached access information for a repository so that the
+// next lockdown check re-queries its visibility and collaborator permissions.
+func (c *RepoAccessCache) Invalidate(owner, repo string) {
+	if c == nil {
+		return
+	}
+	_, _ = c.cache.Delete(cacheKey(owner, repo))
+}
+
 func (c *RepoAccessCache) viewerLoginFor(ctx context.Context) (string, error) {
 	c.viewerMu.Lock()
 	defer c.viewerMu.Unlock()
@@ -186,8 +336,12 @@ func (c *RepoAccessCache) getRepoAccessInfo(ctx context.Context, username, owner
 	// Entries are immutable once added: the cache table is shared across instances,
 	// so we publish a fresh entry with a cloned knownUsers map on every miss.
 	if cacheItem, err := c.cache.Value(key); err == nil {
+		if c.maxEntries > 0 {
+			c.index.touch(key)
+		}
 		entry := cacheItem.Data().(*repoAccessCacheEntry)
 		if cachedHasPush, known := entry.knownUsers[userKey]; known {
+			c.counters.hits.Add(1)
 			c.logDebug(ctx, fmt.Sprintf("repo access cache hit for user %s to %s/%s", username, owner, repo))
 			return RepoAccessInfo{
 				IsPrivate:     entry.isPrivate,
@@ -195,6 +349,7 @@ func (c *RepoAccessCache) getRepoAccessInfo(ctx context.Context, username, owner
 			}, nil
 		}
 
+		c.counters.misses.Add(1)
 		c.logDebug(ctx, "known users cache miss, fetching permission")
 
 		hasPush, pushErr := c.checkPushAccess(ctx, username, owner, repo)
@@ -205,7 +360,7 @@ func (c *RepoAccessCache) getRepoAccessInfo(ctx context.Context, username, owner
 		users := make(map[string]bool, len(entry.knownUsers)+1)
 		maps.Copy(users, entry.knownUsers)
 		users[userKey] = hasPush
-		c.cache.Add(key, c.ttl, &repoAccessCacheEntry{
+		c.store(ctx, key, &repoAccessCacheEntry{
 			isPrivate:  entry.isPrivate,
 			knownUsers: users,
 		})
@@ -216,6 +371,7 @@ func (c *RepoAccessCache) getRepoAccessInfo(ctx context.Context, username, owner
 		}, nil
 	}
 
+	c.counters.misses.Add(1)
 	c.logDebug(ctx, fmt.Sprintf("repo access cache miss for user %s to %s/%s", username, owner, repo))
 
 	isPrivate, viewerLogin, queryErr := c.queryRepoAccessInfo(ctx, owner, repo)
@@ -229,7 +385,7 @@ func (c *RepoAccessCache) getRepoAccessInfo(ctx context.Context, username, owner
 		return RepoAccessInfo{}, pushErr
 	}
 
-	c.cache.Add(key, c.ttl, &repoAccessCacheEntry{
+	c.store(ctx, key, &repoAccessCacheEntry{
 		knownUsers: map[string]bool{userKey: hasPush},
 		isPrivate:  isPrivate,
 	})
@@ -240,6 +396,25 @@ func (c *RepoAccessCache) getRepoAccessInfo(ctx context.Context, username, owner
 	}, nil
 }
 
+// store adds entry under key and evicts the least recently used entries while
+// the cache holds more than maxEntries repositories.
+func (c *RepoAccessCache) store(ctx context.Context, key string, entry *repoAccessCacheEntry) {
+	c.cache.Add(key, c.ttl, entry)
+	if c.maxEntries <= 0 {
+		return
+	}
+
+	c.index.touch(key)
+	// The index lock is released before deleting, as deletes call back into it.
+	for _, victim := range c.index.trim(c.maxEntries) {
+		// Another instance sharing the table may have removed it already.
+		if _, err := c.cache.Delete(victim); err == nil {
+			c.counters.evictions.Add(1)
+			c.logDebug(ctx, fmt.Sprintf("evicted repo access cache entry %s", victim))
+		}
+	}
+}
+
 // queryRepoAccessInfo fetches repository visibility and the viewer login in a single GraphQL round-trip.
 func (c *RepoAccessCache) queryRepoAccessInfo(ctx context.Context, owner, repo string) (bool, string, error) {
 	if c.client == nil {
@@ -305,6 +480,11 @@ func (c *RepoAccessCache) isTrustedBot(username string) bool {
 	return ok
 }
 
+func (c *RepoAccessCache) isTrustedOrg(owner string) bool {
+	_, ok := c.trustedOrgs[strings.ToLower(owner)]
+	return ok
+}
+
 func cacheKey(owner, repo string) string {
 	return fmt.Sprintf("%s/%s", strings.ToLower(owner), strings.ToLower(repo))
 }
@@ -216,3 +216,175 @@ func TestRepoAccessCacheRejectsEmptyViewerLogin(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "empty")
 }
+
+func TestRepoAccessCacheInvalidateForcesRefetch(t *testing.T) {
+	ctx := t.Context()
+
+	cache, transport := newMockRepoAccessCache(t, time.Hour)
+	_, err := cache.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+	_, err = cache.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, transport.CallCount())
+
+	cache.Invalidate("Octo-Org", "Octo-Repo")
+
+	_, err = cache.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, transport.CallCount())
+}
+
+func TestRepoAccessCacheEvictsLeastRecentlyUsedBeyondMaxEntries(t *testing.T) {
+	ctx := t.Context()
+
+	cache := NewRepoAccessCache(nil, nil, WithCacheName(t.Name()), WithMaxEntries(2), WithTTL(time.Hour))
+	t.Cleanup(cache.cache.Flush)
+
+	store := func(repo string) {
+		cache.store(ctx, cacheKey(testOwner, repo), &repoAccessCacheEntry{knownUsers: map[string]bool{testUser: true}})
+	}
+
+	store("repo-a")
+	store("repo-b")
+	store("repo-c")
+
+	require.Equal(t, 2, cache.cache.Count())
+	require.False(t, cache.cache.Exists(cacheKey(testOwner, "repo-a")), "oldest entry should be evicted")
+	require.True(t, cache.cache.Exists(cacheKey(testOwner, "repo-b")))
+	require.True(t, cache.cache.Exists(cacheKey(testOwner, "repo-c")))
+	require.EqualValues(t, 1, cache.Stats().Evictions)
+
+	// Reading repo-b makes repo-c the least recently used entry.
+	_, err := cache.getRepoAccessInfo(ctx, testUser, testOwner, "repo-b")
+	require.NoError(t, err)
+	store("repo-d")
+
+	require.Equal(t, 2, cache.cache.Count())
+	require.True(t, cache.cache.Exists(cacheKey(testOwner, "repo-b")))
+	require.False(t, cache.cache.Exists(cacheKey(testOwner, "repo-c")))
+	require.True(t, cache.cache.Exists(cacheKey(testOwner, "repo-d")))
+	require.EqualValues(t, 2, cache.Stats().Evictions)
+}
+
+func TestRepoAccessCacheInvalidateFreesCapacity(t *testing.T) {
+	ctx := t.Context()
+
+	cache := NewRepoAccessCache(nil, nil, WithCacheName(t.Name()), WithMaxEntries(2), WithTTL(time.Hour))
+	t.Cleanup(cache.cache.Flush)
+	for _, repo := range []string{"repo-a", "repo-b"} {
+		cache.store(ctx, cacheKey(testOwner, repo), &repoAccessCacheEntry{knownUsers: map[string]bool{}})
+	}
+
+	cache.Invalidate(testOwner, "repo-a")
+	cache.store(ctx, cacheKey(testOwner, "repo-c"), &repoAccessCacheEntry{knownUsers: map[string]bool{}})
+
+	require.Equal(t, 2, cache.cache.Count())
+	require.True(t, cache.cache.Exists(cacheKey(testOwner, "repo-b")))
+	require.Zero(t, cache.Stats().Evictions)
+}
+
+func TestRepoAccessCacheWithoutMaxEntriesIsUnbounded(t *testing.T) {
+	ctx := t.Context()
+
+	cache := NewRepoAccessCache(nil, nil, WithCacheName(t.Name()), WithMaxEntries(0), WithTTL(time.Hour))
+	t.Cleanup(cache.cache.Flush)
+	for _, repo := range []string{"repo-a", "repo-b", "repo-c"} {
+		cache.store(ctx, cacheKey(testOwner, repo), &repoAccessCacheEntry{knownUsers: map[string]bool{}})
+	}
+
+	require.Equal(t, 3, cache.cache.Count())
+	require.Zero(t, cache.Stats().Evictions)
+}
+
+func TestRepoAccessCacheStatsCountHitsAndMisses(t *testing.T) {
+	ctx := t.Context()
+
+	cache, _ := newMockRepoAccessCache(t, time.Hour)
+	t.Cleanup(cache.cache.Flush)
+	require.Equal(t, CacheStats{}, cache.Stats())
+
+	_, err := cache.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+	require.Equal(t, CacheStats{Misses: 1}, cache.Stats())
+
+	_, err = cache.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+	_, err = cache.getRepoAccessInfo(ctx, "OctoCat", "Octo-Org", "Octo-Repo")
+	require.NoError(t, err)
+	require.Equal(t, CacheStats{Hits: 2, Misses: 1}, cache.Stats())
+
+	// A cached repository with an unknown user still has to fetch permissions.
+	_, err = cache.getRepoAccessInfo(ctx, "hubot", testOwner, testRepo)
+	require.NoError(t, err)
+	require.Equal(t, CacheStats{Hits: 2, Misses: 2}, cache.Stats())
+}
+
+func TestRepoAccessCacheStatsAreSharedPerTable(t *testing.T) {
+	ctx := t.Context()
+
+	gqlClient, _ := newMockGQLClient(testUser, false)
+	restClient := newMockRESTServer(t, "write")
+
+	first := NewRepoAccessCache(gqlClient, restClient, WithCacheName(t.Name()))
+	t.Cleanup(first.cache.Flush)
+	_, err := first.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+
+	second := NewRepoAccessCache(gqlClient, restClient, WithCacheName(t.Name()))
+	_, err = second.getRepoAccessInfo(ctx, testUser, testOwner, testRepo)
+	require.NoError(t, err)
+
+	require.Equal(t, CacheStats{Hits: 1, Misses: 1}, first.Stats())
+	require.Equal(t, first.Stats(), second.Stats())
+
+	other := NewRepoAccessCache(gqlClient, restClient, WithCacheName(t.Name()+"-other"))
+	require.Equal(t, CacheStats{}, other.Stats())
+}
+
+func TestRepoAccessCacheTrustedOrgsBypassFiltering(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name        string
+		trustedOrgs []string
+		owner       string
+		expectSafe  bool
+		expectCalls int
+	}{
+		{
+			name:        "allowlisted owner is safe without a query",
+			trustedOrgs: []string{"octo-org"},
+			owner:       testOwner,
+			expectSafe:  true,
+			expectCalls: 0,
+		},
+		{
+			name:        "allowlist matches case-insensitively",
+			trustedOrgs: []string{" Octo-Org "},
+			owner:       "OCTO-ORG",
+			expectSafe:  true,
+			expectCalls: 0,
+		},
+		{
+			name:        "owner not on the allowlist is checked",
+			trustedOrgs: []string{"other-org"},
+			owner:       testOwner,
+			expectSafe:  false,
+			expectCalls: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient, transport := newMockGQLClient("viewer", false)
+			restClient := newMockRESTServer(t, "read")
+			cache := NewRepoAccessCache(gqlClient, restClient, WithCacheName(t.Name()), WithTrustedOrgs(tc.trustedOrgs...))
+			t.Cleanup(cache.cache.Flush)
+
+			safe, err := cache.IsSafeContent(ctx, "outsider", tc.owner, testRepo)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectSafe, safe)
+			require.Equal(t, tc.expectCalls, transport.CallCount())
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package raw
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+)
+
+// DefaultContentCacheEntries is the number of files a server's ContentCache
+// remembers between reads.
+const DefaultContentCacheEntries = 128
+
+// maxCachedContentSize bounds the size of a file body kept in a ContentCache;
+// larger files are always downloaded again.
+const maxCachedContentSize = 1 << 20
+
+// CachedContent is a raw file body remembered together with the ETag it was
+// served with.
+type CachedContent struct {
+	ETag        string
+	ContentType string
+	Body        []byte
+}
+
+type contentCacheKey struct {
+	host, owner, repo, path, ref string
+}
+
+type contentCacheEntry struct {
+	key     contentCacheKey
+	content *CachedContent
+}
+
+// ContentCache remembers the most recently read raw files by host, owner,
+// repo, path and ref, so that a file read again can be requested with If-None-Match
+// and, when GitHub answers 304 Not Modified, served without downloading it.
+// A cached body is only ever returned after GitHub has confirmed it with the
+// caller's own credentials, so the cache can be shared between users. A nil
+// ContentCache caches nothing.
+type ContentCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[contentCacheKey]*list.Element
+}
+
+// NewContentCache creates a ContentCache holding at most maxEntries files.
+func NewContentCache(maxEntries int) *ContentCache {
+	return &ContentCache{
+		maxEntries: maxEntries,
+		lru:        list.New(),
+		entries:    make(map[contentCacheKey]*list.Element),
+	}
+}
+
+func newContentCacheKey(host, owner, repo, path string, opts *ContentOpts) contentCacheKey {
+	ref := "HEAD"
+	if opts != nil && opts.SHA != "" {
+		ref = opts.SHA
+	} else if opts != nil && opts.Ref != "" {
+		ref = opts.Ref
+	}
+	return contentCacheKey{
+		host:  strings.ToLower(host),
+		owner: strings.ToLower(owner),
+		repo:  strings.ToLower(repo),
+		path:  path,
+		ref:   ref,
+	}
+}
+
+// Get returns the cached content of the file served by host, or nil if it is
+// not cached.
+func (c *ContentCache) Get(host, owner, repo, path string, opts *ContentOpts) *CachedContent {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[newContentCacheKey(host, owner, repo, path, opts)]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*contentCacheEntry).content
+}
+
+// Add caches content for the file served by host, evicting the least recently used file when
+// the cache is full. Content without an ETag or larger than
+// maxCachedContentSize is not cached, and drops any stale entry for the file.
+func (c *ContentCache) Add(host, owner, repo, path string, opts *ContentOpts, content *CachedContent) {
+	if c == nil {
+		return
+	}
+	key := newContentCacheKey(host, owner, repo, path, opts)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+	}
+	if content.ETag == "" || len(content.Body) > maxCachedContentSize || c.maxEntries <= 0 {
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&contentCacheEntry{key: key, content: content})
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*contentCacheEntry).key)
+	}
+}
@@ -0,0 +1,41 @@
+package raw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentCache(t *testing.T) {
+	cache := NewContentCache(2)
+	readme := &CachedContent{ETag: `"readme"`, ContentType: "text/plain", Body: []byte("# Hello")}
+
+	cache.Add("raw.example.com", "Octocat", "Hello", "README.md", nil, readme)
+	assert.Same(t, readme, cache.Get("raw.example.com", "octocat", "hello", "README.md", &ContentOpts{}))
+	assert.Nil(t, cache.Get("raw.example.com", "octocat", "hello", "README.md", &ContentOpts{Ref: "refs/heads/main"}))
+	assert.Nil(t, cache.Get("raw.ghe.example.com", "octocat", "hello", "README.md", nil), "files are cached per host")
+
+	// Content without an ETag cannot be revalidated, and replaces a stale entry.
+	cache.Add("raw.example.com", "octocat", "hello", "README.md", nil, &CachedContent{Body: []byte("# Hello again")})
+	assert.Nil(t, cache.Get("raw.example.com", "octocat", "hello", "README.md", nil))
+
+	// The least recently used file is evicted once the cache is full.
+	a := &CachedContent{ETag: `"a"`}
+	b := &CachedContent{ETag: `"b"`}
+	c := &CachedContent{ETag: `"c"`}
+	cache.Add("raw.example.com", "octocat", "hello", "a.txt", &ContentOpts{SHA: "abc123"}, a)
+	cache.Add("raw.example.com", "octocat", "hello", "b.txt", &ContentOpts{SHA: "abc123"}, b)
+	require.Same(t, a, cache.Get("raw.example.com", "octocat", "hello", "a.txt", &ContentOpts{SHA: "abc123"}))
+	cache.Add("raw.example.com", "octocat", "hello", "c.txt", &ContentOpts{SHA: "abc123"}, c)
+
+	assert.Same(t, a, cache.Get("raw.example.com", "octocat", "hello", "a.txt", &ContentOpts{SHA: "abc123"}))
+	assert.Nil(t, cache.Get("raw.example.com", "octocat", "hello", "b.txt", &ContentOpts{SHA: "abc123"}))
+	assert.Same(t, c, cache.Get("raw.example.com", "octocat", "hello", "c.txt", &ContentOpts{SHA: "abc123"}))
+}
+
+func TestContentCache_Nil(t *testing.T) {
+	var cache *ContentCache
+	cache.Add("raw.example.com", "octocat", "hello", "README.md", nil, &CachedContent{ETag: `"readme"`})
+	assert.Nil(t, cache.Get("raw.example.com", "octocat", "hello", "README.md", nil))
+}
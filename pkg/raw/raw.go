@@ -30,6 +30,11 @@ func NewClient(client *gogithub.Client, rawURL *url.URL) (*Client, error) {
 	return &Client{client: newClient, url: rawURL}, nil
 }
 
+// Host returns the host raw content is downloaded from.
+func (c *Client) Host() string {
+	return c.url.Host
+}
+
 func (c *Client) newRequest(ctx context.Context, method string, urlStr string, body any, opts ...gogithub.RequestOption) (*http.Request, error) {
 	return c.client.NewRequest(ctx, method, urlStr, body, opts...)
 }
@@ -59,15 +64,24 @@ func (c *Client) commitURL(owner, repo, sha, path string) string {
 type ContentOpts struct {
 	Ref string
 	SHA string
+
+	// IfNoneMatch, when set, is sent as the If-None-Match header so that an
+	// unchanged file is answered with 304 Not Modified and no body.
+	IfNoneMatch string
 }
 
 // GetRawContent fetches the raw content of a file from a GitHub repository.
+// If opts.IfNoneMatch is set and the file is unchanged, the response has
+// status 304 Not Modified.
 func (c *Client) GetRawContent(ctx context.Context, owner, repo, path string, opts *ContentOpts) (*http.Response, error) {
 	url := c.URLFromOpts(opts, owner, repo, path)
 	req, err := c.newRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
+	if opts != nil && opts.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", opts.IfNoneMatch)
+	}
 
 	return c.client.Client().Do(req)
 }
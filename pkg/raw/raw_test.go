@@ -183,3 +183,36 @@ func TestUrlFromOpts(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRawContent_IfNoneMatch(t *testing.T) {
+	base, _ := url.Parse("https://raw.example.com/")
+	var gotIfNoneMatch string
+	mockedClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			gotIfNoneMatch = req.Header.Get("If-None-Match")
+			return &http.Response{
+				StatusCode: http.StatusNotModified,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("")),
+				Request:    req,
+			}, nil
+		}),
+	}
+	ghClient, err := github.NewClient(github.WithHTTPClient(mockedClient))
+	require.NoError(t, err)
+	client, err := NewClient(ghClient, base)
+	require.NoError(t, err)
+
+	resp, err := client.GetRawContent(context.Background(), "octocat", "hello", "README.md", &ContentOpts{IfNoneMatch: `"abc"`})
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	require.Equal(t, `"abc"`, gotIfNoneMatch)
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,55 @@
+package scopes
+
+// permissionScopes maps a GitHub App or fine-grained token permission to the
+// OAuth scopes that tools needing that permission declare, for read and for
+// write access. Permissions are finer grained than OAuth scopes, so the
+// mapping is approximate: any repository permission maps to Repo, just as a
+// classic token needs the repo scope for all of them. Permissions with no
+// counterpart in the tool scope model, such as metadata, are omitted.
+var permissionScopes = map[string]struct{ read, write Scope }{
+	"actions":                     {Repo, Repo},
+	"administration":              {Repo, Repo},
+	"checks":                      {Repo, Repo},
+	"contents":                    {Repo, Repo},
+	"deployments":                 {Repo, Repo},
+	"discussions":                 {Repo, Repo},
+	"issues":                      {Repo, Repo},
+	"pages":                       {Repo, Repo},
+	"pull_requests":               {Repo, Repo},
+	"repository_hooks":            {Repo, Repo},
+	"statuses":                    {Repo, Repo},
+	"security_events":             {SecurityEvents, SecurityEvents},
+	"secret_scanning_alerts":      {SecurityEvents, SecurityEvents},
+	"vulnerability_alerts":        {SecurityEvents, SecurityEvents},
+	"members":                     {ReadOrg, WriteOrg},
+	"organization_administration": {ReadOrg, AdminOrg},
+	"organization_projects":       {ReadProject, Project},
+	"repository_projects":         {ReadProject, Project},
+	"packages":                    {ReadPackages, WritePackages},
+	"gists":                       {NoScope, Gist},
+	"email_addresses":             {UserEmail, UserEmail},
+	"profile":                     {ReadUser, User},
+}
+
+// ScopesFromPermissions maps the permissions granted to a GitHub App
+// installation or fine-grained token, as returned by GitHub in the form
+// {"contents": "read", "issues": "write"}, to the OAuth scopes used for tool
+// filtering. "write" and "admin" access both map to the write scope.
+// The returned slice is sorted for deterministic output.
+func ScopesFromPermissions(permissions map[string]string) []string {
+	set := make(ScopeSet)
+	for permission, access := range permissions {
+		mapped, ok := permissionScopes[permission]
+		if !ok {
+			continue
+		}
+		scope := mapped.read
+		if access == "write" || access == "admin" {
+			scope = mapped.write
+		}
+		if scope != NoScope {
+			set[scope] = true
+		}
+	}
+	return set.ToStringSlice()
+}
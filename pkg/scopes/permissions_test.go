@@ -0,0 +1,71 @@
+package scopes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopesFromPermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions map[string]string
+		expected    []string
+	}{
+		{
+			name:        "no permissions",
+			permissions: nil,
+			expected:    []string{},
+		},
+		{
+			name:        "metadata only maps to no scopes",
+			permissions: map[string]string{"metadata": "read"},
+			expected:    []string{},
+		},
+		{
+			name: "repository permissions map to repo",
+			permissions: map[string]string{
+				"contents":      "read",
+				"issues":        "write",
+				"pull_requests": "write",
+				"metadata":      "read",
+			},
+			expected: []string{"repo"},
+		},
+		{
+			name: "read and write access map to different scopes",
+			permissions: map[string]string{
+				"members":               "read",
+				"organization_projects": "write",
+				"packages":              "read",
+			},
+			expected: []string{"project", "read:org", "read:packages"},
+		},
+		{
+			name: "admin access maps to the write scope",
+			permissions: map[string]string{
+				"organization_administration": "admin",
+			},
+			expected: []string{"admin:org"},
+		},
+		{
+			name: "read-only gists grant no scope",
+			permissions: map[string]string{
+				"gists":           "read",
+				"security_events": "read",
+			},
+			expected: []string{"security_events"},
+		},
+		{
+			name:        "unknown permissions are ignored",
+			permissions: map[string]string{"codespaces": "write"},
+			expected:    []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ScopesFromPermissions(tc.permissions))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -27,8 +28,30 @@ type APIHost struct {
 
 var _ APIHostResolver = APIHost{}
 
-func NewAPIHost(s string) (APIHostResolver, error) {
-	a, err := parseAPIHost(s)
+// APIHostOption configures NewAPIHost.
+type APIHostOption func(*apiHostOptions)
+
+type apiHostOptions struct {
+	httpClient *http.Client
+}
+
+// WithHTTPClient sets the client used to detect GHES subdomain isolation, so
+// that the probe honors the same proxy and CA settings as API requests.
+// Redirects are never followed and a 5 second timeout applies unless the
+// client sets its own.
+func WithHTTPClient(client *http.Client) APIHostOption {
+	return func(o *apiHostOptions) {
+		o.httpClient = client
+	}
+}
+
+func NewAPIHost(s string, opts ...APIHostOption) (APIHostResolver, error) {
+	var o apiHostOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	a, err := parseAPIHost(s, o.httpClient)
 
 	if err != nil {
 		return nil, err
@@ -139,33 +162,37 @@ func newGHECHost(hostname string) (APIHost, error) {
 	}, nil
 }
 
-func newGHESHost(hostname string) (APIHost, error) {
+func newGHESHost(hostname string, client *http.Client) (APIHost, error) {
 	u, err := url.Parse(hostname)
 	if err != nil {
 		return APIHost{}, fmt.Errorf("failed to parse GHES URL: %w", err)
 	}
 
-	restURL, err := url.Parse(fmt.Sprintf("%s://%s/api/v3/", u.Scheme, u.Hostname()))
+	// u.Host keeps a non-standard port, such as in https://ghe.local:8443, so
+	// that every derived URL points at the same port.
+	host := u.Host
+
+	restURL, err := url.Parse(fmt.Sprintf("%s://%s/api/v3/", u.Scheme, host))
 	if err != nil {
 		return APIHost{}, fmt.Errorf("failed to parse GHES REST URL: %w", err)
 	}
 
-	gqlURL, err := url.Parse(fmt.Sprintf("%s://%s/api/graphql", u.Scheme, u.Hostname()))
+	gqlURL, err := url.Parse(fmt.Sprintf("%s://%s/api/graphql", u.Scheme, host))
 	if err != nil {
 		return APIHost{}, fmt.Errorf("failed to parse GHES GraphQL URL: %w", err)
 	}
 
 	// Check if subdomain isolation is enabled
 	// See https://docs.github.com/en/enterprise-server@3.17/admin/configuring-settings/hardening-security-for-your-enterprise/enabling-subdomain-isolation#about-subdomain-isolation
-	hasSubdomainIsolation := checkSubdomainIsolation(u.Scheme, u.Hostname())
+	hasSubdomainIsolation := detectSubdomainIsolation(client, u.Scheme, host)
 
 	var uploadURL *url.URL
 	if hasSubdomainIsolation {
 		// With subdomain isolation: https://uploads.hostname/
-		uploadURL, err = url.Parse(fmt.Sprintf("%s://uploads.%s/", u.Scheme, u.Hostname()))
+		uploadURL, err = url.Parse(fmt.Sprintf("%s://uploads.%s/", u.Scheme, host))
 	} else {
 		// Without subdomain isolation: https://hostname/api/uploads/
-		uploadURL, err = url.Parse(fmt.Sprintf("%s://%s/api/uploads/", u.Scheme, u.Hostname()))
+		uploadURL, err = url.Parse(fmt.Sprintf("%s://%s/api/uploads/", u.Scheme, host))
 	}
 	if err != nil {
 		return APIHost{}, fmt.Errorf("failed to parse GHES Upload URL: %w", err)
@@ -174,16 +201,16 @@ func newGHESHost(hostname string) (APIHost, error) {
 	var rawURL *url.URL
 	if hasSubdomainIsolation {
 		// With subdomain isolation: https://raw.hostname/
-		rawURL, err = url.Parse(fmt.Sprintf("%s://raw.%s/", u.Scheme, u.Hostname()))
+		rawURL, err = url.Parse(fmt.Sprintf("%s://raw.%s/", u.Scheme, host))
 	} else {
 		// Without subdomain isolation: https://hostname/raw/
-		rawURL, err = url.Parse(fmt.Sprintf("%s://%s/raw/", u.Scheme, u.Hostname()))
+		rawURL, err = url.Parse(fmt.Sprintf("%s://%s/raw/", u.Scheme, host))
 	}
 	if err != nil {
 		return APIHost{}, fmt.Errorf("failed to parse GHES Raw URL: %w", err)
 	}
 
-	authorizationServerURL, err := url.Parse(fmt.Sprintf("%s://%s/login/oauth", u.Scheme, u.Hostname()))
+	authorizationServerURL, err := url.Parse(fmt.Sprintf("%s://%s/login/oauth", u.Scheme, host))
 	if err != nil {
 		return APIHost{}, fmt.Errorf("failed to parse GHES Authorization Server URL: %w", err)
 	}
@@ -197,31 +224,126 @@ func newGHESHost(hostname string) (APIHost, error) {
 	}, nil
 }
 
-// checkSubdomainIsolation detects if GitHub Enterprise Server has subdomain isolation enabled
-// by attempting to ping the raw.<host>/_ping endpoint on the subdomain. The raw subdomain must always exist for subdomain isolation.
-func checkSubdomainIsolation(scheme, hostname string) bool {
-	subdomainURL := fmt.Sprintf("%s://raw.%s/_ping", scheme, hostname)
+// subdomainIsolationTTL is how long a detected subdomain isolation setting is
+// reused for a host before it is probed again.
+const subdomainIsolationTTL = 5 * time.Minute
+
+// subdomainIsolationEntry holds the detected setting of one host. Its lock is
+// held while the host is probed, so that concurrent constructions for the
+// same host ping it once without blocking constructions for other hosts.
+type subdomainIsolationEntry struct {
+	sync.Mutex
+	known   bool
+	enabled bool
+	// expiresAt is zero for results set by SetSubdomainIsolation, which do
+	// not expire.
+	expiresAt time.Time
+}
+
+// subdomainIsolation memoizes detection results by scheme and host, so that
+// constructing several APIHosts for the same GHES instance pings it once.
+var subdomainIsolation = struct {
+	sync.Mutex
+	entries map[string]*subdomainIsolationEntry
+}{entries: make(map[string]*subdomainIsolationEntry)}
+
+// probeSubdomainIsolation is the detection used on a cache miss; tests
+// replace it to avoid network access.
+var probeSubdomainIsolation = checkSubdomainIsolation
+
+func subdomainIsolationKey(scheme, host string) string {
+	return strings.ToLower(scheme + "://" + host)
+}
+
+// subdomainIsolationEntryFor returns the entry for key, creating it if needed.
+func subdomainIsolationEntryFor(key string) *subdomainIsolationEntry {
+	subdomainIsolation.Lock()
+	defer subdomainIsolation.Unlock()
+	entry, ok := subdomainIsolation.entries[key]
+	if !ok {
+		entry = &subdomainIsolationEntry{}
+		subdomainIsolation.entries[key] = entry
+	}
+	return entry
+}
+
+// detectSubdomainIsolation returns the cached subdomain isolation setting of
+// a GHES host, probing the host when nothing fresh is cached. A probe that
+// fails to reach the host is treated as no isolation but is not cached, so
+// the next construction probes again.
+func detectSubdomainIsolation(client *http.Client, scheme, host string) bool {
+	entry := subdomainIsolationEntryFor(subdomainIsolationKey(scheme, host))
+
+	entry.Lock()
+	defer entry.Unlock()
 
-	client := &http.Client{
-		Timeout: 5 * time.Second,
-		// Don't follow redirects - we just want to check if the endpoint exists
-		//nolint:revive // parameters are required by http.Client.CheckRedirect signature
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			return http.ErrUseLastResponse
-		},
+	if entry.known && (entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt)) {
+		return entry.enabled
 	}
 
-	resp, err := client.Get(subdomainURL)
+	enabled, err := probeSubdomainIsolation(client, scheme, host)
 	if err != nil {
 		return false
 	}
+	entry.known = true
+	entry.enabled = enabled
+	entry.expiresAt = time.Now().Add(subdomainIsolationTTL)
+	return enabled
+}
+
+// SetSubdomainIsolation records whether the GHES instance at s (a URL such as
+// https://ghe.example.com) has subdomain isolation enabled, replacing
+// detection for every APIHost subsequently constructed for it. Use it when
+// the raw.<host>/_ping probe cannot reach the instance.
+func SetSubdomainIsolation(s string, enabled bool) error {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("host must be a URL with a scheme (http or https): %s", s)
+	}
+
+	entry := subdomainIsolationEntryFor(subdomainIsolationKey(u.Scheme, u.Host))
+	entry.Lock()
+	defer entry.Unlock()
+	entry.known = true
+	entry.enabled = enabled
+	entry.expiresAt = time.Time{}
+	return nil
+}
+
+// checkSubdomainIsolation detects if GitHub Enterprise Server has subdomain isolation enabled
+// by attempting to ping the raw.<host>/_ping endpoint on the subdomain. The raw subdomain must always exist for subdomain isolation.
+// host may include a port, which the subdomain shares. A nil client uses the default transport.
+// An error is returned when the endpoint could not be reached at all.
+func checkSubdomainIsolation(client *http.Client, scheme, host string) (bool, error) {
+	subdomainURL := fmt.Sprintf("%s://raw.%s/_ping", scheme, host)
+
+	pingClient := &http.Client{}
+	if client != nil {
+		// Copy so the caller's redirect policy and timeout are left untouched.
+		*pingClient = *client
+	}
+	if pingClient.Timeout == 0 {
+		pingClient.Timeout = 5 * time.Second
+	}
+	// Don't follow redirects - we just want to check if the endpoint exists
+	//nolint:revive // parameters are required by http.Client.CheckRedirect signature
+	pingClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := pingClient.Get(subdomainURL)
+	if err != nil {
+		return false, err
+	}
 	defer resp.Body.Close()
 
-	return resp.StatusCode == http.StatusOK
+	return resp.StatusCode == http.StatusOK, nil
 }
 
-// Note that this does not handle ports yet, so development environments are out.
-func parseAPIHost(s string) (APIHost, error) {
+// parseAPIHost resolves the API URLs for GitHub.com, GHEC (ghe.com) or, for
+// any other host, GHES. A port in a GHES host, such as a local development
+// instance, is preserved.
+func parseAPIHost(s string, client *http.Client) (APIHost, error) {
 	if s == "" {
 		return newDotcomHost()
 	}
@@ -243,5 +365,5 @@ func parseAPIHost(s string) (APIHost, error) {
 		return newGHECHost(s)
 	}
 
-	return newGHESHost(s)
+	return newGHESHost(s, client)
 }
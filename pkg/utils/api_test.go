@@ -1,7 +1,10 @@
 package utils //nolint:revive //TODO: figure out a better name for this package
 
 import (
+	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,7 +66,7 @@ func TestParseAPIHost(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			host, err := parseAPIHost(tc.input)
+			host, err := parseAPIHost(tc.input, nil)
 			if tc.wantErr {
 				assert.Error(t, err)
 				return
@@ -73,3 +76,159 @@ func TestParseAPIHost(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAPIHostPreservesGHESPort(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantRestURL   string
+		wantGraphQL   string
+		wantUploadURL string
+		wantRawURL    string
+	}{
+		{
+			name:          "GHES hostname with port",
+			input:         "https://ghe.local:8443",
+			wantRestURL:   "https://ghe.local:8443/api/v3/",
+			wantGraphQL:   "https://ghe.local:8443/api/graphql",
+			wantUploadURL: "https://ghe.local:8443/api/uploads/",
+			wantRawURL:    "https://ghe.local:8443/raw/",
+		},
+		{
+			name:          "localhost with port",
+			input:         "http://localhost:3000",
+			wantRestURL:   "http://localhost:3000/api/v3/",
+			wantGraphQL:   "http://localhost:3000/api/graphql",
+			wantUploadURL: "http://localhost:3000/api/uploads/",
+			wantRawURL:    "http://localhost:3000/raw/",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			host, err := parseAPIHost(tc.input, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRestURL, host.restURL.String())
+			assert.Equal(t, tc.wantGraphQL, host.gqlURL.String())
+			assert.Equal(t, tc.wantUploadURL, host.uploadURL.String())
+			assert.Equal(t, tc.wantRawURL, host.rawURL.String())
+		})
+	}
+}
+
+// stubSubdomainIsolationProbe replaces the network probe for the duration of
+// the test and returns a counter of the probes made.
+func stubSubdomainIsolationProbe(t *testing.T, enabled bool) *int {
+	t.Helper()
+	probes := 0
+	original := probeSubdomainIsolation
+	probeSubdomainIsolation = func(_ *http.Client, _, _ string) (bool, error) {
+		probes++
+		return enabled, nil
+	}
+	t.Cleanup(func() { probeSubdomainIsolation = original })
+	return &probes
+}
+
+func TestNewAPIHostCachesSubdomainIsolation(t *testing.T) {
+	probes := stubSubdomainIsolationProbe(t, true)
+	const host = "https://ghes-cache.example.com"
+
+	for range 3 {
+		resolver, err := NewAPIHost(host)
+		require.NoError(t, err)
+		rawURL, err := resolver.RawURL(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "https://raw.ghes-cache.example.com/", rawURL.String())
+	}
+	assert.Equal(t, 1, *probes, "the ping endpoint should be hit at most once")
+
+	// An expired result is probed again.
+	entry := subdomainIsolationEntryFor(subdomainIsolationKey("https", "ghes-cache.example.com"))
+	entry.Lock()
+	entry.expiresAt = time.Now().Add(-time.Second)
+	entry.Unlock()
+
+	_, err := NewAPIHost(host)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *probes)
+}
+
+func TestNewAPIHostDoesNotCacheProbeErrors(t *testing.T) {
+	probes := 0
+	original := probeSubdomainIsolation
+	probeSubdomainIsolation = func(_ *http.Client, _, _ string) (bool, error) {
+		probes++
+		if probes == 1 {
+			return false, errors.New("connection refused")
+		}
+		return true, nil
+	}
+	t.Cleanup(func() { probeSubdomainIsolation = original })
+	const host = "https://ghes-unreachable.example.com"
+
+	resolver, err := NewAPIHost(host)
+	require.NoError(t, err)
+	rawURL, err := resolver.RawURL(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "https://ghes-unreachable.example.com/raw/", rawURL.String())
+
+	// The failed probe is retried, and its successful result is cached.
+	for range 2 {
+		resolver, err = NewAPIHost(host)
+		require.NoError(t, err)
+		rawURL, err = resolver.RawURL(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "https://raw.ghes-unreachable.example.com/", rawURL.String())
+	}
+	assert.Equal(t, 2, probes)
+}
+
+func TestSetSubdomainIsolationOverridesDetection(t *testing.T) {
+	probes := stubSubdomainIsolationProbe(t, false)
+	const host = "https://ghes-override.example.com:8443"
+
+	require.NoError(t, SetSubdomainIsolation(host, true))
+
+	resolver, err := NewAPIHost(host)
+	require.NoError(t, err)
+	uploadURL, err := resolver.UploadURL(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "https://uploads.ghes-override.example.com:8443/", uploadURL.String())
+	assert.Zero(t, *probes)
+
+	assert.Error(t, SetSubdomainIsolation("ghes-override.example.com", true))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewAPIHostWithHTTPClient(t *testing.T) {
+	var pinged []string
+	client := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			pinged = append(pinged, req.URL.String())
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       http.NoBody,
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	resolver, err := NewAPIHost("https://ghes-client.example.com:8443", WithHTTPClient(client))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"https://raw.ghes-client.example.com:8443/_ping"}, pinged)
+	assert.Nil(t, client.CheckRedirect, "the caller's client must not be modified")
+
+	uploadURL, err := resolver.UploadURL(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "https://uploads.ghes-client.example.com:8443/", uploadURL.String())
+	rawURL, err := resolver.RawURL(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "https://raw.ghes-client.example.com:8443/", rawURL.String())
+}